@@ -1,19 +1,16 @@
 package main
 
 import (
-	"crypto/tls"
 	"log"
-	"net/http"
 	"time"
 
 	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
-	"github.com/opensearch-project/opensearch-go/v2"
 	"go.uber.org/zap"
 
 	"fourleaves.studio/manga-scraper/internal/config"
 	"fourleaves.studio/manga-scraper/internal/cron"
 	"fourleaves.studio/manga-scraper/internal/database/prisma"
-	"fourleaves.studio/manga-scraper/internal/elasticsearch"
+	"fourleaves.studio/manga-scraper/internal/database/redis"
 	kafkaDomain "fourleaves.studio/manga-scraper/internal/kafka"
 	"fourleaves.studio/manga-scraper/internal/service"
 )
@@ -43,16 +40,6 @@ func main() {
 		}
 	}()
 
-	esClient, err := opensearch.NewClient(opensearch.Config{
-		Transport: &http.Transport{
-			TLSClientConfig: &tls.Config{InsecureSkipVerify: true}, // nolint:gosec
-		},
-		Addresses: []string{envConfig.SearchURL},
-	})
-	if err != nil {
-		log.Fatal("[main] failed to create elasticsearch client: ", err)
-	}
-
 	kafkaClient, err := kafka.NewProducer(&kafka.ConfigMap{
 		"bootstrap.servers": envConfig.KafkaURL,
 	})
@@ -69,14 +56,15 @@ func main() {
 	cronRepo := prisma.NewCronJobRepo(dbClient)
 	providerRepo := prisma.NewProviderRepo(dbClient)
 	seriesRepo := prisma.NewSeriesRepo(dbClient)
-
-	seriesSearch := elasticsearch.NewSeriesSearchRepository(esClient)
+	workRepo := prisma.NewWorkRepo(dbClient)
 
 	scraperRepo := prisma.NewScraperRepo(dbClient)
 	scaperMessageBroker := kafkaDomain.NewScraperMessageBroker(kafkaClient)
 	scraperService := service.NewScraperCronService(scraperRepo, scaperMessageBroker, logger)
 
-	cronWorker := cron.NewCron(providerRepo, seriesRepo, cronRepo, scraperService, seriesSearch, logger)
+	cacheInvalidator := redis.NewInvalidator(envConfig.RedisURL)
+
+	cronWorker := cron.NewCron(providerRepo, seriesRepo, cronRepo, scraperService, workRepo, cacheInvalidator, logger)
 
 	errC, err := cronWorker.StartServer()
 	if err != nil {
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/opensearch-project/opensearch-go/v2"
+	"go.uber.org/zap"
+
+	"fourleaves.studio/manga-scraper/internal/config"
+	"fourleaves.studio/manga-scraper/internal/database/prisma"
+	"fourleaves.studio/manga-scraper/internal/elasticsearch"
+	"fourleaves.studio/manga-scraper/internal/indexer"
+)
+
+func main() {
+	// Set local timezone to Asia/Singapore
+	loc, err := time.LoadLocation("Asia/Singapore")
+	if err != nil {
+		log.Fatal("[main] failed to load location: ", err)
+	}
+
+	time.Local = loc
+
+	// Load config from .env file
+	envConfig, err := config.LoadConfig(".env")
+	if err != nil {
+		log.Fatal("[main] failed to load config: ", err)
+	}
+
+	dbClient := prisma.NewClient(prisma.WithDatasourceURL(envConfig.DBURL))
+	if err := dbClient.Connect(); err != nil {
+		log.Fatal("[main] failed to connect to database: ", err)
+	}
+	defer func() {
+		if err := dbClient.Disconnect(); err != nil {
+			log.Fatal("[main] failed to disconnect from database: ", err)
+		}
+	}()
+
+	esClient, err := opensearch.NewClient(opensearch.Config{
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: true}, // nolint:gosec
+		},
+		Addresses: []string{envConfig.SearchURL},
+	})
+	if err != nil {
+		log.Fatal("[main] failed to create elasticsearch client: ", err)
+	}
+
+	logger, err := zap.NewProduction()
+	if err != nil {
+		log.Fatal("[main] failed to create logger: ", err)
+	}
+
+	seriesSearch := elasticsearch.NewSeriesSearchRepository(esClient)
+	if err := seriesSearch.PutTemplates(context.Background()); err != nil {
+		logger.Warn("[main] failed to put search index templates", zap.Error(err))
+	}
+
+	retryPolicy := indexer.RetryPolicy{
+		BaseDelay: envConfig.IndexerRetryBaseDelay,
+		MaxDelay:  envConfig.IndexerRetryMaxDelay,
+	}
+
+	indexerWorker := indexer.NewWorker(
+		prisma.NewSeriesChangeRepo(dbClient),
+		prisma.NewSeriesRepo(dbClient),
+		prisma.NewReindexJobRepo(dbClient),
+		seriesSearch,
+		logger,
+		retryPolicy,
+		envConfig.IndexerPollInterval,
+	)
+
+	errC, err := indexerWorker.StartServer()
+	if err != nil {
+		log.Fatal("[main] couldn't run: ", err)
+	}
+
+	if err := <-errC; err != nil {
+		log.Fatal("[main] error while running: ", err)
+	}
+}
@@ -30,6 +30,9 @@ import (
 // @in							header
 // @name						Authorization
 // @tokenUrl					https://manga-reader.fourleaves.studio/sign-in
+// @securitydefinitions.apikey	APIKeyAuth
+// @in							header
+// @name						X-API-Key
 func main() {
 	// Set local timezone to Asia/Singapore
 	loc, err := time.LoadLocation("Asia/Singapore")
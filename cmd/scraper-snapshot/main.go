@@ -0,0 +1,171 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"flag"
+	"log"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"fourleaves.studio/manga-scraper/internal/scraper/browser"
+	"fourleaves.studio/manga-scraper/internal/scraper/fetch"
+	"fourleaves.studio/manga-scraper/internal/scraper/scrapertest"
+)
+
+// Refreshes the HTML snapshots used by the provider scraper tests, ex:
+//
+//	go run ./cmd/scraper-snapshot -dir internal/scraper/asura/testdata
+//	go run ./cmd/scraper-snapshot -all
+//
+// Afterwards run UPDATE_GOLDEN=1 go test ./internal/scraper/... to record the new results.
+func main() {
+	dir := flag.String("dir", "", "testdata directory of a single provider")
+	all := flag.Bool("all", false, "refresh every provider under internal/scraper")
+	browserURL := flag.String("browser", "", "render pages in the browser at this URL instead of plain HTTP")
+	flag.Parse()
+
+	var dirs []string
+
+	switch {
+	case *all:
+		matches, err := filepath.Glob(filepath.Join("internal", "scraper", "*", "testdata", "sources.json"))
+		if err != nil {
+			log.Fatal("[main] failed to find providers: ", err)
+		}
+
+		for _, m := range matches {
+			dirs = append(dirs, filepath.Dir(m))
+		}
+	case *dir != "":
+		dirs = append(dirs, *dir)
+	default:
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	logger, err := zap.NewDevelopment()
+	if err != nil {
+		log.Fatal("[main] failed to create logger: ", err)
+	}
+
+	var get func(ctx context.Context, url string) ([]byte, error)
+
+	if *browserURL != "" {
+		pool := browser.NewPool(*browserURL, 1, 0, logger)
+		defer pool.Close()
+
+		get = func(ctx context.Context, url string) ([]byte, error) {
+			page, release, err := pool.Page(ctx, url)
+			if err != nil {
+				return nil, err
+			}
+
+			defer release()
+
+			if err := page.WaitLoad(); err != nil {
+				return nil, err
+			}
+
+			html, err := page.HTML()
+			if err != nil {
+				return nil, err
+			}
+
+			return []byte(html), nil
+		}
+	} else {
+		get = fetch.NewClient(30 * time.Second).Get
+	}
+
+	failed := false
+
+	for _, d := range dirs {
+		if err := refresh(d, get, logger); err != nil {
+			logger.Error("failed to refresh snapshots", zap.String("dir", d), zap.Error(err))
+			failed = true
+		}
+	}
+
+	if failed {
+		os.Exit(1)
+	}
+}
+
+var readerURLRegex = regexp.MustCompile(`"(?:prevUrl|nextUrl)"\s*:\s*"([^"]+)"`)
+
+func refresh(dir string, get func(ctx context.Context, url string) ([]byte, error), logger *zap.Logger) error {
+	b, err := os.ReadFile(filepath.Join(dir, "sources.json"))
+	if err != nil {
+		return err
+	}
+
+	var sources scrapertest.Sources
+	if err := json.Unmarshal(b, &sources); err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	if err := os.RemoveAll(filepath.Join(dir, "pages")); err != nil {
+		return err
+	}
+
+	for _, p := range []string{sources.SeriesList, sources.Series} {
+		if _, err := save(ctx, dir, sources.BaseURL, p, get, logger); err != nil {
+			return err
+		}
+	}
+
+	chapter, err := save(ctx, dir, sources.BaseURL, sources.Chapter, get, logger)
+	if err != nil {
+		return err
+	}
+
+	// the chapter detail scraper also visits the previous and next chapter
+	for _, m := range readerURLRegex.FindAllSubmatch(chapter, -1) {
+		u, err := url.Parse(strings.ReplaceAll(string(m[1]), `\/`, "/"))
+		if err != nil || u.Path == "" {
+			continue
+		}
+
+		if _, err := save(ctx, dir, sources.BaseURL, u.Path, get, logger); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func save(ctx context.Context, dir, baseURL, p string, get func(ctx context.Context, url string) ([]byte, error), logger *zap.Logger) ([]byte, error) {
+	b, err := get(ctx, baseURL+p)
+	if err != nil {
+		return nil, err
+	}
+
+	b = bytes.ReplaceAll(b, []byte(strings.ReplaceAll(baseURL, "/", `\/`)), []byte(scrapertest.BaseURLPlaceholder))
+	b = scrapertest.Collapse(b, baseURL)
+
+	name := filepath.Join(dir, "pages", filepath.FromSlash(path.Clean("/"+p)), "index.html")
+
+	if err := os.MkdirAll(filepath.Dir(name), 0o750); err != nil {
+		return nil, err
+	}
+
+	if err := os.WriteFile(name, b, 0o600); err != nil {
+		return nil, err
+	}
+
+	logger.Info("Saved snapshot", zap.String("url", baseURL+p), zap.String("file", name))
+
+	return b, nil
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"log"
 	"time"
 
@@ -9,7 +10,11 @@ import (
 
 	"fourleaves.studio/manga-scraper/internal/config"
 	"fourleaves.studio/manga-scraper/internal/database/prisma"
+	"fourleaves.studio/manga-scraper/internal/database/redis"
+	kafkaDomain "fourleaves.studio/manga-scraper/internal/kafka"
 	"fourleaves.studio/manga-scraper/internal/scraper"
+	"fourleaves.studio/manga-scraper/internal/scraper/browser"
+	"fourleaves.studio/manga-scraper/internal/scraper/fetch"
 )
 
 func main() {
@@ -42,7 +47,7 @@ func main() {
 		log.Fatal("[main] failed to create kafka client: ", err)
 	}
 
-	if err := kafkaClient.Subscribe("scrape-request", nil); err != nil {
+	if err := kafkaClient.SubscribeTopics([]string{kafkaDomain.ScrapeRequestTopic, kafkaDomain.ScrapeRequestRetryTopic}, nil); err != nil {
 		log.Fatal("[main] failed to subscribe to kafka topic: ", err)
 	}
 
@@ -51,18 +56,64 @@ func main() {
 		log.Fatal("[main] failed to create logger: ", err)
 	}
 
+	kafkaProducer, err := kafka.NewProducer(&kafka.ConfigMap{
+		"bootstrap.servers": envConfig.KafkaURL,
+	})
+	if err != nil {
+		log.Fatal("[main] failed to create kafka producer: ", err)
+	}
+
+	// log.Fatal skips deferred calls, so the fatal paths below close the producer themselves
+	closeProducer := func() {
+		kafkaProducer.Flush(5000)
+		kafkaProducer.Close()
+	}
+	defer closeProducer()
+
+	// deliveries are reported to each publish, the events channel only carries client errors
+	go func() {
+		for e := range kafkaProducer.Events() {
+			if kErr, ok := e.(kafka.Error); ok {
+				logger.Error("kafka producer error", zap.Error(kErr))
+			}
+		}
+	}()
+
+	browserPool := browser.NewPool(envConfig.RodURL, envConfig.BrowserPoolSize, envConfig.BrowserPageMaxUses, logger)
+	browserPool.StartHealthCheck(context.Background(), envConfig.BrowserHealthInterval)
+
+	fetchClient := fetch.NewClient(30 * time.Second)
+
+	providerRepo := prisma.NewProviderRepo(dbClient)
 	seriesRepo := prisma.NewSeriesRepo(dbClient)
 	chapterRepo := prisma.NewChapterRepo(dbClient)
 	scraperRepo := prisma.NewScraperRepo(dbClient)
 
-	scraperService := scraper.NewScraper(scraperRepo, seriesRepo, chapterRepo, kafkaClient, logger, envConfig.RodURL)
+	scraperMessageBroker := kafkaDomain.NewScraperMessageBroker(kafkaProducer)
+	chapterMessageBroker := kafkaDomain.NewChapterMessageBroker(kafkaProducer)
+	cacheInvalidator := redis.NewInvalidator(envConfig.RedisURL)
+
+	retryPolicy := scraper.RetryPolicy{
+		MaxAttempts: envConfig.ScrapeMaxAttempts,
+		BaseDelay:   envConfig.ScrapeRetryBaseDelay,
+		MaxDelay:    envConfig.ScrapeRetryMaxDelay,
+	}
+
+	concurrency := scraper.Concurrency{
+		Workers:     envConfig.ScraperConcurrency,
+		PerProvider: envConfig.ScraperProviderConcurrency,
+	}
+
+	scraperService := scraper.NewScraper(scraperRepo, providerRepo, seriesRepo, chapterRepo, kafkaClient, logger, browserPool, fetchClient, scraperMessageBroker, chapterMessageBroker, cacheInvalidator, retryPolicy, concurrency)
 
 	errC, err := scraperService.StartServer()
 	if err != nil {
+		closeProducer()
 		log.Fatal("[main] couldn't run: ", err)
 	}
 
 	if err := <-errC; err != nil {
+		closeProducer()
 		log.Fatal("[main] error while running: ", err)
 	}
 }
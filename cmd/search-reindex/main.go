@@ -0,0 +1,107 @@
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"flag"
+	"log"
+	"net/http"
+	"os"
+
+	"github.com/opensearch-project/opensearch-go/v2"
+	"go.uber.org/zap"
+
+	"fourleaves.studio/manga-scraper/internal"
+	"fourleaves.studio/manga-scraper/internal/config"
+	"fourleaves.studio/manga-scraper/internal/database/prisma"
+	"fourleaves.studio/manga-scraper/internal/elasticsearch"
+	"fourleaves.studio/manga-scraper/internal/service"
+)
+
+// Rebuilds the search indices from MySQL, each provider into a new index swapped in once complete, ex:
+//
+//	go run ./cmd/search-reindex -provider asura
+//	go run ./cmd/search-reindex -all
+func main() {
+	provider := flag.String("provider", "", "rebuild the index of a single provider")
+	all := flag.Bool("all", false, "rebuild the index of every provider")
+	flag.Parse()
+
+	if *provider == "" && !*all {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	envConfig, err := config.LoadConfig(".env")
+	if err != nil {
+		log.Fatal("[main] failed to load config: ", err)
+	}
+
+	dbClient := prisma.NewClient(prisma.WithDatasourceURL(envConfig.DBURL))
+	if err := dbClient.Connect(); err != nil {
+		log.Fatal("[main] failed to connect to database: ", err)
+	}
+	defer func() {
+		if err := dbClient.Disconnect(); err != nil {
+			log.Fatal("[main] failed to disconnect from database: ", err)
+		}
+	}()
+
+	esClient, err := opensearch.NewClient(opensearch.Config{
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: true}, // nolint:gosec
+		},
+		Addresses: []string{envConfig.SearchURL},
+	})
+	if err != nil {
+		log.Fatal("[main] failed to create elasticsearch client: ", err)
+	}
+
+	logger, err := zap.NewProduction()
+	if err != nil {
+		log.Fatal("[main] failed to create logger: ", err)
+	}
+
+	ctx := context.Background()
+
+	seriesSearch := elasticsearch.NewSeriesSearchRepository(esClient)
+	if err := seriesSearch.PutTemplates(ctx); err != nil {
+		log.Fatal("[main] failed to put search index templates: ", err)
+	}
+
+	reindexService := service.NewReindexService(
+		prisma.NewReindexJobRepo(dbClient),
+		prisma.NewSeriesRepo(dbClient),
+		prisma.NewSeriesChangeRepo(dbClient),
+		prisma.NewProviderRepo(dbClient),
+		seriesSearch,
+	)
+
+	var jobs []internal.ReindexJob
+
+	if *all {
+		jobs, err = reindexService.RunAll(ctx)
+	} else {
+		var job internal.ReindexJob
+
+		job, err = reindexService.RunProvider(ctx, *provider)
+		if job.ID != "" {
+			jobs = append(jobs, job)
+		}
+	}
+
+	for _, job := range jobs {
+		logger.Info("reindex job finished",
+			zap.String("provider", job.Provider),
+			zap.String("status", string(job.Status)),
+			zap.String("index", job.Index),
+			zap.Int("indexed", job.Indexed),
+			zap.Int("total", job.Total),
+			zap.String("message", job.Message),
+		)
+	}
+
+	if err != nil {
+		logger.Fatal("reindex failed", zap.Error(err))
+	}
+}
@@ -0,0 +1,76 @@
+package main
+
+import (
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"go.uber.org/zap"
+
+	"fourleaves.studio/manga-scraper/internal/config"
+	"fourleaves.studio/manga-scraper/internal/database/prisma"
+	kafkaDomain "fourleaves.studio/manga-scraper/internal/kafka"
+	"fourleaves.studio/manga-scraper/internal/webhook"
+)
+
+func main() {
+	// Set local timezone to Asia/Singapore
+	loc, err := time.LoadLocation("Asia/Singapore")
+	if err != nil {
+		log.Fatal("[main] failed to load location: ", err)
+	}
+
+	time.Local = loc
+
+	// Load config from .env file
+	envConfig, err := config.LoadConfig(".env")
+	if err != nil {
+		log.Fatal("[main] failed to load config: ", err)
+	}
+
+	dbClient := prisma.NewClient(prisma.WithDatasourceURL(envConfig.DBURL))
+	if err := dbClient.Connect(); err != nil {
+		log.Fatal("[main] failed to connect to database: ", err)
+	}
+
+	kafkaClient, err := kafka.NewConsumer(&kafka.ConfigMap{
+		"bootstrap.servers":  envConfig.KafkaURL,
+		"group.id":           "webhook-worker",
+		"auto.offset.reset":  "earliest",
+		"enable.auto.commit": false,
+	})
+	if err != nil {
+		log.Fatal("[main] failed to create kafka client: ", err)
+	}
+
+	if err := kafkaClient.SubscribeTopics([]string{kafkaDomain.ChapterReleasedTopic}, nil); err != nil {
+		log.Fatal("[main] failed to subscribe to kafka topic: ", err)
+	}
+
+	logger, err := zap.NewProduction()
+	if err != nil {
+		log.Fatal("[main] failed to create logger: ", err)
+	}
+
+	webhookRepo := prisma.NewWebhookRepo(dbClient)
+
+	retryPolicy := webhook.RetryPolicy{
+		MaxAttempts: envConfig.WebhookMaxAttempts,
+		BaseDelay:   envConfig.WebhookRetryBaseDelay,
+		MaxDelay:    envConfig.WebhookRetryMaxDelay,
+	}
+
+	httpClient := &http.Client{Timeout: envConfig.WebhookTimeout}
+
+	webhookService := webhook.NewWorker(webhookRepo, kafkaClient, httpClient, logger, retryPolicy, envConfig.WebhookPollInterval)
+
+	errC, err := webhookService.StartServer()
+	if err != nil {
+		log.Fatal("[main] couldn't run: ", err)
+	}
+
+	if err := <-errC; err != nil {
+		log.Fatal("[main] error while running: ", err)
+	}
+}
@@ -24,16 +24,1909 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
+        "/api/v1/apikeys": {
+            "get": {
+                "security": [
+                    {
+                        "TokenAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Get all API keys, including the revoked ones",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "apikeys"
+                ],
+                "summary": "Get all API keys",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "TokenAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Create an API key with scopes, a rate limit per minute and a daily quota, 0 is unlimited. The key is only returned here.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "apikeys"
+                ],
+                "summary": "Create API key",
+                "parameters": [
+                    {
+                        "description": "Request body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/CreateAPIKeyRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/apikeys/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "TokenAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Get API key by ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "apikeys"
+                ],
+                "summary": "Get API key by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "550e8400-e29b-41d4-a716-446655440000",
+                        "description": "API key ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "TokenAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Replace the name, scopes and limits of an API key, the key itself is kept",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "apikeys"
+                ],
+                "summary": "Update API key by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "550e8400-e29b-41d4-a716-446655440000",
+                        "description": "API key ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Request body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/UpdateAPIKeyRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/apikeys/{id}/_revoke": {
+            "post": {
+                "security": [
+                    {
+                        "TokenAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Revoke an API key for good, its requests are rejected right away",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "apikeys"
+                ],
+                "summary": "Revoke API key by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "550e8400-e29b-41d4-a716-446655440000",
+                        "description": "API key ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/apikeys/{id}/_rotate": {
+            "post": {
+                "security": [
+                    {
+                        "TokenAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Replace the key of an API key, the previous key stops working right away. The new key is only returned here.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "apikeys"
+                ],
+                "summary": "Rotate API key by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "550e8400-e29b-41d4-a716-446655440000",
+                        "description": "API key ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/apikeys/{id}/usage": {
+            "get": {
+                "security": [
+                    {
+                        "TokenAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Get the requests of an API key in the current minute and UTC day",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "apikeys"
+                ],
+                "summary": "Get API key usage",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "550e8400-e29b-41d4-a716-446655440000",
+                        "description": "API key ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    }
+                }
+            }
+        },
         "/api/v1/chapters/{provider_slug}/{series_slug}": {
             "get": {
-                "description": "Get paginated chapter list",
+                "description": "Get paginated chapter list",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "chapters"
+                ],
+                "summary": "Get paginated chapter list",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "asura",
+                        "description": "Provider slug",
+                        "name": "provider_slug",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "reincarnator",
+                        "description": "Series slug",
+                        "name": "series_slug",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "asc",
+                        "description": "Sort order",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor from a previous page, takes precedence over page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "10",
+                        "description": "Page, only used without a cursor",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "100",
+                        "description": "Size",
+                        "name": "size",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "304": {
+                        "description": "Not Modified"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/chapters/{provider_slug}/{series_slug}/_all": {
+            "get": {
+                "description": "Get all chapter list",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "chapters"
+                ],
+                "summary": "Get all chapter list",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "asura",
+                        "description": "Provider slug",
+                        "name": "provider_slug",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "reincarnator",
+                        "description": "Series slug",
+                        "name": "series_slug",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "asc",
+                        "description": "Sort order",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "304": {
+                        "description": "Not Modified"
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/chapters/{provider_slug}/{series_slug}/_list": {
+            "get": {
+                "description": "Get chapter list with series",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "chapters"
+                ],
+                "summary": "Get chapter list with series",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "asura",
+                        "description": "Provider slug",
+                        "name": "provider_slug",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "reincarnator",
+                        "description": "Series slug",
+                        "name": "series_slug",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "asc",
+                        "description": "Sort order",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "304": {
+                        "description": "Not Modified"
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/chapters/{provider_slug}/{series_slug}/{chapter_slug}": {
+            "get": {
+                "description": "Get chapter by slug",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "chapters"
+                ],
+                "summary": "Get chapter by slug",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "asura",
+                        "description": "Provider slug",
+                        "name": "provider_slug",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "reincarnator",
+                        "description": "Series slug",
+                        "name": "series_slug",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "reincarnator-chapter-0",
+                        "description": "Chapter slug",
+                        "name": "chapter_slug",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "304": {
+                        "description": "Not Modified"
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/chapters/{provider_slug}/{series_slug}/{chapter_slug}/_bc": {
+            "get": {
+                "description": "Get chapter breadcrumbs",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "chapters"
+                ],
+                "summary": "Get chapter breadcrumbs",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "asura",
+                        "description": "Provider slug",
+                        "name": "provider_slug",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "reincarnator",
+                        "description": "Series slug",
+                        "name": "series_slug",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "reincarnator-chapter-0",
+                        "description": "Chapter slug",
+                        "name": "chapter_slug",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "304": {
+                        "description": "Not Modified"
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/providers": {
+            "get": {
+                "description": "Get provider list",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "providers"
+                ],
+                "summary": "Get provider list",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "304": {
+                        "description": "Not Modified"
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "TokenAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Create provider",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "providers"
+                ],
+                "summary": "Create provider",
+                "parameters": [
+                    {
+                        "description": "Request body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/CreateProviderRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/providers/{provider_slug}": {
+            "get": {
+                "description": "Get provider by slug",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "providers"
+                ],
+                "summary": "Get provider by slug",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "asura",
+                        "description": "Provider slug",
+                        "name": "provider_slug",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "304": {
+                        "description": "Not Modified"
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "TokenAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Update provider, selectors, fetch modes and rate limit are kept when omitted and an empty selectors object clears the selectors",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "providers"
+                ],
+                "summary": "Update provider",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "asura",
+                        "description": "Provider slug",
+                        "name": "provider_slug",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Request body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/UpdateProviderRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/providers/{provider_slug}/_bc": {
+            "get": {
+                "description": "Get provider breadcrumbs",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "providers"
+                ],
+                "summary": "Get provider breadcrumbs",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "asura",
+                        "description": "Provider slug",
+                        "name": "provider_slug",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "304": {
+                        "description": "Not Modified"
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/scrapers": {
+            "get": {
+                "security": [
+                    {
+                        "TokenAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Get paginated scrape requests filtered by type, provider, series, status, error flag and creation time",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "scrapers"
+                ],
+                "summary": "Get paginated scrape requests",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "desc",
+                        "description": "Sort by creation time",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "1",
+                        "description": "Page",
+                        "name": "page",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "10",
+                        "description": "Size",
+                        "name": "size",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "CHAPTER_DETAIL",
+                        "description": "Request type",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "asura",
+                        "description": "Provider slug",
+                        "name": "provider",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "reincarnator",
+                        "description": "Series slug",
+                        "name": "series",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "FAILED",
+                        "description": "Request status",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "true",
+                        "description": "Error flag",
+                        "name": "error",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "2024-07-01T00:00:00Z",
+                        "description": "Created at or after (RFC3339)",
+                        "name": "created_after",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "2024-07-02T00:00:00Z",
+                        "description": "Created before (RFC3339)",
+                        "name": "created_before",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "TokenAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Create scrape request",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "scrapers"
+                ],
+                "summary": "Create scrape request",
+                "parameters": [
+                    {
+                        "description": "Request body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/CreateScrapeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "TokenAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Delete every scrape request created before the given time, pending requests are never deleted",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "scrapers"
+                ],
+                "summary": "Bulk delete scrape requests",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "COMPLETED",
+                        "description": "Request status",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "2024-07-01T00:00:00Z",
+                        "description": "Created before (RFC3339)",
+                        "name": "created_before",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/scrapers/_dead_letters": {
+            "get": {
+                "security": [
+                    {
+                        "TokenAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Get paginated scrape requests that failed after every retry attempt",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "scrapers"
+                ],
+                "summary": "Get dead-lettered scrape requests",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "desc",
+                        "description": "Sort by creation time",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "1",
+                        "description": "Page",
+                        "name": "page",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "10",
+                        "description": "Size",
+                        "name": "size",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/scrapers/_retry": {
+            "post": {
+                "security": [
+                    {
+                        "TokenAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Publish matching failed scrape requests again, requests whose target is already pending are skipped",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "scrapers"
+                ],
+                "summary": "Retry failed scrape requests",
+                "parameters": [
+                    {
+                        "description": "Request body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/RetryScrapeRequestsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/scrapers/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "TokenAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Get scrape request by ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "scrapers"
+                ],
+                "summary": "Get scrape request by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "550e8400-e29b-41d4-a716-446655440000",
+                        "description": "Request ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "TokenAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Delete scrape request by ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "scrapers"
+                ],
+                "summary": "Delete scrape request by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "550e8400-e29b-41d4-a716-446655440000",
+                        "description": "Request ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/scrapers/{id}/_cancel": {
+            "post": {
+                "security": [
+                    {
+                        "TokenAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Cancel a pending scrape request, the scraper worker skips it once it is consumed",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "scrapers"
+                ],
+                "summary": "Cancel scrape request",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "550e8400-e29b-41d4-a716-446655440000",
+                        "description": "Request ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/series": {
+            "get": {
+                "description": "Get series search result, without a query the series of every provider are browsed by cursor",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "series"
+                ],
+                "summary": "Get series search result",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "solo lev",
+                        "description": "Query",
+                        "name": "q",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "asura",
+                        "description": "Provider slug, only used with a query",
+                        "name": "provider",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "example": 0,
+                        "description": "Offset of the hits, only used with a query",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor from a previous page, only used without a query",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "100",
+                        "description": "Size, required without a query",
+                        "name": "size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Genres, repeated or comma separated",
+                        "name": "genres",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "any",
+                            "all"
+                        ],
+                        "type": "string",
+                        "description": "Match any or all genres, only used without a query",
+                        "name": "genres_match",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "ONGOING",
+                            "COMPLETED",
+                            "HIATUS",
+                            "DROPPED"
+                        ],
+                        "type": "string",
+                        "description": "Status",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "example": 10,
+                        "description": "Minimum chapters count, only used without a query",
+                        "name": "min_chapters",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "2024-07-01T00:00:00Z",
+                        "description": "Updated since, RFC 3339, only used without a query",
+                        "name": "updated_since",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "slug",
+                            "title",
+                            "chapters",
+                            "updated",
+                            "latest"
+                        ],
+                        "type": "string",
+                        "description": "Sort by, only used without a query",
+                        "name": "sort_by",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "Sort order, only used without a query",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "304": {
+                        "description": "Not Modified"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/series/_reindex/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "TokenAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Get the status and progress of a search reindex job",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "chapters"
+                    "series"
                 ],
-                "summary": "Get paginated chapter list",
+                "summary": "Get reindex job",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Job ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/series/_suggest": {
+            "get": {
+                "description": "Get title completions across providers, tolerating typos, for search-as-you-type",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "series"
+                ],
+                "summary": "Get series title suggestions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "solo lev",
+                        "description": "Partly typed title",
+                        "name": "q",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "example": 5,
+                        "description": "Size, 5 when empty",
+                        "name": "size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "304": {
+                        "description": "Not Modified"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/series/{provider_slug}": {
+            "get": {
+                "description": "Get paginated series list, filtered and sorted lists are paged by cursor only",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "series"
+                ],
+                "summary": "Get paginated series list",
                 "parameters": [
                     {
                         "type": "string",
@@ -45,45 +1938,166 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "example": "reincarnator",
-                        "description": "Series slug",
-                        "name": "series_slug",
-                        "in": "path",
-                        "required": true
+                        "description": "Cursor from a previous page, takes precedence over page",
+                        "name": "cursor",
+                        "in": "query"
                     },
                     {
                         "type": "string",
-                        "default": "asc",
+                        "example": "10",
+                        "description": "Page, only used without a cursor and filters",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Genres, repeated or comma separated",
+                        "name": "genres",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "any",
+                            "all"
+                        ],
+                        "type": "string",
+                        "description": "Match any or all genres",
+                        "name": "genres_match",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "ONGOING",
+                            "COMPLETED",
+                            "HIATUS",
+                            "DROPPED"
+                        ],
+                        "type": "string",
+                        "description": "Status",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "example": 10,
+                        "description": "Minimum chapters count",
+                        "name": "min_chapters",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "2024-07-01T00:00:00Z",
+                        "description": "Updated since, RFC 3339",
+                        "name": "updated_since",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "slug",
+                            "title",
+                            "chapters",
+                            "updated",
+                            "latest"
+                        ],
+                        "type": "string",
+                        "description": "Sort by",
+                        "name": "sort_by",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
                         "description": "Sort order",
                         "name": "sort",
                         "in": "query"
                     },
                     {
-                        "type": "string",
-                        "example": "10",
-                        "description": "Page",
-                        "name": "page",
-                        "in": "query",
-                        "required": true
-                    },
+                        "type": "string",
+                        "example": "100",
+                        "description": "Size",
+                        "name": "size",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "304": {
+                        "description": "Not Modified"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "TokenAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Start rebuilding the search index of a provider into a new index, searches switch to it once every series is indexed",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "series"
+                ],
+                "summary": "Reindex the series of a provider",
+                "parameters": [
                     {
                         "type": "string",
-                        "example": "100",
-                        "description": "Size",
-                        "name": "size",
-                        "in": "query",
+                        "description": "Provider Slug",
+                        "name": "provider_slug",
+                        "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "202": {
+                        "description": "Accepted",
                         "schema": {
                             "$ref": "#/definitions/ResponseV1"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "$ref": "#/definitions/ResponseV1"
                         }
@@ -94,6 +2108,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/ResponseV1"
                         }
                     },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -103,16 +2123,16 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/chapters/{provider_slug}/{series_slug}/_all": {
+        "/api/v1/series/{provider_slug}/_all": {
             "get": {
-                "description": "Get all chapter list",
+                "description": "Get all series list",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "chapters"
+                    "series"
                 ],
-                "summary": "Get all chapter list",
+                "summary": "Get all series list",
                 "parameters": [
                     {
                         "type": "string",
@@ -122,14 +2142,6 @@ const docTemplate = `{
                         "in": "path",
                         "required": true
                     },
-                    {
-                        "type": "string",
-                        "example": "reincarnator",
-                        "description": "Series slug",
-                        "name": "series_slug",
-                        "in": "path",
-                        "required": true
-                    },
                     {
                         "type": "string",
                         "default": "asc",
@@ -145,6 +2157,9 @@ const docTemplate = `{
                             "$ref": "#/definitions/ResponseV1"
                         }
                     },
+                    "304": {
+                        "description": "Not Modified"
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -160,16 +2175,16 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/chapters/{provider_slug}/{series_slug}/_list": {
+        "/api/v1/series/{provider_slug}/{series_slug}": {
             "get": {
-                "description": "Get chapter list with series",
+                "description": "Get series by slug",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "chapters"
+                    "series"
                 ],
-                "summary": "Get chapter list with series",
+                "summary": "Get series by slug",
                 "parameters": [
                     {
                         "type": "string",
@@ -186,13 +2201,6 @@ const docTemplate = `{
                         "name": "series_slug",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "default": "asc",
-                        "description": "Sort order",
-                        "name": "sort",
-                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -202,6 +2210,9 @@ const docTemplate = `{
                             "$ref": "#/definitions/ResponseV1"
                         }
                     },
+                    "304": {
+                        "description": "Not Modified"
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -217,16 +2228,16 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/chapters/{provider_slug}/{series_slug}/{chapter_slug}": {
+        "/api/v1/series/{provider_slug}/{series_slug}/_bc": {
             "get": {
-                "description": "Get chapter by slug",
+                "description": "Get series breadcrumbs",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "chapters"
+                    "series"
                 ],
-                "summary": "Get chapter by slug",
+                "summary": "Get series breadcrumbs",
                 "parameters": [
                     {
                         "type": "string",
@@ -243,14 +2254,6 @@ const docTemplate = `{
                         "name": "series_slug",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "example": "reincarnator-chapter-0",
-                        "description": "Chapter slug",
-                        "name": "chapter_slug",
-                        "in": "path",
-                        "required": true
                     }
                 ],
                 "responses": {
@@ -260,6 +2263,9 @@ const docTemplate = `{
                             "$ref": "#/definitions/ResponseV1"
                         }
                     },
+                    "304": {
+                        "description": "Not Modified"
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -275,16 +2281,24 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/chapters/{provider_slug}/{series_slug}/{chapter_slug}/_bc": {
-            "get": {
-                "description": "Get chapter breadcrumbs",
+        "/api/v1/series/{provider_slug}/{series_slug}/_sync": {
+            "put": {
+                "security": [
+                    {
+                        "TokenAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Recompute the chapters count and latest chapter of the series from its chapters and reindex it",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "chapters"
+                    "series"
                 ],
-                "summary": "Get chapter breadcrumbs",
+                "summary": "Sync series chapters count and latest chapter",
                 "parameters": [
                     {
                         "type": "string",
@@ -301,14 +2315,6 @@ const docTemplate = `{
                         "name": "series_slug",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "example": "reincarnator-chapter-0",
-                        "description": "Chapter slug",
-                        "name": "chapter_slug",
-                        "in": "path",
-                        "required": true
                     }
                 ],
                 "responses": {
@@ -318,6 +2324,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/ResponseV1"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -333,16 +2351,24 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/providers": {
+        "/api/v1/webhooks": {
             "get": {
-                "description": "Get provider list",
+                "security": [
+                    {
+                        "TokenAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Get all webhook subscriptions",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "providers"
+                    "webhooks"
                 ],
-                "summary": "Get provider list",
+                "summary": "Get all webhook subscriptions",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -350,8 +2376,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/ResponseV1"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "$ref": "#/definitions/ResponseV1"
                         }
@@ -368,9 +2400,12 @@ const docTemplate = `{
                 "security": [
                     {
                         "TokenAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
                     }
                 ],
-                "description": "Create provider",
+                "description": "Subscribe a URL to chapter.released events, optionally filtered by provider or series. The signing secret is only returned here.",
                 "consumes": [
                     "application/json"
                 ],
@@ -378,9 +2413,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "providers"
+                    "webhooks"
                 ],
-                "summary": "Create provider",
+                "summary": "Create webhook subscription",
                 "parameters": [
                     {
                         "description": "Request body",
@@ -388,7 +2423,7 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/CreateProviderRequest"
+                            "$ref": "#/definitions/CreateWebhookRequest"
                         }
                     }
                 ],
@@ -417,8 +2452,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/ResponseV1"
                         }
                     },
-                    "409": {
-                        "description": "Conflict",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/ResponseV1"
                         }
@@ -432,22 +2467,30 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/providers/{provider_slug}": {
+        "/api/v1/webhooks/{id}": {
             "get": {
-                "description": "Get provider by slug",
+                "security": [
+                    {
+                        "TokenAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Get webhook subscription by ID",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "providers"
+                    "webhooks"
                 ],
-                "summary": "Get provider by slug",
+                "summary": "Get webhook subscription by ID",
                 "parameters": [
                     {
                         "type": "string",
-                        "example": "asura",
-                        "description": "Provider slug",
-                        "name": "provider_slug",
+                        "example": "550e8400-e29b-41d4-a716-446655440000",
+                        "description": "Subscription ID",
+                        "name": "id",
                         "in": "path",
                         "required": true
                     }
@@ -459,6 +2502,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/ResponseV1"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -477,9 +2532,12 @@ const docTemplate = `{
                 "security": [
                     {
                         "TokenAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
                     }
                 ],
-                "description": "Update provider",
+                "description": "Replace the URL, filters and state of a webhook subscription, the secret is only rotated when a new one is given",
                 "consumes": [
                     "application/json"
                 ],
@@ -487,15 +2545,15 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "providers"
+                    "webhooks"
                 ],
-                "summary": "Update provider",
+                "summary": "Update webhook subscription by ID",
                 "parameters": [
                     {
                         "type": "string",
-                        "example": "asura",
-                        "description": "Provider slug",
-                        "name": "provider_slug",
+                        "example": "550e8400-e29b-41d4-a716-446655440000",
+                        "description": "Subscription ID",
+                        "name": "id",
                         "in": "path",
                         "required": true
                     },
@@ -505,9 +2563,75 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/UpdateProviderRequest"
+                            "$ref": "#/definitions/UpdateWebhookRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
                         }
                     }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "TokenAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Delete webhook subscription by ID together with its delivery log",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Delete webhook subscription by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "550e8400-e29b-41d4-a716-446655440000",
+                        "description": "Subscription ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
                 "responses": {
                     "200": {
@@ -516,12 +2640,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/ResponseV1"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/ResponseV1"
-                        }
-                    },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
@@ -549,24 +2667,55 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/providers/{provider_slug}/_bc": {
+        "/api/v1/webhooks/{id}/deliveries": {
             "get": {
-                "description": "Get provider breadcrumbs",
+                "security": [
+                    {
+                        "TokenAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Get the delivery log of a webhook subscription, newest first",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "providers"
+                    "webhooks"
                 ],
-                "summary": "Get provider breadcrumbs",
+                "summary": "Get webhook deliveries",
                 "parameters": [
                     {
                         "type": "string",
-                        "example": "asura",
-                        "description": "Provider slug",
-                        "name": "provider_slug",
+                        "example": "550e8400-e29b-41d4-a716-446655440000",
+                        "description": "Subscription ID",
+                        "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "1",
+                        "description": "Page",
+                        "name": "page",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "10",
+                        "description": "Size",
+                        "name": "size",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "FAILED",
+                        "description": "Delivery status",
+                        "name": "status",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -576,6 +2725,24 @@ const docTemplate = `{
                             "$ref": "#/definitions/ResponseV1"
                         }
                     },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -591,38 +2758,52 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/scrapers": {
-            "post": {
+        "/api/v1/works/_candidates": {
+            "get": {
                 "security": [
                     {
                         "TokenAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
                     }
                 ],
-                "description": "Create scrape request",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Get the series suggested for a work by title or alias matching, newest first",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "scrapers"
+                    "works"
                 ],
-                "summary": "Create scrape request",
+                "summary": "Get work candidates",
                 "parameters": [
                     {
-                        "description": "Request body",
-                        "name": "body",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/CreateScrapeRequest"
-                        }
+                        "type": "string",
+                        "example": "1",
+                        "description": "Page",
+                        "name": "page",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "10",
+                        "description": "Size",
+                        "name": "size",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "PENDING",
+                        "description": "Candidate status",
+                        "name": "status",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "202": {
-                        "description": "Accepted",
+                    "200": {
+                        "description": "OK",
                         "schema": {
                             "$ref": "#/definitions/ResponseV1"
                         }
@@ -645,12 +2826,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/ResponseV1"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/ResponseV1"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -660,26 +2835,29 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/scrapers/{id}": {
-            "get": {
+        "/api/v1/works/_candidates/{id}/_confirm": {
+            "post": {
                 "security": [
                     {
                         "TokenAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
                     }
                 ],
-                "description": "Get scrape request by ID",
+                "description": "Link the candidate series into the candidate work, the series leaves its previous work",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "scrapers"
+                    "works"
                 ],
-                "summary": "Get scrape request by ID",
+                "summary": "Confirm work candidate",
                 "parameters": [
                     {
                         "type": "string",
                         "example": "550e8400-e29b-41d4-a716-446655440000",
-                        "description": "Request ID",
+                        "description": "Candidate ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -719,23 +2897,31 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/series": {
-            "get": {
-                "description": "Get series search result",
+        "/api/v1/works/_candidates/{id}/_reject": {
+            "post": {
+                "security": [
+                    {
+                        "TokenAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Reject the candidate, the series will not be suggested for the work again",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "series"
+                    "works"
                 ],
-                "summary": "Get series search result",
+                "summary": "Reject work candidate",
                 "parameters": [
                     {
                         "type": "string",
-                        "example": "warrior high school",
-                        "description": "Query",
-                        "name": "q",
-                        "in": "query",
+                        "example": "550e8400-e29b-41d4-a716-446655440000",
+                        "description": "Candidate ID",
+                        "name": "id",
+                        "in": "path",
                         "required": true
                     }
                 ],
@@ -746,8 +2932,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/ResponseV1"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "$ref": "#/definitions/ResponseV1"
                         }
@@ -767,40 +2959,24 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/series/{provider_slug}": {
+        "/api/v1/works/{id}": {
             "get": {
-                "description": "Get paginated series list",
+                "description": "Get a work with the series of every provider linked to it",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "series"
+                    "works"
                 ],
-                "summary": "Get paginated series list",
+                "summary": "Get work by ID",
                 "parameters": [
                     {
                         "type": "string",
-                        "example": "asura",
-                        "description": "Provider slug",
-                        "name": "provider_slug",
+                        "example": "550e8400-e29b-41d4-a716-446655440000",
+                        "description": "Work ID",
+                        "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "example": "10",
-                        "description": "Page",
-                        "name": "page",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "example": "100",
-                        "description": "Size",
-                        "name": "size",
-                        "in": "query",
-                        "required": true
                     }
                 ],
                 "responses": {
@@ -810,11 +2986,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/ResponseV1"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/ResponseV1"
-                        }
+                    "304": {
+                        "description": "Not Modified"
                     },
                     "404": {
                         "description": "Not Found",
@@ -834,23 +3007,39 @@ const docTemplate = `{
                 "security": [
                     {
                         "TokenAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
                     }
                 ],
-                "description": "Feed the open search engine",
+                "description": "Replace the canonical title and aliases of a work, aliases are used to match new series to the work",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "series"
+                    "works"
                 ],
-                "summary": "Feed the open search engine",
+                "summary": "Update work by ID",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Provider Slug",
-                        "name": "provider_slug",
+                        "example": "550e8400-e29b-41d4-a716-446655440000",
+                        "description": "Work ID",
+                        "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Request body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/UpdateWorkRequest"
+                        }
                     }
                 ],
                 "responses": {
@@ -860,6 +3049,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/ResponseV1"
                         }
                     },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
@@ -887,31 +3082,44 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/series/{provider_slug}/_all": {
-            "get": {
-                "description": "Get all series list",
+        "/api/v1/works/{id}/_merge": {
+            "post": {
+                "security": [
+                    {
+                        "TokenAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Move every series of the given work into this work and delete the given work, its aliases are kept",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "series"
+                    "works"
                 ],
-                "summary": "Get all series list",
+                "summary": "Merge works",
                 "parameters": [
                     {
                         "type": "string",
-                        "example": "asura",
-                        "description": "Provider slug",
-                        "name": "provider_slug",
+                        "example": "550e8400-e29b-41d4-a716-446655440000",
+                        "description": "Target work ID",
+                        "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "default": "asc",
-                        "description": "Sort order",
-                        "name": "sort",
-                        "in": "query"
+                        "description": "Request body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/MergeWorkRequest"
+                        }
                     }
                 ],
                 "responses": {
@@ -921,6 +3129,24 @@ const docTemplate = `{
                             "$ref": "#/definitions/ResponseV1"
                         }
                     },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -936,32 +3162,44 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/series/{provider_slug}/{series_slug}": {
-            "get": {
-                "description": "Get series by slug",
+        "/api/v1/works/{id}/_split": {
+            "post": {
+                "security": [
+                    {
+                        "TokenAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Move a wrongly linked series out of the work into a work of its own, it will not be suggested for this work again",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "series"
+                    "works"
                 ],
-                "summary": "Get series by slug",
+                "summary": "Split series from work",
                 "parameters": [
                     {
                         "type": "string",
-                        "example": "asura",
-                        "description": "Provider slug",
-                        "name": "provider_slug",
+                        "example": "550e8400-e29b-41d4-a716-446655440000",
+                        "description": "Work ID",
+                        "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "example": "reincarnator",
-                        "description": "Series slug",
-                        "name": "series_slug",
-                        "in": "path",
-                        "required": true
+                        "description": "Request body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/SplitWorkRequest"
+                        }
                     }
                 ],
                 "responses": {
@@ -971,6 +3209,24 @@ const docTemplate = `{
                             "$ref": "#/definitions/ResponseV1"
                         }
                     },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/ResponseV1"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -986,32 +3242,31 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/series/{provider_slug}/{series_slug}/_bc": {
+        "/api/v1/works/{id}/chapters": {
             "get": {
-                "description": "Get series breadcrumbs",
+                "description": "Get the chapters of every provider of a work merged by number, each chapter is served by the preferred provider that has its content and lists the other providers in sources",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "series"
+                    "works"
                 ],
-                "summary": "Get series breadcrumbs",
+                "summary": "Get work chapter list",
                 "parameters": [
                     {
                         "type": "string",
-                        "example": "asura",
-                        "description": "Provider slug",
-                        "name": "provider_slug",
+                        "example": "550e8400-e29b-41d4-a716-446655440000",
+                        "description": "Work ID",
+                        "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "example": "reincarnator",
-                        "description": "Series slug",
-                        "name": "series_slug",
-                        "in": "path",
-                        "required": true
+                        "default": "asc",
+                        "description": "Sort order",
+                        "name": "sort",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -1021,6 +3276,9 @@ const docTemplate = `{
                             "$ref": "#/definitions/ResponseV1"
                         }
                     },
+                    "304": {
+                        "description": "Not Modified"
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -1055,6 +3313,40 @@ const docTemplate = `{
         }
     },
     "definitions": {
+        "CreateAPIKeyRequest": {
+            "type": "object",
+            "required": [
+                "name",
+                "scopes"
+            ],
+            "properties": {
+                "daily_quota": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 10000
+                },
+                "name": {
+                    "type": "string",
+                    "example": "partner"
+                },
+                "rate_limit": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 60
+                },
+                "scopes": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "read",
+                        "scrape"
+                    ]
+                }
+            }
+        },
         "CreateProviderRequest": {
             "type": "object",
             "required": [
@@ -1066,6 +3358,9 @@ const docTemplate = `{
                 "slug"
             ],
             "properties": {
+                "fetch_modes": {
+                    "$ref": "#/definitions/internal.ProviderFetchModes"
+                },
                 "host": {
                     "type": "string",
                     "example": "asuratoon.com"
@@ -1082,10 +3377,16 @@ const docTemplate = `{
                     "type": "string",
                     "example": "Asura Scans"
                 },
+                "rate_limit": {
+                    "$ref": "#/definitions/internal.ProviderRateLimit"
+                },
                 "scheme": {
                     "type": "string",
                     "example": "https://"
                 },
+                "selectors": {
+                    "$ref": "#/definitions/internal.ProviderSelectors"
+                },
                 "slug": {
                     "type": "string",
                     "example": "asura"
@@ -1123,6 +3424,47 @@ const docTemplate = `{
                 }
             }
         },
+        "CreateWebhookRequest": {
+            "type": "object",
+            "required": [
+                "url"
+            ],
+            "properties": {
+                "is_active": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "provider": {
+                    "type": "string",
+                    "example": "asura"
+                },
+                "secret": {
+                    "type": "string",
+                    "minLength": 16,
+                    "example": "a-long-random-shared-secret"
+                },
+                "series": {
+                    "type": "string",
+                    "example": "reincarnator"
+                },
+                "url": {
+                    "type": "string",
+                    "example": "https://example.com/hooks/chapters"
+                }
+            }
+        },
+        "MergeWorkRequest": {
+            "type": "object",
+            "required": [
+                "work_id"
+            ],
+            "properties": {
+                "work_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                }
+            }
+        },
         "ResponseV1": {
             "type": "object",
             "properties": {
@@ -1136,6 +3478,100 @@ const docTemplate = `{
                 }
             }
         },
+        "RetryScrapeRequestsRequest": {
+            "type": "object",
+            "properties": {
+                "created_after": {
+                    "type": "string",
+                    "example": "2024-07-01T00:00:00Z"
+                },
+                "created_before": {
+                    "type": "string",
+                    "example": "2024-07-02T00:00:00Z"
+                },
+                "limit": {
+                    "type": "integer",
+                    "maximum": 1000,
+                    "example": 100
+                },
+                "message": {
+                    "type": "string",
+                    "example": "page.Navigate"
+                },
+                "provider": {
+                    "type": "string",
+                    "example": "asura"
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "FAILED",
+                        "DEAD_LETTER"
+                    ],
+                    "example": "FAILED"
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "SERIES_LIST",
+                        "SERIES_DETAIL",
+                        "CHAPTER_LIST",
+                        "CHAPTER_DETAIL"
+                    ],
+                    "example": "CHAPTER_DETAIL"
+                }
+            }
+        },
+        "SplitWorkRequest": {
+            "type": "object",
+            "required": [
+                "provider",
+                "series"
+            ],
+            "properties": {
+                "provider": {
+                    "type": "string",
+                    "example": "asura"
+                },
+                "series": {
+                    "type": "string",
+                    "example": "solo-leveling"
+                }
+            }
+        },
+        "UpdateAPIKeyRequest": {
+            "type": "object",
+            "required": [
+                "name",
+                "scopes"
+            ],
+            "properties": {
+                "daily_quota": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 50000
+                },
+                "name": {
+                    "type": "string",
+                    "example": "partner"
+                },
+                "rate_limit": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 120
+                },
+                "scopes": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "read"
+                    ]
+                }
+            }
+        },
         "UpdateProviderRequest": {
             "type": "object",
             "required": [
@@ -1146,6 +3582,9 @@ const docTemplate = `{
                 "scheme"
             ],
             "properties": {
+                "fetch_modes": {
+                    "$ref": "#/definitions/internal.ProviderFetchModes"
+                },
                 "host": {
                     "type": "string",
                     "example": "asuratoon.com"
@@ -1162,14 +3601,182 @@ const docTemplate = `{
                     "type": "string",
                     "example": "Asura Scans"
                 },
+                "rate_limit": {
+                    "$ref": "#/definitions/internal.ProviderRateLimit"
+                },
                 "scheme": {
                     "type": "string",
                     "example": "https://"
+                },
+                "selectors": {
+                    "$ref": "#/definitions/internal.ProviderSelectors"
+                }
+            }
+        },
+        "UpdateWebhookRequest": {
+            "type": "object",
+            "required": [
+                "is_active",
+                "url"
+            ],
+            "properties": {
+                "is_active": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "provider": {
+                    "type": "string",
+                    "example": "asura"
+                },
+                "secret": {
+                    "type": "string",
+                    "minLength": 16,
+                    "example": "a-long-random-shared-secret"
+                },
+                "series": {
+                    "type": "string",
+                    "example": "reincarnator"
+                },
+                "url": {
+                    "type": "string",
+                    "example": "https://example.com/hooks/chapters"
+                }
+            }
+        },
+        "UpdateWorkRequest": {
+            "type": "object",
+            "required": [
+                "aliases",
+                "title"
+            ],
+            "properties": {
+                "aliases": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "Only I Level Up",
+                        "Na Honjaman Level Up"
+                    ]
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Solo Leveling"
+                }
+            }
+        },
+        "internal.FetchMode": {
+            "type": "string",
+            "enum": [
+                "BROWSER",
+                "STATIC"
+            ],
+            "x-enum-varnames": [
+                "BrowserFetchMode",
+                "StaticFetchMode"
+            ]
+        },
+        "internal.ProviderFetchModes": {
+            "type": "object",
+            "properties": {
+                "chapterDetail": {
+                    "$ref": "#/definitions/internal.FetchMode"
+                },
+                "chapterList": {
+                    "$ref": "#/definitions/internal.FetchMode"
+                },
+                "seriesDetail": {
+                    "$ref": "#/definitions/internal.FetchMode"
+                },
+                "seriesList": {
+                    "$ref": "#/definitions/internal.FetchMode"
+                }
+            }
+        },
+        "internal.ProviderRateLimit": {
+            "type": "object",
+            "properties": {
+                "burst": {
+                    "type": "integer"
+                },
+                "jitterMaxMs": {
+                    "type": "integer"
+                },
+                "jitterMinMs": {
+                    "type": "integer"
+                },
+                "maxInFlight": {
+                    "type": "integer"
+                },
+                "requestsPerMinute": {
+                    "type": "number"
+                }
+            }
+        },
+        "internal.ProviderSelectors": {
+            "type": "object",
+            "properties": {
+                "chapterFullTitle": {
+                    "type": "string"
+                },
+                "chapterListContainer": {
+                    "type": "string"
+                },
+                "chapterListLink": {
+                    "type": "string"
+                },
+                "chapterNumber": {
+                    "type": "string"
+                },
+                "chapterNumberAttr": {
+                    "type": "string"
+                },
+                "chapterTitle": {
+                    "type": "string"
+                },
+                "genres": {
+                    "type": "string"
+                },
+                "readerScript": {
+                    "type": "string"
+                },
+                "seriesInfo": {
+                    "type": "string"
+                },
+                "seriesInfoValue": {
+                    "type": "string"
+                },
+                "seriesListContainer": {
+                    "type": "string"
+                },
+                "seriesListLink": {
+                    "type": "string"
+                },
+                "shortlink": {
+                    "type": "string"
+                },
+                "synopsis": {
+                    "type": "string"
+                },
+                "synopsisFallback": {
+                    "type": "string"
+                },
+                "thumbnail": {
+                    "type": "string"
+                },
+                "thumbnailFallbackAttr": {
+                    "type": "string"
                 }
             }
         }
     },
     "securityDefinitions": {
+        "APIKeyAuth": {
+            "type": "apiKey",
+            "name": "X-API-Key",
+            "in": "header"
+        },
         "TokenAuth": {
             "type": "apiKey",
             "name": "Authorization",
@@ -3,6 +3,9 @@ module fourleaves.studio/manga-scraper
 go 1.22.3
 
 require (
+	github.com/PuerkitoBio/goquery v1.9.2
+	github.com/alicebob/miniredis/v2 v2.37.0
+	github.com/andybalholm/cascadia v1.3.2
 	github.com/clerk/clerk-sdk-go/v2 v2.0.4
 	github.com/confluentinc/confluent-kafka-go/v2 v2.4.0
 	github.com/getsentry/sentry-go v0.27.0
@@ -25,6 +28,9 @@ require (
 	go.uber.org/mock v0.4.0
 	go.uber.org/zap v1.21.0
 	goa.design/model v1.9.8
+	golang.org/x/sync v0.7.0
+	golang.org/x/text v0.15.0
+	golang.org/x/time v0.5.0
 )
 
 require (
@@ -77,6 +83,7 @@ require (
 	github.com/ysmood/got v0.34.1 // indirect
 	github.com/ysmood/gson v0.7.3 // indirect
 	github.com/ysmood/leakless v0.8.0 // indirect
+	github.com/yuin/gopher-lua v1.1.1 // indirect
 	go.uber.org/atomic v1.11.0 // indirect
 	go.uber.org/multierr v1.9.0 // indirect
 	goa.design/goa/v3 v3.16.2 // indirect
@@ -85,8 +92,6 @@ require (
 	golang.org/x/mod v0.17.0 // indirect
 	golang.org/x/net v0.25.0 // indirect
 	golang.org/x/sys v0.20.0 // indirect
-	golang.org/x/text v0.15.0 // indirect
-	golang.org/x/time v0.5.0 // indirect
 	golang.org/x/tools v0.21.0 // indirect
 	gopkg.in/ini.v1 v1.67.0 // indirect
 	gopkg.in/yaml.v2 v2.4.0 // indirect
@@ -0,0 +1,105 @@
+package internal
+
+import "time"
+
+type APIKeyScope string
+
+const (
+	// ReadAPIKeyScope lets a key read providers, series, chapters and works
+	ReadAPIKeyScope APIKeyScope = "read"
+	// ScrapeAPIKeyScope lets a key request and follow scrapes
+	ScrapeAPIKeyScope APIKeyScope = "scrape"
+	// AdminAPIKeyScope grants every scope, like an admin session
+	AdminAPIKeyScope APIKeyScope = "admin"
+)
+
+type APIKey struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	// Prefix is the start of the key, enough to tell keys apart without storing them
+	Prefix string `json:"prefix"`
+	// Hash is the stored hash of the key, the caches are keyed by it and it is never rendered
+	Hash   string        `json:"-"`
+	Scopes []APIKeyScope `json:"scopes"`
+	// RateLimit is the number of requests allowed per minute, 0 is unlimited
+	RateLimit int `json:"rateLimit"`
+	// DailyQuota is the number of requests allowed per UTC day, 0 is unlimited
+	DailyQuota int        `json:"dailyQuota"`
+	RevokedAt  *time.Time `json:"revokedAt,omitempty"`
+	// Key is only returned when the key is created or rotated, only its hash is stored
+	Key       string    `json:"key,omitempty"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+type APIKeyParams struct {
+	ID         string
+	Name       string
+	Scopes     []APIKeyScope
+	RateLimit  int
+	DailyQuota int
+	// Prefix and Hash are set by the service from the generated key
+	Prefix string
+	Hash   string
+}
+
+// APIKeyUsage counts the requests of a key in the current minute and UTC day
+type APIKeyUsage struct {
+	Minute        int       `json:"minute"`
+	Day           int       `json:"day"`
+	MinuteResetAt time.Time `json:"minuteResetAt"`
+	DayResetAt    time.Time `json:"dayResetAt"`
+}
+
+func (p *APIKeyParams) Validate() error {
+	if p.Name == "" {
+		return NewErrorf(ErrInvalidInput, "name is required")
+	}
+
+	if len(p.Scopes) == 0 {
+		return NewErrorf(ErrInvalidInput, "at least one scope is required")
+	}
+
+	for _, scope := range p.Scopes {
+		switch scope {
+		case ReadAPIKeyScope, ScrapeAPIKeyScope, AdminAPIKeyScope:
+		default:
+			return NewErrorf(ErrInvalidInput, "invalid scope %s", scope)
+		}
+	}
+
+	if p.RateLimit < 0 {
+		return NewErrorf(ErrInvalidInput, "rate limit must not be negative")
+	}
+
+	if p.DailyQuota < 0 {
+		return NewErrorf(ErrInvalidInput, "daily quota must not be negative")
+	}
+
+	return nil
+}
+
+// HasScope reports whether the key grants the scope, the admin scope grants all of them
+func (k *APIKey) HasScope(scope APIKeyScope) bool {
+	for _, s := range k.Scopes {
+		if s == scope || s == AdminAPIKeyScope {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Throttled reports whether the usage is over the rate limit or the daily quota of the key,
+// along with when the exceeded window resets
+func (k *APIKey) Throttled(usage APIKeyUsage) (time.Time, bool) {
+	if k.DailyQuota > 0 && usage.Day > k.DailyQuota {
+		return usage.DayResetAt, true
+	}
+
+	if k.RateLimit > 0 && usage.Minute > k.RateLimit {
+		return usage.MinuteResetAt, true
+	}
+
+	return time.Time{}, false
+}
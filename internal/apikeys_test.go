@@ -0,0 +1,95 @@
+package internal
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAPIKeyParams_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  APIKeyParams
+		wantErr bool
+	}{
+		{"Valid", APIKeyParams{Name: "partner", Scopes: []APIKeyScope{ReadAPIKeyScope}, RateLimit: 60, DailyQuota: 10000}, false},
+		{"Unlimited", APIKeyParams{Name: "partner", Scopes: []APIKeyScope{ReadAPIKeyScope, ScrapeAPIKeyScope}}, false},
+		{"EmptyName", APIKeyParams{Scopes: []APIKeyScope{ReadAPIKeyScope}}, true},
+		{"NoScopes", APIKeyParams{Name: "partner"}, true},
+		{"InvalidScope", APIKeyParams{Name: "partner", Scopes: []APIKeyScope{"write"}}, true},
+		{"NegativeRateLimit", APIKeyParams{Name: "partner", Scopes: []APIKeyScope{ReadAPIKeyScope}, RateLimit: -1}, true},
+		{"NegativeDailyQuota", APIKeyParams{Name: "partner", Scopes: []APIKeyScope{ReadAPIKeyScope}, DailyQuota: -1}, true},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tc.params.Validate()
+			if tc.wantErr && err == nil {
+				t.Errorf("expected an error but got none")
+			}
+			if !tc.wantErr && err != nil {
+				t.Errorf("did not expect an error but got one: %v", err)
+			}
+		})
+	}
+}
+
+func TestAPIKey_HasScope(t *testing.T) {
+	tests := []struct {
+		name  string
+		key   APIKey
+		scope APIKeyScope
+		want  bool
+	}{
+		{"Granted", APIKey{Scopes: []APIKeyScope{ReadAPIKeyScope, ScrapeAPIKeyScope}}, ScrapeAPIKeyScope, true},
+		{"Missing", APIKey{Scopes: []APIKeyScope{ReadAPIKeyScope}}, ScrapeAPIKeyScope, false},
+		{"Admin", APIKey{Scopes: []APIKeyScope{AdminAPIKeyScope}}, ReadAPIKeyScope, true},
+		{"None", APIKey{}, ReadAPIKeyScope, false},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tc.key.HasScope(tc.scope); got != tc.want {
+				t.Errorf("expected %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestAPIKey_Throttled(t *testing.T) {
+	minuteReset := time.Date(2024, 7, 12, 10, 31, 0, 0, time.UTC)
+	dayReset := time.Date(2024, 7, 13, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		key       APIKey
+		usage     APIKeyUsage
+		wantUntil time.Time
+		want      bool
+	}{
+		{"WithinLimits", APIKey{RateLimit: 60, DailyQuota: 1000}, APIKeyUsage{Minute: 60, Day: 1000}, time.Time{}, false},
+		{"RateLimited", APIKey{RateLimit: 60, DailyQuota: 1000}, APIKeyUsage{Minute: 61, Day: 100}, minuteReset, true},
+		{"QuotaExceeded", APIKey{RateLimit: 60, DailyQuota: 1000}, APIKeyUsage{Minute: 61, Day: 1001}, dayReset, true},
+		{"Unlimited", APIKey{}, APIKeyUsage{Minute: 1000, Day: 100000}, time.Time{}, false},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			tc.usage.MinuteResetAt = minuteReset
+			tc.usage.DayResetAt = dayReset
+
+			until, got := tc.key.Throttled(tc.usage)
+			if got != tc.want || !until.Equal(tc.wantUntil) {
+				t.Errorf("expected %v until %v, got %v until %v", tc.want, tc.wantUntil, got, until)
+			}
+		})
+	}
+}
@@ -0,0 +1,24 @@
+// Package backoff computes the exponential delays shared by the retry policies of the workers
+package backoff
+
+import "time"
+
+// Exponential returns the delay before the given retry attempt, doubling from base up to maxDelay
+// Attempts start at 1, earlier attempts have no delay
+func Exponential(base, maxDelay time.Duration, attempt int) time.Duration {
+	if attempt < 1 {
+		return 0
+	}
+
+	delay := base
+
+	for i := 1; i < attempt && delay < maxDelay; i++ {
+		delay *= 2
+	}
+
+	if delay > maxDelay {
+		return maxDelay
+	}
+
+	return delay
+}
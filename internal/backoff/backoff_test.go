@@ -0,0 +1,33 @@
+package backoff
+
+import (
+	"testing"
+	"time"
+)
+
+func TestExponential(t *testing.T) {
+	tests := []struct {
+		name    string
+		attempt int
+		want    time.Duration
+	}{
+		{"FirstAttempt", 0, 0},
+		{"FirstRetry", 1, 30 * time.Second},
+		{"SecondRetry", 2, time.Minute},
+		{"ThirdRetry", 3, 2 * time.Minute},
+		{"FourthRetry", 4, 4 * time.Minute},
+		{"Capped", 5, 5 * time.Minute},
+		{"CappedFarOut", 60, 5 * time.Minute},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := Exponential(30*time.Second, 5*time.Minute, tc.attempt); got != tc.want {
+				t.Errorf("expected %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
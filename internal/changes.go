@@ -0,0 +1,37 @@
+package internal
+
+import "time"
+
+const (
+	// SeriesUpsertedEvent is recorded when a series row is created or updated
+	SeriesUpsertedEvent = "series.upserted"
+	// SeriesDeletedEvent is recorded when a series row is deleted
+	SeriesDeletedEvent = "series.deleted"
+)
+
+// SeriesChange marks a series whose search document may be behind MySQL, there is at most one per series.
+// The indexer copies the current row of the series into the index, or deletes the document once the row is gone,
+// so a change only needs to say which series to look at
+type SeriesChange struct {
+	ID       string
+	Provider string
+	Slug     string
+	// Event is the latest event recorded for the series
+	Event string
+	// Revision grows with every event, a change recorded while the indexer works on the series keeps it pending
+	Revision      int
+	Attempts      int
+	Error         string
+	NextAttemptAt time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// RetrySeriesChangeParams records a failed attempt at indexing a change
+type RetrySeriesChangeParams struct {
+	ID            string
+	Revision      int
+	Attempts      int
+	Error         string
+	NextAttemptAt time.Time
+}
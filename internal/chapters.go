@@ -1,5 +1,7 @@
 package internal
 
+import "time"
+
 type Chapter struct {
 	Provider    string      `json:"provider"`
 	Series      string      `json:"series"`
@@ -11,6 +13,10 @@ type Chapter struct {
 	ChapterNav  *ChapterNav `json:"chapterNav,omitempty"`
 	ContentURLs []string    `json:"contentURLs,omitempty"`
 	SourceHref  string      `json:"sourceHref,omitempty"`
+	// Sources lists the same chapter on the other providers of the series work
+	Sources []ChapterSource `json:"sources,omitempty"`
+	// UpdatedAt is when the chapter row was last written
+	UpdatedAt time.Time `json:"updatedAt"`
 }
 
 type ChapterList struct {
@@ -1,6 +1,10 @@
 package config
 
-import "github.com/spf13/viper"
+import (
+	"time"
+
+	"github.com/spf13/viper"
+)
 
 // Stores the configuration for the application.
 // The values are read by viper from the config file or environment variables.
@@ -16,6 +20,34 @@ type Config struct {
 	SearchURL      string `mapstructure:"OPENSEARCH_URL"`
 	ClerkSecretKey string `mapstructure:"CLERK_SECRET_KEY"`
 	KafkaURL       string `mapstructure:"KAFKA_URL"`
+
+	BrowserPoolSize       int           `mapstructure:"BROWSER_POOL_SIZE"`
+	BrowserPageMaxUses    int           `mapstructure:"BROWSER_PAGE_MAX_USES"`
+	BrowserHealthInterval time.Duration `mapstructure:"BROWSER_HEALTH_INTERVAL"`
+
+	ScrapeMaxAttempts    int           `mapstructure:"SCRAPE_MAX_ATTEMPTS"`
+	ScrapeRetryBaseDelay time.Duration `mapstructure:"SCRAPE_RETRY_BASE_DELAY"`
+	ScrapeRetryMaxDelay  time.Duration `mapstructure:"SCRAPE_RETRY_MAX_DELAY"`
+
+	ScraperConcurrency         int `mapstructure:"SCRAPER_CONCURRENCY"`
+	ScraperProviderConcurrency int `mapstructure:"SCRAPER_PROVIDER_CONCURRENCY"`
+
+	WebhookMaxAttempts    int           `mapstructure:"WEBHOOK_MAX_ATTEMPTS"`
+	WebhookRetryBaseDelay time.Duration `mapstructure:"WEBHOOK_RETRY_BASE_DELAY"`
+	WebhookRetryMaxDelay  time.Duration `mapstructure:"WEBHOOK_RETRY_MAX_DELAY"`
+	WebhookTimeout        time.Duration `mapstructure:"WEBHOOK_TIMEOUT"`
+	WebhookPollInterval   time.Duration `mapstructure:"WEBHOOK_POLL_INTERVAL"`
+
+	IndexerPollInterval   time.Duration `mapstructure:"INDEXER_POLL_INTERVAL"`
+	IndexerRetryBaseDelay time.Duration `mapstructure:"INDEXER_RETRY_BASE_DELAY"`
+	IndexerRetryMaxDelay  time.Duration `mapstructure:"INDEXER_RETRY_MAX_DELAY"`
+
+	// Limits of the requests without an API key on the public routes, counted per client IP, 0 is unlimited
+	AnonymousRateLimit  int `mapstructure:"ANONYMOUS_RATE_LIMIT"`
+	AnonymousDailyQuota int `mapstructure:"ANONYMOUS_DAILY_QUOTA"`
+
+	// Comma separated provider slugs, the merged chapter list of a work prefers the first provider with the chapter
+	WorkProviderPriority []string `mapstructure:"WORK_PROVIDER_PRIORITY"`
 }
 
 // Reads the configuration from the config file or environment variables.
@@ -24,6 +56,26 @@ func LoadConfig(path string) (*Config, error) {
 	viper.SetConfigFile(path)
 	viper.AutomaticEnv()
 
+	viper.SetDefault("BROWSER_POOL_SIZE", 4)
+	viper.SetDefault("BROWSER_PAGE_MAX_USES", 50)
+	viper.SetDefault("BROWSER_HEALTH_INTERVAL", 30*time.Second)
+	viper.SetDefault("SCRAPE_MAX_ATTEMPTS", 5)
+	viper.SetDefault("SCRAPE_RETRY_BASE_DELAY", 30*time.Second)
+	viper.SetDefault("SCRAPE_RETRY_MAX_DELAY", 15*time.Minute)
+	viper.SetDefault("SCRAPER_CONCURRENCY", 4)
+	viper.SetDefault("SCRAPER_PROVIDER_CONCURRENCY", 2)
+	viper.SetDefault("WEBHOOK_MAX_ATTEMPTS", 6)
+	viper.SetDefault("WEBHOOK_RETRY_BASE_DELAY", time.Minute)
+	viper.SetDefault("WEBHOOK_RETRY_MAX_DELAY", time.Hour)
+	viper.SetDefault("WEBHOOK_TIMEOUT", 10*time.Second)
+	viper.SetDefault("WEBHOOK_POLL_INTERVAL", 10*time.Second)
+	viper.SetDefault("INDEXER_POLL_INTERVAL", 5*time.Second)
+	viper.SetDefault("INDEXER_RETRY_BASE_DELAY", 10*time.Second)
+	viper.SetDefault("INDEXER_RETRY_MAX_DELAY", 10*time.Minute)
+	viper.SetDefault("ANONYMOUS_RATE_LIMIT", 30)
+	viper.SetDefault("ANONYMOUS_DAILY_QUOTA", 5000)
+	viper.SetDefault("WORK_PROVIDER_PRIORITY", []string{})
+
 	if err := viper.ReadInConfig(); err != nil {
 		return nil, err
 	}
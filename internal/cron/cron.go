@@ -33,14 +33,25 @@ type SeriesRepository interface {
 	FindEmptyThumb(ctx context.Context, order internal.SortOrder) ([]internal.CreateScrapeRequestParams, error)
 	FindOnGoing(ctx context.Context, params internal.FindSeriesParams) ([]internal.Series, error)
 	FindEmptyChapters(ctx context.Context, params internal.FindSeriesParams) ([]internal.CreateScrapeRequestParams, error)
+	FindAll(ctx context.Context, params internal.FindSeriesParams) ([]internal.Series, error)
+	SyncChapters(ctx context.Context, params internal.FindSeriesParams) (internal.Series, error)
 }
 
 type ScraperRepository interface {
 	Create(ctx context.Context, params internal.CreateScrapeRequestParams) (internal.ScrapeRequest, error)
 }
 
-type SeriesSearchRepository interface {
-	Index(ctx context.Context, series internal.Series) error
+type WorkRepository interface {
+	Create(ctx context.Context, params internal.CreateWorkParams) (internal.Work, error)
+	FindAll(ctx context.Context) ([]internal.Work, error)
+	DeleteEmpty(ctx context.Context) (int, error)
+	CreateCandidate(ctx context.Context, params internal.CreateWorkCandidateParams) (internal.WorkCandidate, error)
+	FindAllCandidates(ctx context.Context) ([]internal.WorkCandidate, error)
+}
+
+// CacheInvalidator drops the cached series a job outdated
+type CacheInvalidator interface {
+	SeriesChanged(ctx context.Context, provider string, slugs ...string) error
 }
 
 type Cron struct {
@@ -48,7 +59,8 @@ type Cron struct {
 	series      SeriesRepository
 	repo        JobRepository
 	scraper     ScraperRepository
-	search      SeriesSearchRepository
+	work        WorkRepository
+	cache       CacheInvalidator
 	cronMonitor *cronMonitor
 	logger      *zap.Logger
 	doneC       chan struct{}
@@ -59,7 +71,8 @@ func NewCron(
 	series SeriesRepository,
 	repo JobRepository,
 	scraper ScraperRepository,
-	search SeriesSearchRepository,
+	work WorkRepository,
+	cache CacheInvalidator,
 	logger *zap.Logger,
 ) *Cron {
 	return &Cron{
@@ -67,7 +80,8 @@ func NewCron(
 		series:      series,
 		repo:        repo,
 		scraper:     scraper,
-		search:      search,
+		work:        work,
+		cache:       cache,
 		cronMonitor: newCronMonitor(),
 		logger:      logger,
 		doneC:       make(chan struct{}),
@@ -26,6 +26,10 @@ func (s *Cron) scheduleJobs(scheduler gocron.Scheduler) error {
 			err = s.createNewJob(scheduler, cronjobs[i].Crontab, cronjobs[i].Name, s.scrapeChaptersList, cronjobs[i].ID)
 		case "scrape-chapters-detail":
 			err = s.createNewJob(scheduler, cronjobs[i].Crontab, cronjobs[i].Name, s.scrapeChaptersDetail, cronjobs[i].ID)
+		case "reconcile-series-chapters":
+			err = s.createNewJob(scheduler, cronjobs[i].Crontab, cronjobs[i].Name, s.reconcileSeriesChapters, cronjobs[i].ID)
+		case "link-series-works":
+			err = s.createNewJob(scheduler, cronjobs[i].Crontab, cronjobs[i].Name, s.linkSeriesWorks, cronjobs[i].ID)
 		}
 	}
 
@@ -90,6 +94,4 @@ func (s *Cron) createNewJob(scheduler gocron.Scheduler, crontab, name string, jo
 }
 
 // TODO:
-// - Implement cron job to update chapter count 2x a day
-// - Implement cron job to update latest chapter 2x a day
 // - Implement cron job to update series index 2x a day
@@ -0,0 +1,215 @@
+package cron
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"fourleaves.studio/manga-scraper/internal"
+	"go.uber.org/zap"
+)
+
+// linkSeriesWorks groups provider series into works by normalized title and alias.
+// A series that matches an existing work becomes a pending candidate for an admin to confirm,
+// a series without any open match gets a work of its own. Series already sharing a work with another series are left alone.
+func (s *Cron) linkSeriesWorks() {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Minute)
+	defer cancel()
+
+	works, err := s.work.FindAll(ctx)
+	if err != nil {
+		s.logger.Error("Failed to get works", zap.Error(err))
+		return
+	}
+
+	candidates, err := s.work.FindAllCandidates(ctx)
+	if err != nil {
+		s.logger.Error("Failed to get work candidates", zap.Error(err))
+		return
+	}
+
+	linker := newWorkLinker(works, candidates)
+
+	providers, err := s.provider.FindAll(ctx, internal.ASC)
+	if err != nil {
+		s.logger.Error("Failed to get providers", zap.Error(err))
+		return
+	}
+
+	var created, suggested int
+
+	for i := range providers {
+		series, err := s.series.FindAll(ctx, internal.FindSeriesParams{
+			Provider: providers[i].Slug,
+			Order:    internal.ASC,
+		})
+		if err != nil {
+			var ierr *internal.Error
+			if !errors.As(err, &ierr) || ierr.Code() != internal.ErrNotFound {
+				s.logger.Error("Failed to get series", zap.Error(err))
+			}
+
+			continue
+		}
+
+		for j := range series {
+			matches, own := linker.match(series[j])
+
+			for _, match := range matches {
+				_, err := s.work.CreateCandidate(ctx, internal.CreateWorkCandidateParams{
+					WorkID:   match.workID,
+					Provider: series[j].Provider,
+					Series:   series[j].Slug,
+					Reason:   match.reason,
+					Status:   internal.PendingCandidateStatus,
+				})
+				if err != nil {
+					s.logger.Error("Failed to create work candidate", zap.String("series", series[j].Slug), zap.Error(err))
+					continue
+				}
+
+				linker.suggested(match.workID, series[j])
+				suggested++
+			}
+
+			if !own {
+				continue
+			}
+
+			work, err := s.work.Create(ctx, internal.CreateWorkParams{
+				Title:    series[j].Title,
+				Provider: series[j].Provider,
+				Series:   series[j].Slug,
+			})
+			if err != nil {
+				s.logger.Error("Failed to create work", zap.String("series", series[j].Slug), zap.Error(err))
+				continue
+			}
+
+			linker.add(work)
+			created++
+		}
+	}
+
+	deleted, err := s.work.DeleteEmpty(ctx)
+	if err != nil {
+		s.logger.Error("Failed to delete empty works", zap.Error(err))
+	}
+
+	s.logger.Info("Linked series works", zap.Int("created", created), zap.Int("suggested", suggested), zap.Int("deleted", deleted))
+}
+
+type workMatch struct {
+	workID string
+	reason string
+}
+
+// workLinker holds the works and candidates in memory while the linking job walks every series
+type workLinker struct {
+	titles     map[string][]workMatch
+	memberOf   map[string]string
+	members    map[string][]string
+	candidates map[string]internal.WorkCandidateStatus
+	pending    map[string]int
+}
+
+func newWorkLinker(works []internal.Work, candidates []internal.WorkCandidate) *workLinker {
+	l := &workLinker{
+		titles:     make(map[string][]workMatch),
+		memberOf:   make(map[string]string),
+		members:    make(map[string][]string),
+		candidates: make(map[string]internal.WorkCandidateStatus),
+		pending:    make(map[string]int),
+	}
+
+	for i := range works {
+		l.add(works[i])
+	}
+
+	for i := range candidates {
+		key := seriesKey(candidates[i].Provider, candidates[i].Series)
+
+		l.candidates[candidates[i].WorkID+"|"+key] = candidates[i].Status
+
+		if candidates[i].Status == internal.PendingCandidateStatus {
+			l.pending[key]++
+		}
+	}
+
+	return l
+}
+
+func (l *workLinker) add(work internal.Work) {
+	l.index(work.ID, work.Title, "title")
+
+	for _, alias := range work.Aliases {
+		l.index(work.ID, alias, "alias")
+	}
+
+	for i := range work.Sources {
+		key := seriesKey(work.Sources[i].Provider, work.Sources[i].Slug)
+
+		l.memberOf[key] = work.ID
+		l.members[work.ID] = append(l.members[work.ID], key)
+	}
+}
+
+func (l *workLinker) index(workID, title, reason string) {
+	normalized := internal.NormalizeTitle(title)
+	if normalized == "" {
+		return
+	}
+
+	for _, match := range l.titles[normalized] {
+		if match.workID == workID {
+			return
+		}
+	}
+
+	l.titles[normalized] = append(l.titles[normalized], workMatch{workID: workID, reason: reason})
+}
+
+// match returns the works the series should be suggested for,
+// and whether it has no work and no open suggestion so it needs a work of its own
+func (l *workLinker) match(series internal.Series) ([]workMatch, bool) {
+	key := seriesKey(series.Provider, series.Slug)
+
+	own, linked := l.memberOf[key]
+	if linked && len(l.members[own]) > 1 {
+		return nil, false
+	}
+
+	var matches []workMatch
+
+	for _, match := range l.titles[internal.NormalizeTitle(series.Title)] {
+		if match.workID == own {
+			continue
+		}
+
+		if _, ok := l.candidates[match.workID+"|"+key]; ok {
+			continue
+		}
+
+		// two works with a single series each match each other, one suggestion is enough
+		if others := l.members[match.workID]; linked && len(others) == 1 {
+			if _, ok := l.candidates[own+"|"+others[0]]; ok {
+				continue
+			}
+		}
+
+		matches = append(matches, match)
+	}
+
+	return matches, !linked && l.pending[key] == 0 && len(matches) == 0
+}
+
+func (l *workLinker) suggested(workID string, series internal.Series) {
+	key := seriesKey(series.Provider, series.Slug)
+
+	l.candidates[workID+"|"+key] = internal.PendingCandidateStatus
+	l.pending[key]++
+}
+
+func seriesKey(provider, slug string) string {
+	return provider + "/" + slug
+}
@@ -0,0 +1,74 @@
+package cron
+
+import (
+	"reflect"
+	"testing"
+
+	"fourleaves.studio/manga-scraper/internal"
+)
+
+func TestWorkLinker_Match(t *testing.T) {
+	works := []internal.Work{
+		{ID: "w1", Title: "Solo Leveling", Aliases: []string{"Na Honjaman Level Up"}, Sources: []internal.SeriesSource{{Provider: "asura", Slug: "solo-leveling"}}},
+		{ID: "w2", Title: "Nano Machine", Sources: []internal.SeriesSource{{Provider: "asura", Slug: "nano-machine"}, {Provider: "flame", Slug: "nano-machine"}}},
+		{ID: "w3", Title: "Reincarnator", Sources: []internal.SeriesSource{{Provider: "asura", Slug: "reincarnator"}}},
+		{ID: "w4", Title: "Reincarnator", Sources: []internal.SeriesSource{{Provider: "flame", Slug: "reincarnator"}}},
+	}
+	candidates := []internal.WorkCandidate{
+		{WorkID: "w1", Provider: "luminous", Series: "solo-leveling-ragnarok", Status: internal.RejectedCandidateStatus},
+		{WorkID: "w3", Provider: "flame", Series: "reincarnator", Status: internal.RejectedCandidateStatus},
+		{WorkID: "w1", Provider: "surya", Series: "solo-leveling", Status: internal.PendingCandidateStatus},
+	}
+
+	tests := []struct {
+		name        string
+		series      internal.Series
+		wantMatches []workMatch
+		wantOwn     bool
+	}{
+		{"TitleMatch", internal.Series{Provider: "flame", Slug: "solo-leveling", Title: "Solo Leveling!"}, []workMatch{{"w1", "title"}}, false},
+		{"AliasMatch", internal.Series{Provider: "flame", Slug: "na-honjaman", Title: "Na Honjaman: Level Up"}, []workMatch{{"w1", "alias"}}, false},
+		{"NoMatch", internal.Series{Provider: "flame", Slug: "omniscient-reader", Title: "Omniscient Reader"}, nil, true},
+		{"Rejected", internal.Series{Provider: "luminous", Slug: "solo-leveling-ragnarok", Title: "Solo Leveling"}, nil, true},
+		{"Pending", internal.Series{Provider: "surya", Slug: "solo-leveling", Title: "Solo Leveling"}, nil, false},
+		{"Grouped", internal.Series{Provider: "asura", Slug: "nano-machine", Title: "Nano Machine"}, nil, false},
+		{"OwnWork", internal.Series{Provider: "asura", Slug: "solo-leveling", Title: "Solo Leveling"}, nil, false},
+		{"ReverseRejected", internal.Series{Provider: "asura", Slug: "reincarnator", Title: "Reincarnator"}, nil, false},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			linker := newWorkLinker(works, candidates)
+
+			matches, own := linker.match(tc.series)
+			if !reflect.DeepEqual(matches, tc.wantMatches) {
+				t.Errorf("expected matches %v, got %v", tc.wantMatches, matches)
+			}
+
+			if own != tc.wantOwn {
+				t.Errorf("expected own %v, got %v", tc.wantOwn, own)
+			}
+		})
+	}
+}
+
+func TestWorkLinker_SuggestedOnce(t *testing.T) {
+	linker := newWorkLinker(nil, nil)
+	series := internal.Series{Provider: "flame", Slug: "reincarnator", Title: "Reincarnator"}
+
+	linker.add(internal.Work{ID: "w1", Title: "Reincarnator", Sources: []internal.SeriesSource{{Provider: "asura", Slug: "reincarnator"}}})
+
+	matches, own := linker.match(series)
+	if len(matches) != 1 || own {
+		t.Fatalf("expected one match, got %v (own %v)", matches, own)
+	}
+
+	linker.suggested(matches[0].workID, series)
+
+	if matches, own := linker.match(series); len(matches) != 0 || own {
+		t.Errorf("expected no new match after suggesting, got %v (own %v)", matches, own)
+	}
+}
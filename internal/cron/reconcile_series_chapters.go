@@ -0,0 +1,65 @@
+package cron
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"fourleaves.studio/manga-scraper/internal"
+	"go.uber.org/zap"
+)
+
+// reconcileSeriesChapters fixes chaptersCount and latestChapter of series that drifted from the chapter table,
+// e.g. after chapters were deleted or a chapter list scrape failed halfway. The indexer picks up the fixed series
+func (s *Cron) reconcileSeriesChapters() {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Minute)
+	defer cancel()
+
+	providers, err := s.provider.FindAll(ctx, internal.ASC)
+	if err != nil {
+		s.logger.Error("Failed to get providers", zap.Error(err))
+		return
+	}
+
+	for i := range providers {
+		series, err := s.series.FindAll(ctx, internal.FindSeriesParams{
+			Provider: providers[i].Slug,
+			Order:    internal.ASC,
+		})
+		if err != nil {
+			var ierr *internal.Error
+			if !errors.As(err, &ierr) || ierr.Code() != internal.ErrNotFound {
+				s.logger.Error("Failed to get series", zap.Error(err))
+			}
+
+			continue
+		}
+
+		var fixed []string
+
+		for j := range series {
+			synced, err := s.series.SyncChapters(ctx, internal.FindSeriesParams{
+				Provider: series[j].Provider,
+				Slug:     series[j].Slug,
+			})
+			if err != nil {
+				s.logger.Error("Failed to sync series chapters", zap.String("series", series[j].Slug), zap.Error(err))
+				continue
+			}
+
+			if synced.ChaptersCount == series[j].ChaptersCount && synced.LatestChapter == series[j].LatestChapter {
+				continue
+			}
+
+			fixed = append(fixed, synced.Slug)
+		}
+
+		if len(fixed) > 0 {
+			if err := s.cache.SeriesChanged(ctx, providers[i].Slug, fixed...); err != nil {
+				s.logger.Error("Failed to invalidate cached series", zap.String("provider", providers[i].Slug), zap.Error(err))
+			}
+		}
+
+		s.logger.Info("Reconciled series chapters", zap.String("provider", providers[i].Slug), zap.Int("series", len(series)), zap.Int("fixed", len(fixed)))
+	}
+}
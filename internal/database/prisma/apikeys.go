@@ -0,0 +1,191 @@
+package prisma
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"fourleaves.studio/manga-scraper/internal"
+)
+
+type APIKeyRepo struct {
+	q *PrismaClient
+}
+
+func NewAPIKeyRepo(prismaClient *PrismaClient) *APIKeyRepo {
+	return &APIKeyRepo{
+		q: prismaClient,
+	}
+}
+
+func (k *APIKeyModel) toAPIKey() internal.APIKey {
+	var scopes []internal.APIKeyScope
+	_ = json.Unmarshal(k.Scopes, &scopes)
+
+	key := internal.APIKey{
+		ID:         k.ID,
+		Name:       k.Name,
+		Prefix:     k.Prefix,
+		Hash:       k.Hash,
+		Scopes:     scopes,
+		RateLimit:  k.RateLimit,
+		DailyQuota: k.DailyQuota,
+		CreatedAt:  k.CreatedAt,
+		UpdatedAt:  k.UpdatedAt,
+	}
+
+	if at, ok := k.RevokedAt(); ok {
+		key.RevokedAt = &at
+	}
+
+	return key
+}
+
+func (r *APIKeyRepo) Create(ctx context.Context, params internal.APIKeyParams) (internal.APIKey, error) {
+	defer newSentrySpan(ctx, "APIKeyRepo.Create").Finish()
+
+	scopes, err := json.Marshal(params.Scopes)
+	if err != nil {
+		return internal.APIKey{}, internal.WrapErrorf(err, internal.ErrUnknown, "json.Marshal")
+	}
+
+	key, err := r.q.APIKey.CreateOne(
+		APIKey.Name.Set(params.Name),
+		APIKey.Prefix.Set(params.Prefix),
+		APIKey.Hash.Set(params.Hash),
+		APIKey.Scopes.Set(scopes),
+		APIKey.RateLimit.Set(params.RateLimit),
+		APIKey.DailyQuota.Set(params.DailyQuota),
+	).Exec(ctx)
+	if err != nil {
+		if _, ok := IsErrUniqueConstraint(err); ok {
+			return internal.APIKey{}, internal.WrapErrorf(err, internal.ErrUniqueConstraint, "api key already exists")
+		}
+
+		return internal.APIKey{}, internal.WrapErrorf(err, internal.ErrUnknown, "failed to create api key")
+	}
+
+	return key.toAPIKey(), nil
+}
+
+func (r *APIKeyRepo) Find(ctx context.Context, id string) (internal.APIKey, error) {
+	defer newSentrySpan(ctx, "APIKeyRepo.Find").Finish()
+
+	key, err := r.q.APIKey.FindUnique(
+		APIKey.ID.Equals(id),
+	).Exec(ctx)
+	if err != nil {
+		if IsErrNotFound(err) {
+			return internal.APIKey{}, internal.WrapErrorf(err, internal.ErrNotFound, "api key not found")
+		}
+
+		return internal.APIKey{}, internal.WrapErrorf(err, internal.ErrUnknown, "failed to find api key")
+	}
+
+	return key.toAPIKey(), nil
+}
+
+// FindByHash looks a key up by the hash of the key a client sent, revoked keys are returned as well
+func (r *APIKeyRepo) FindByHash(ctx context.Context, hash string) (internal.APIKey, error) {
+	defer newSentrySpan(ctx, "APIKeyRepo.FindByHash").Finish()
+
+	key, err := r.q.APIKey.FindUnique(
+		APIKey.Hash.Equals(hash),
+	).Exec(ctx)
+	if err != nil {
+		if IsErrNotFound(err) {
+			return internal.APIKey{}, internal.WrapErrorf(err, internal.ErrNotFound, "api key not found")
+		}
+
+		return internal.APIKey{}, internal.WrapErrorf(err, internal.ErrUnknown, "failed to find api key")
+	}
+
+	return key.toAPIKey(), nil
+}
+
+func (r *APIKeyRepo) FindAll(ctx context.Context) ([]internal.APIKey, error) {
+	defer newSentrySpan(ctx, "APIKeyRepo.FindAll").Finish()
+
+	keys, err := r.q.APIKey.FindMany().OrderBy(
+		APIKey.CreatedAt.Order(SortOrderAsc),
+	).Exec(ctx)
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrUnknown, "failed to find api keys")
+	}
+
+	result := make([]internal.APIKey, 0, len(keys))
+	for i := range keys {
+		result = append(result, keys[i].toAPIKey())
+	}
+
+	return result, nil
+}
+
+// Update replaces the name, scopes and limits of the key
+func (r *APIKeyRepo) Update(ctx context.Context, params internal.APIKeyParams) (internal.APIKey, error) {
+	defer newSentrySpan(ctx, "APIKeyRepo.Update").Finish()
+
+	scopes, err := json.Marshal(params.Scopes)
+	if err != nil {
+		return internal.APIKey{}, internal.WrapErrorf(err, internal.ErrUnknown, "json.Marshal")
+	}
+
+	key, err := r.q.APIKey.FindUnique(
+		APIKey.ID.Equals(params.ID),
+	).Update(
+		APIKey.Name.Set(params.Name),
+		APIKey.Scopes.Set(scopes),
+		APIKey.RateLimit.Set(params.RateLimit),
+		APIKey.DailyQuota.Set(params.DailyQuota),
+	).Exec(ctx)
+	if err != nil {
+		if IsErrNotFound(err) {
+			return internal.APIKey{}, internal.WrapErrorf(err, internal.ErrNotFound, "api key not found")
+		}
+
+		return internal.APIKey{}, internal.WrapErrorf(err, internal.ErrUnknown, "failed to update api key")
+	}
+
+	return key.toAPIKey(), nil
+}
+
+// Rotate replaces the hash and prefix of the key, the previous key stops working right away
+func (r *APIKeyRepo) Rotate(ctx context.Context, params internal.APIKeyParams) (internal.APIKey, error) {
+	defer newSentrySpan(ctx, "APIKeyRepo.Rotate").Finish()
+
+	key, err := r.q.APIKey.FindUnique(
+		APIKey.ID.Equals(params.ID),
+	).Update(
+		APIKey.Prefix.Set(params.Prefix),
+		APIKey.Hash.Set(params.Hash),
+	).Exec(ctx)
+	if err != nil {
+		if IsErrNotFound(err) {
+			return internal.APIKey{}, internal.WrapErrorf(err, internal.ErrNotFound, "api key not found")
+		}
+
+		return internal.APIKey{}, internal.WrapErrorf(err, internal.ErrUnknown, "failed to rotate api key")
+	}
+
+	return key.toAPIKey(), nil
+}
+
+// Revoke disables the key for good, the row is kept so its requests can still be attributed
+func (r *APIKeyRepo) Revoke(ctx context.Context, id string) (internal.APIKey, error) {
+	defer newSentrySpan(ctx, "APIKeyRepo.Revoke").Finish()
+
+	key, err := r.q.APIKey.FindUnique(
+		APIKey.ID.Equals(id),
+	).Update(
+		APIKey.RevokedAt.Set(time.Now()),
+	).Exec(ctx)
+	if err != nil {
+		if IsErrNotFound(err) {
+			return internal.APIKey{}, internal.WrapErrorf(err, internal.ErrNotFound, "api key not found")
+		}
+
+		return internal.APIKey{}, internal.WrapErrorf(err, internal.ErrUnknown, "failed to revoke api key")
+	}
+
+	return key.toAPIKey(), nil
+}
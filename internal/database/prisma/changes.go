@@ -0,0 +1,158 @@
+package prisma
+
+import (
+	"context"
+	"time"
+
+	"fourleaves.studio/manga-scraper/internal"
+)
+
+type SeriesChangeRepo struct {
+	q *PrismaClient
+}
+
+func NewSeriesChangeRepo(prismaClient *PrismaClient) *SeriesChangeRepo {
+	return &SeriesChangeRepo{
+		q: prismaClient,
+	}
+}
+
+func (c *SeriesChangeModel) toSeriesChange() internal.SeriesChange {
+	return internal.SeriesChange{
+		ID:            c.ID,
+		Provider:      c.Provider,
+		Slug:          c.Slug,
+		Event:         c.Event,
+		Revision:      c.Revision,
+		Attempts:      c.Attempts,
+		Error:         c.Error,
+		NextAttemptAt: c.NextAttemptAt,
+		CreatedAt:     c.CreatedAt,
+		UpdatedAt:     c.UpdatedAt,
+	}
+}
+
+// upsertSeriesChange records a new event on a change that is already pending, the attempts of the previous
+// event are reset so it is indexed again right away
+const upsertSeriesChange = " ON DUPLICATE KEY UPDATE event = ?, revision = revision + 1, " +
+	"attempts = 0, error = '', nextAttemptAt = NOW(3), updatedAt = NOW(3)"
+
+// newSeriesChangeTx marks the series for the indexer. The series writes run it in the same transaction,
+// so every committed write leaves a change behind and a failed write leaves none
+func newSeriesChangeTx(q *PrismaClient, provider, slug, event string) PrismaTransaction {
+	return q.Prisma.ExecuteRaw(
+		"INSERT INTO `SeriesChange` (id, provider, slug, event, error, updatedAt) "+
+			"VALUES (UUID(), ?, ?, ?, '', NOW(3))"+upsertSeriesChange,
+		provider, slug, event, event,
+	).Tx()
+}
+
+// newSeriesChangesTx marks every series matching the where clause for the indexer in one statement,
+// for the writes that change series in bulk or only when the series differs. It must run before the write,
+// which may change the matching rows
+func newSeriesChangesTx(q *PrismaClient, event, where string, args ...interface{}) PrismaTransaction {
+	args = append([]interface{}{event}, args...)
+	args = append(args, event)
+
+	return q.Prisma.ExecuteRaw(
+		"INSERT INTO `SeriesChange` (id, provider, slug, event, error, updatedAt) "+
+			"SELECT UUID(), providerSlug, slug, ?, '', NOW(3) FROM `Series` WHERE "+where+upsertSeriesChange,
+		args...,
+	).Tx()
+}
+
+// FindDue returns the changes whose next attempt is due and that were last recorded before settled,
+// so the write that recorded a change has committed by the time the indexer reads the series. Oldest first
+func (r *SeriesChangeRepo) FindDue(ctx context.Context, settled time.Time, limit int) ([]internal.SeriesChange, error) {
+	defer newSentrySpan(ctx, "SeriesChangeRepo.FindDue").Finish()
+
+	changes, err := r.q.SeriesChange.FindMany(
+		SeriesChange.NextAttemptAt.Lte(time.Now()),
+		SeriesChange.UpdatedAt.Lte(settled),
+	).OrderBy(
+		SeriesChange.NextAttemptAt.Order(SortOrderAsc),
+	).Take(limit).Exec(ctx)
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrUnknown, "failed to find series changes")
+	}
+
+	result := make([]internal.SeriesChange, 0, len(changes))
+	for i := range changes {
+		result = append(result, changes[i].toSeriesChange())
+	}
+
+	return result, nil
+}
+
+// FindByProvider returns the changes of the provider that are not indexed yet, due or not
+func (r *SeriesChangeRepo) FindByProvider(ctx context.Context, provider string) ([]internal.SeriesChange, error) {
+	defer newSentrySpan(ctx, "SeriesChangeRepo.FindByProvider").Finish()
+
+	changes, err := r.q.SeriesChange.FindMany(
+		SeriesChange.Provider.Equals(provider),
+	).Exec(ctx)
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrUnknown, "failed to find series changes")
+	}
+
+	result := make([]internal.SeriesChange, 0, len(changes))
+	for i := range changes {
+		result = append(result, changes[i].toSeriesChange())
+	}
+
+	return result, nil
+}
+
+// Claim pushes the next attempt of a due change to until, so other indexers skip it while it is being indexed
+// It reports false when another indexer claimed the change first or a new event was recorded since it was loaded
+func (r *SeriesChangeRepo) Claim(ctx context.Context, change internal.SeriesChange, until time.Time) (bool, error) {
+	defer newSentrySpan(ctx, "SeriesChangeRepo.Claim").Finish()
+
+	result, err := r.q.SeriesChange.FindMany(
+		SeriesChange.ID.Equals(change.ID),
+		SeriesChange.Revision.Equals(change.Revision),
+		SeriesChange.NextAttemptAt.Equals(change.NextAttemptAt),
+	).Update(
+		SeriesChange.NextAttemptAt.Set(until),
+	).Exec(ctx)
+	if err != nil {
+		return false, internal.WrapErrorf(err, internal.ErrUnknown, "failed to claim series change")
+	}
+
+	return result.Count == 1, nil
+}
+
+// Complete deletes the change once the series is indexed, a change that got a new event meanwhile is kept
+// and indexed again. It reports whether the change was deleted
+func (r *SeriesChangeRepo) Complete(ctx context.Context, change internal.SeriesChange) (bool, error) {
+	defer newSentrySpan(ctx, "SeriesChangeRepo.Complete").Finish()
+
+	result, err := r.q.SeriesChange.FindMany(
+		SeriesChange.ID.Equals(change.ID),
+		SeriesChange.Revision.Equals(change.Revision),
+	).Delete().Exec(ctx)
+	if err != nil {
+		return false, internal.WrapErrorf(err, internal.ErrUnknown, "failed to complete series change")
+	}
+
+	return result.Count == 1, nil
+}
+
+// Retry records a failed attempt, a change that got a new event meanwhile is left as it is
+func (r *SeriesChangeRepo) Retry(ctx context.Context, params internal.RetrySeriesChangeParams) error {
+	defer newSentrySpan(ctx, "SeriesChangeRepo.Retry").Finish()
+
+	_, err := r.q.SeriesChange.FindMany(
+		SeriesChange.ID.Equals(params.ID),
+		SeriesChange.Revision.Equals(params.Revision),
+	).Update(
+		SeriesChange.Attempts.Set(params.Attempts),
+		SeriesChange.Error.Set(params.Error),
+		SeriesChange.NextAttemptAt.Set(params.NextAttemptAt),
+	).Exec(ctx)
+	if err != nil {
+		return internal.WrapErrorf(err, internal.ErrUnknown, "failed to retry series change")
+	}
+
+	return nil
+}
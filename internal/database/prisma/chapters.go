@@ -61,6 +61,7 @@ func (c *ChapterModel) toChapter() internal.Chapter {
 		},
 		ContentURLs: newContentURLsFromSlice(contentPaths, provider.Scheme+provider.Host),
 		SourceHref:  c.SourceHref,
+		UpdatedAt:   c.UpdatedAt,
 	}
 }
 
@@ -106,6 +107,7 @@ func (c *ChapterModel) toChapterUpsert(provider *ProviderModel) internal.Chapter
 		},
 		ContentURLs: newContentURLsFromSlice(contentPaths, provider.Scheme+provider.Host),
 		SourceHref:  c.SourceHref,
+		UpdatedAt:   c.UpdatedAt,
 	}
 }
 
@@ -179,6 +181,7 @@ func (s *SeriesModel) toChapterList() []internal.Chapter {
 			},
 			ContentURLs: newContentURLsFromSlice(contentPaths, provider.Scheme+provider.Host),
 			SourceHref:  chaptersList[i].SourceHref,
+			UpdatedAt:   chaptersList[i].UpdatedAt,
 		})
 	}
 
@@ -208,6 +211,7 @@ func (s *SeriesModel) toChapterListWithRel() internal.ChapterList {
 			Slug:       chaptersList[i].Slug,
 			Number:     chaptersList[i].Number,
 			ShortTitle: chaptersList[i].ShortTitle,
+			UpdatedAt:  chaptersList[i].UpdatedAt,
 		})
 	}
 
@@ -385,22 +389,41 @@ func (c *ChapterRepo) FindLatest(ctx context.Context, params internal.FindChapte
 	return chapter.toChapter(), nil
 }
 
+const countChaptersQuery = "SELECT COUNT(*) AS total FROM `Chapter` WHERE providerSlug = ? AND seriesSlug = ?"
+
 func (c *ChapterRepo) Count(ctx context.Context, params internal.FindChapterParams) (int, error) {
 	defer newSentrySpan(ctx, "ChapterRepo.Count").Finish()
 
+	total, err := queryCount(ctx, c.q, countChaptersQuery, params.Provider, params.Series)
+	if err != nil {
+		return 0, internal.WrapErrorf(err, internal.ErrUnknown, "failed to count chapters")
+	}
+
+	return total, nil
+}
+
+func (c *ChapterRepo) FindSlugs(ctx context.Context, params internal.FindChapterParams) ([]string, error) {
+	defer newSentrySpan(ctx, "ChapterRepo.FindSlugs").Finish()
+
 	chapters, err := c.q.Chapter.FindMany(
 		Chapter.And(
 			Chapter.ProviderSlug.Equals(params.Provider),
 			Chapter.SeriesSlug.Equals(params.Series),
 		),
 	).Select(
-		Chapter.Number.Field(),
+		Chapter.Slug.Field(),
 	).Exec(ctx)
 	if err != nil {
-		return 0, internal.WrapErrorf(err, internal.ErrUnknown, "failed to count chapters")
+		return nil, internal.WrapErrorf(err, internal.ErrUnknown, "failed to find chapters")
 	}
 
-	return len(chapters), nil
+	result := make([]string, 0, len(chapters))
+
+	for i := range chapters {
+		result = append(result, chapters[i].Slug)
+	}
+
+	return result, nil
 }
 
 func (c *ChapterRepo) FindAll(ctx context.Context, params internal.FindChapterParams) ([]internal.Chapter, error) {
@@ -465,9 +488,55 @@ func (c *ChapterRepo) FindListWithRel(ctx context.Context, params internal.FindC
 	return result, nil
 }
 
-func (c *ChapterRepo) FindPaginated(ctx context.Context, params internal.FindChapterParams) ([]internal.Chapter, error) {
+// FindPaginated reads a page of the series chapters keyed by number and slug after params.Cursor,
+// without a cursor params.Page is still honoured by offset for older clients
+func (c *ChapterRepo) FindPaginated(ctx context.Context, params internal.FindChapterParams) (internal.ChapterPage, error) {
 	defer newSentrySpan(ctx, "ChapterRepo.FindPaginated").Finish()
 
+	cursor, err := internal.DecodeCursor(params.Cursor)
+	if err != nil {
+		return internal.ChapterPage{}, err
+	}
+
+	order := SortOrderDesc
+	if cursor.Ascending(params.Order) {
+		order = SortOrderAsc
+	}
+
+	var filters []ChapterWhereParam
+
+	// numbers are not unique, the slug breaks the ties
+	if cursor != nil {
+		if cursor.Ascending(params.Order) {
+			filters = append(filters, Chapter.Or(
+				Chapter.Number.Gt(cursor.Number),
+				Chapter.And(
+					Chapter.Number.Equals(cursor.Number),
+					Chapter.Slug.Gt(cursor.Slug),
+				),
+			))
+		} else {
+			filters = append(filters, Chapter.Or(
+				Chapter.Number.Lt(cursor.Number),
+				Chapter.And(
+					Chapter.Number.Equals(cursor.Number),
+					Chapter.Slug.Lt(cursor.Slug),
+				),
+			))
+		}
+	}
+
+	// one extra chapter tells whether there is a page after this one
+	query := Series.Chapters.Fetch(filters...).OrderBy(
+		Chapter.Number.Order(order),
+		Chapter.Slug.Order(order),
+	).Take(params.Size + 1)
+
+	offset := cursor == nil && params.Page > 1
+	if offset {
+		query = query.Skip(params.Size * (params.Page - 1))
+	}
+
 	series, err := c.q.Series.FindUnique(
 		Series.SeriesUnique(
 			Series.ProviderSlug.Equals(params.Provider),
@@ -475,25 +544,35 @@ func (c *ChapterRepo) FindPaginated(ctx context.Context, params internal.FindCha
 		),
 	).With(
 		Series.Provider.Fetch(),
-		Series.Chapters.Fetch().OrderBy(
-			Chapter.Number.Order(newSortOrder(params.Order)),
-		).Take(params.Size).Skip(params.Size*(params.Page-1)),
+		query,
 	).Exec(ctx)
 	if err != nil {
 		if IsErrNotFound(err) {
-			return nil, internal.WrapErrorf(err, internal.ErrNotFound, "series not found")
+			return internal.ChapterPage{}, internal.WrapErrorf(err, internal.ErrNotFound, "series not found")
 		}
 
-		return nil, internal.WrapErrorf(err, internal.ErrUnknown, "failed to find series")
+		return internal.ChapterPage{}, internal.WrapErrorf(err, internal.ErrUnknown, "failed to find series")
 	}
 
-	result := series.toChapterList()
+	chapters, prev, next := internal.KeysetPage(series.toChapterList(), params.Size, cursor, offset, func(chapter internal.Chapter) internal.Cursor {
+		return internal.Cursor{Slug: chapter.Slug, Number: chapter.Number}
+	})
 
-	if len(result) == 0 {
-		return nil, internal.WrapErrorf(err, internal.ErrNotFound, "no chapters found")
+	if len(chapters) == 0 {
+		return internal.ChapterPage{}, internal.NewErrorf(internal.ErrNotFound, "no chapters found")
 	}
 
-	return result, nil
+	total, err := c.Count(ctx, params)
+	if err != nil {
+		return internal.ChapterPage{}, err
+	}
+
+	return internal.ChapterPage{
+		Chapters:   chapters,
+		PrevCursor: prev,
+		NextCursor: next,
+		Total:      total,
+	}, nil
 }
 
 func (c *ChapterRepo) UpdateInit(ctx context.Context, params internal.UpdateInitChapterParams) (internal.Chapter, error) {
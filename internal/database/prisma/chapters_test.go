@@ -416,66 +416,6 @@ func createRandomSeriesWithChapterRel(provider ProviderModel, series SeriesModel
 	}
 }
 
-func TestChapterRepo_Count(t *testing.T) {
-	providerModel, _ := createRandomProvider(t)
-	seriesModel, _ := createRandomInitSeries(t, providerModel)
-	expModel1, _ := createRandomInitChapter(t, providerModel, seriesModel)
-	expModel2, _ := createRandomInitChapter(t, providerModel, seriesModel)
-	expModel3, _ := createRandomInitChapter(t, providerModel, seriesModel)
-
-	client, mock, ensure := NewMock()
-	defer ensure(t)
-
-	chapterRepo := NewChapterRepo(client)
-
-	mock.Chapter.Expect(
-		chapterRepo.q.Chapter.FindMany(
-			Chapter.And(
-				Chapter.ProviderSlug.Equals(providerModel.Slug),
-				Chapter.SeriesSlug.Equals(seriesModel.Slug),
-			),
-		).Select(
-			Chapter.Number.Field(),
-		),
-	).ReturnsMany([]ChapterModel{expModel1, expModel2, expModel3})
-
-	count, err := chapterRepo.Count(context.Background(), internal.FindChapterParams{
-		Provider: providerModel.Slug,
-		Series:   seriesModel.Slug,
-	})
-
-	require.NoError(t, err)
-	require.Equal(t, 3, count)
-}
-
-func TestChapterRepo_Count_UnkownError(t *testing.T) {
-	providerModel, _ := createRandomProvider(t)
-	seriesModel, _ := createRandomInitSeries(t, providerModel)
-
-	client, mock, ensure := NewMock()
-	defer ensure(t)
-
-	chapterRepo := NewChapterRepo(client)
-
-	mock.Chapter.Expect(
-		chapterRepo.q.Chapter.FindMany(
-			Chapter.And(
-				Chapter.ProviderSlug.Equals(providerModel.Slug),
-				Chapter.SeriesSlug.Equals(seriesModel.Slug),
-			),
-		).Select(
-			Chapter.Number.Field(),
-		),
-	).Errors(fmt.Errorf("unknown error"))
-
-	_, err := chapterRepo.Count(context.Background(), internal.FindChapterParams{
-		Provider: providerModel.Slug,
-		Series:   seriesModel.Slug,
-	})
-
-	require.Error(t, err)
-}
-
 func TestChapterRepo_FindAll(t *testing.T) {
 	providerModel, _ := createRandomProvider(t)
 	seriesModel, _ := createRandomInitSeries(t, providerModel)
@@ -608,10 +548,13 @@ func TestChapterRepo_FindPaginated(t *testing.T) {
 			Series.Provider.Fetch(),
 			Series.Chapters.Fetch().OrderBy(
 				Chapter.Number.Order(SortOrderAsc),
-			).Take(2).Skip(2),
+				Chapter.Slug.Order(SortOrderAsc),
+			).Take(3).Skip(2),
 		),
 	).Returns(seriesModelWithRel)
 
+	expectCount(client, mock, 3, countChaptersQuery, providerModel.Slug, seriesModel.Slug)
+
 	foundChapters, err := chapterRepo.FindPaginated(context.Background(), internal.FindChapterParams{
 		Provider: providerModel.Slug,
 		Series:   seriesModel.Slug,
@@ -621,8 +564,32 @@ func TestChapterRepo_FindPaginated(t *testing.T) {
 	})
 
 	require.NoError(t, err)
-	require.Len(t, foundChapters, 1)
-	require.Equal(t, expResult3, foundChapters[0])
+	require.Len(t, foundChapters.Chapters, 1)
+	require.Equal(t, expResult3, foundChapters.Chapters[0])
+	require.Equal(t, 3, foundChapters.Total)
+	require.Equal(t, internal.Cursor{Slug: expModel3.Slug, Number: expModel3.Number, Before: true}.Encode(), foundChapters.PrevCursor)
+	require.Empty(t, foundChapters.NextCursor)
+}
+
+func TestChapterRepo_FindPaginated_InvalidCursor(t *testing.T) {
+	client, _, ensure := NewMock()
+	defer ensure(t)
+
+	chapterRepo := NewChapterRepo(client)
+
+	_, err := chapterRepo.FindPaginated(context.Background(), internal.FindChapterParams{
+		Provider: "provider",
+		Series:   "series",
+		Order:    internal.ASC,
+		Size:     2,
+		Cursor:   "not a cursor!",
+	})
+
+	var ierr *internal.Error
+
+	require.Error(t, err)
+	require.ErrorAs(t, err, &ierr)
+	require.Equal(t, internal.ErrInvalidInput, ierr.Code())
 }
 
 func TestChapterRepo_FindPaginated_NotFound(t *testing.T) {
@@ -644,7 +611,8 @@ func TestChapterRepo_FindPaginated_NotFound(t *testing.T) {
 			Series.Provider.Fetch(),
 			Series.Chapters.Fetch().OrderBy(
 				Chapter.Number.Order(SortOrderAsc),
-			).Take(2).Skip(2),
+				Chapter.Slug.Order(SortOrderAsc),
+			).Take(3).Skip(2),
 		),
 	).Errors(ErrNotFound)
 
@@ -679,7 +647,8 @@ func TestChapterRepo_FindPaginated_UnknownError(t *testing.T) {
 			Series.Provider.Fetch(),
 			Series.Chapters.Fetch().OrderBy(
 				Chapter.Number.Order(SortOrderAsc),
-			).Take(2).Skip(2),
+				Chapter.Slug.Order(SortOrderAsc),
+			).Take(3).Skip(2),
 		),
 	).Errors(fmt.Errorf("unknown error"))
 
@@ -3,6 +3,7 @@ package prisma
 import (
 	"context"
 	"encoding/json"
+	"strings"
 
 	"fourleaves.studio/manga-scraper/internal"
 	"github.com/getsentry/sentry-go"
@@ -27,6 +28,33 @@ func newSortOrder(order internal.SortOrder) SortOrder {
 	}
 }
 
+type countRow struct {
+	Total BigInt `json:"total"`
+}
+
+// queryCount runs a raw "SELECT COUNT(*) AS total" query, so the database counts the rows instead of the client loading them
+func queryCount(ctx context.Context, q *PrismaClient, query string, args ...interface{}) (int, error) {
+	var rows []countRow
+
+	if err := q.Prisma.QueryRaw(query, args...).Exec(ctx, &rows); err != nil {
+		return 0, err
+	}
+
+	if len(rows) == 0 {
+		return 0, nil
+	}
+
+	return int(rows[0].Total), nil
+}
+
+// likeEscaper escapes the LIKE wildcards, MySQL escapes with a backslash by default
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+// escapeLike makes s match itself in a LIKE pattern
+func escapeLike(s string) string {
+	return likeEscaper.Replace(s)
+}
+
 func newStringSliceFromBytes(b []byte) []string {
 	var s []string
 	_ = json.Unmarshal(b, &s)
@@ -2,6 +2,7 @@ package prisma
 
 import (
 	"context"
+	"encoding/json"
 
 	"fourleaves.studio/manga-scraper/internal"
 )
@@ -18,14 +19,47 @@ func NewProviderRepo(prismaClient *PrismaClient) *ProviderRepo {
 
 func (p *ProviderModel) toProvider() internal.Provider {
 	return internal.Provider{
-		Slug:     p.Slug,
-		Name:     p.Name,
-		IsActive: p.IsActive,
-		BaseURL:  p.Scheme + p.Host,
-		ListURL:  p.Scheme + p.Host + p.ListPath,
+		Slug:       p.Slug,
+		Name:       p.Name,
+		IsActive:   p.IsActive,
+		BaseURL:    p.Scheme + p.Host,
+		ListURL:    p.Scheme + p.Host + p.ListPath,
+		Selectors:  newOptionalFromJSON[internal.ProviderSelectors](p.Selectors()),
+		FetchModes: newOptionalFromJSON[internal.ProviderFetchModes](p.FetchModes()),
+		RateLimit:  newOptionalFromJSON[internal.ProviderRateLimit](p.RateLimit()),
 	}
 }
 
+// newOptionalFromJSON decodes an optional JSON column, returning nil when it is unset or invalid
+func newOptionalFromJSON[T any](raw JSON, ok bool) *T {
+	if !ok || len(raw) == 0 || string(raw) == "null" {
+		return nil
+	}
+
+	var v T
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return nil
+	}
+
+	return &v
+}
+
+// newOptionalJSON encodes a value for an optional JSON column, returning nil when it is unset
+func newOptionalJSON[T any](v *T) (*JSON, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	raw := JSON(b)
+
+	return &raw, nil
+}
+
 func (p *ProviderModel) toBC() internal.ProviderBC {
 	return internal.ProviderBC{
 		Provider: internal.Breadcrumb{
@@ -38,13 +72,44 @@ func (p *ProviderModel) toBC() internal.ProviderBC {
 func (p *ProviderRepo) Create(ctx context.Context, params internal.ProviderParams) (internal.Provider, error) {
 	defer newSentrySpan(ctx, "ProviderRepo.Create").Finish()
 
+	selectors, err := newOptionalJSON(params.Selectors)
+	if err != nil {
+		return internal.Provider{}, internal.WrapErrorf(err, internal.ErrInvalidInput, "invalid selectors")
+	}
+
+	fetchModes, err := newOptionalJSON(params.FetchModes)
+	if err != nil {
+		return internal.Provider{}, internal.WrapErrorf(err, internal.ErrInvalidInput, "invalid fetch modes")
+	}
+
+	rateLimit, err := newOptionalJSON(params.RateLimit)
+	if err != nil {
+		return internal.Provider{}, internal.WrapErrorf(err, internal.ErrInvalidInput, "invalid rate limit")
+	}
+
+	optional := []ProviderSetParam{
+		Provider.IsActive.Set(*params.IsActive),
+	}
+
+	if selectors != nil && !params.Selectors.IsZero() {
+		optional = append(optional, Provider.Selectors.Set(*selectors))
+	}
+
+	if fetchModes != nil {
+		optional = append(optional, Provider.FetchModes.Set(*fetchModes))
+	}
+
+	if rateLimit != nil {
+		optional = append(optional, Provider.RateLimit.Set(*rateLimit))
+	}
+
 	provider, err := p.q.Provider.CreateOne(
 		Provider.Slug.Set(params.Slug),
 		Provider.Name.Set(params.Name),
 		Provider.Scheme.Set(params.Scheme),
 		Provider.Host.Set(params.Host),
 		Provider.ListPath.Set(params.ListPath),
-		Provider.IsActive.Set(*params.IsActive),
+		optional...,
 	).Exec(ctx)
 	if err != nil {
 		if _, ok := IsErrUniqueConstraint(err); ok {
@@ -119,14 +184,49 @@ func (p *ProviderRepo) FindAll(ctx context.Context, order internal.SortOrder) ([
 func (p *ProviderRepo) Update(ctx context.Context, params internal.ProviderParams) (internal.Provider, error) {
 	defer newSentrySpan(ctx, "ProviderRepo.Update").Finish()
 
-	provider, err := p.q.Provider.FindUnique(
-		Provider.Slug.Equals(params.Slug),
-	).Update(
+	selectors, err := newOptionalJSON(params.Selectors)
+	if err != nil {
+		return internal.Provider{}, internal.WrapErrorf(err, internal.ErrInvalidInput, "invalid selectors")
+	}
+
+	fetchModes, err := newOptionalJSON(params.FetchModes)
+	if err != nil {
+		return internal.Provider{}, internal.WrapErrorf(err, internal.ErrInvalidInput, "invalid fetch modes")
+	}
+
+	rateLimit, err := newOptionalJSON(params.RateLimit)
+	if err != nil {
+		return internal.Provider{}, internal.WrapErrorf(err, internal.ErrInvalidInput, "invalid rate limit")
+	}
+
+	fields := []ProviderSetParam{
 		Provider.Name.Set(params.Name),
 		Provider.Scheme.Set(params.Scheme),
 		Provider.Host.Set(params.Host),
 		Provider.ListPath.Set(params.ListPath),
 		Provider.IsActive.Set(*params.IsActive),
+	}
+
+	// Selectors, fetch modes and rate limits are only replaced when provided, so existing configs survive a plain update
+	// An empty set of selectors clears them, so the provider goes back to its registered scraper or the theme defaults
+	if params.Selectors != nil && params.Selectors.IsZero() {
+		fields = append(fields, Provider.Selectors.SetOptional(nil))
+	} else if selectors != nil {
+		fields = append(fields, Provider.Selectors.Set(*selectors))
+	}
+
+	if fetchModes != nil {
+		fields = append(fields, Provider.FetchModes.Set(*fetchModes))
+	}
+
+	if rateLimit != nil {
+		fields = append(fields, Provider.RateLimit.Set(*rateLimit))
+	}
+
+	provider, err := p.q.Provider.FindUnique(
+		Provider.Slug.Equals(params.Slug),
+	).Update(
+		fields...,
 	).Exec(ctx)
 	if err != nil {
 		if IsErrNotFound(err) {
@@ -142,12 +242,16 @@ func (p *ProviderRepo) Update(ctx context.Context, params internal.ProviderParam
 func (p *ProviderRepo) Delete(ctx context.Context, slug string) error {
 	defer newSentrySpan(ctx, "ProviderRepo.Delete").Finish()
 
-	_, err := p.q.Provider.FindUnique(
-		Provider.Slug.Equals(slug),
-	).Delete().Exec(ctx)
+	// the series of the provider are deleted by the cascade, they are marked for the indexer beforehand
+	err := p.q.Prisma.Transaction(
+		newSeriesChangesTx(p.q, internal.SeriesDeletedEvent, "providerSlug = ?", slug),
+		p.q.Provider.FindUnique(
+			Provider.Slug.Equals(slug),
+		).Delete().Tx(),
+	).Exec(ctx)
 	if err != nil {
-		if IsErrNotFound(err) {
-			return internal.WrapErrorf(err, internal.ErrNotFound, "provider not found")
+		if _, ferr := p.q.Provider.FindUnique(Provider.Slug.Equals(slug)).Exec(ctx); IsErrNotFound(ferr) {
+			return internal.WrapErrorf(ferr, internal.ErrNotFound, "provider not found")
 		}
 
 		return internal.WrapErrorf(err, internal.ErrUnknown, "failed to delete provider")
@@ -353,13 +353,15 @@ func TestProviderRepo_Update_UnknownError(t *testing.T) {
 }
 
 func TestProviderRepo_Delete(t *testing.T) {
-	client, mock, ensure := NewMock()
+	client, mock, ensure := newMockTx()
 	defer ensure(t)
 
 	providerRepo := NewProviderRepo(client)
 
 	expModel, _ := createRandomProvider(t)
 
+	expectSeriesChanges(mock, client, internal.SeriesDeletedEvent, "providerSlug = ?", expModel.Slug)
+
 	mock.Provider.Expect(
 		client.Provider.FindUnique(
 			Provider.Slug.Equals(expModel.Slug),
@@ -372,37 +374,54 @@ func TestProviderRepo_Delete(t *testing.T) {
 }
 
 func TestProviderRepo_Delete_NotFound(t *testing.T) {
-	client, mock, ensure := NewMock()
+	client, mock, ensure := newMockTx()
 	defer ensure(t)
 
 	providerRepo := NewProviderRepo(client)
 
+	expectSeriesChanges(mock, client, internal.SeriesDeletedEvent, "providerSlug = ?", "non-existent-slug")
+
 	mock.Provider.Expect(
 		client.Provider.FindUnique(
 			Provider.Slug.Equals("non-existent-slug"),
 		).Delete(),
 	).Errors(ErrNotFound)
 
+	mock.Provider.Expect(
+		client.Provider.FindUnique(
+			Provider.Slug.Equals("non-existent-slug"),
+		),
+	).Errors(ErrNotFound)
+
 	err := providerRepo.Delete(context.Background(), "non-existent-slug")
 
-	require.Error(t, err)
+	requireErrorCode(t, err, internal.ErrNotFound)
 	require.True(t, IsErrNotFound(err))
 }
 
 func TestProviderRepo_Delete_UnknownError(t *testing.T) {
-	client, mock, ensure := NewMock()
+	client, mock, ensure := newMockTx()
 	defer ensure(t)
 
 	providerRepo := NewProviderRepo(client)
 
+	expModel, _ := createRandomProvider(t)
+
+	expectSeriesChanges(mock, client, internal.SeriesDeletedEvent, "providerSlug = ?", expModel.Slug)
+
 	mock.Provider.Expect(
 		client.Provider.FindUnique(
-			Provider.Slug.Equals("unknown-error-slug"),
+			Provider.Slug.Equals(expModel.Slug),
 		).Delete(),
 	).Errors(fmt.Errorf("unknown error"))
 
-	err := providerRepo.Delete(context.Background(), "unknown-error-slug")
+	mock.Provider.Expect(
+		client.Provider.FindUnique(
+			Provider.Slug.Equals(expModel.Slug),
+		),
+	).Returns(expModel)
 
-	require.Error(t, err)
-	require.False(t, IsErrNotFound(err))
+	err := providerRepo.Delete(context.Background(), expModel.Slug)
+
+	requireErrorCode(t, err, internal.ErrUnknown)
 }
@@ -0,0 +1,129 @@
+package prisma
+
+import (
+	"context"
+	"time"
+
+	"fourleaves.studio/manga-scraper/internal"
+)
+
+type ReindexJobRepo struct {
+	q *PrismaClient
+}
+
+func NewReindexJobRepo(prismaClient *PrismaClient) *ReindexJobRepo {
+	return &ReindexJobRepo{
+		q: prismaClient,
+	}
+}
+
+func (j *SearchReindexJobModel) toReindexJob() internal.ReindexJob {
+	return internal.ReindexJob{
+		ID:        j.ID,
+		Provider:  j.Provider,
+		Status:    internal.ReindexJobStatus(j.Status),
+		Index:     j.Index,
+		Total:     j.Total,
+		Indexed:   j.Indexed,
+		Message:   j.Message,
+		CreatedAt: j.CreatedAt,
+		UpdatedAt: j.UpdatedAt,
+	}
+}
+
+// Create starts a job holding the provider, it fails with ErrUniqueConstraint while another job holds it
+func (r *ReindexJobRepo) Create(ctx context.Context, provider string) (internal.ReindexJob, error) {
+	defer newSentrySpan(ctx, "ReindexJobRepo.Create").Finish()
+
+	job, err := r.q.SearchReindexJob.CreateOne(
+		SearchReindexJob.Provider.Set(provider),
+		SearchReindexJob.Status.Set(string(internal.PendingReindexStatus)),
+		SearchReindexJob.Message.Set(""),
+		SearchReindexJob.ActiveProvider.Set(provider),
+	).Exec(ctx)
+	if err != nil {
+		if _, ok := IsErrUniqueConstraint(err); ok {
+			return internal.ReindexJob{}, internal.WrapErrorf(err, internal.ErrUniqueConstraint, "reindex job already running")
+		}
+
+		return internal.ReindexJob{}, internal.WrapErrorf(err, internal.ErrUnknown, "failed to create reindex job")
+	}
+
+	return job.toReindexJob(), nil
+}
+
+func (r *ReindexJobRepo) Find(ctx context.Context, id string) (internal.ReindexJob, error) {
+	defer newSentrySpan(ctx, "ReindexJobRepo.Find").Finish()
+
+	job, err := r.q.SearchReindexJob.FindUnique(
+		SearchReindexJob.ID.Equals(id),
+	).Exec(ctx)
+	if err != nil {
+		return internal.ReindexJob{}, internal.WrapErrorf(err, internal.ErrNotFound, "reindex job not found")
+	}
+
+	return job.toReindexJob(), nil
+}
+
+// FindActive returns the job holding the provider if it made progress after since,
+// a job that stopped reporting progress before since was left behind by a stopped process
+func (r *ReindexJobRepo) FindActive(ctx context.Context, provider string, since time.Time) (internal.ReindexJob, error) {
+	defer newSentrySpan(ctx, "ReindexJobRepo.FindActive").Finish()
+
+	job, err := r.q.SearchReindexJob.FindFirst(
+		SearchReindexJob.ActiveProvider.Equals(provider),
+		SearchReindexJob.UpdatedAt.Gte(since),
+	).Exec(ctx)
+	if err != nil {
+		return internal.ReindexJob{}, internal.WrapErrorf(err, internal.ErrNotFound, "no active reindex job")
+	}
+
+	return job.toReindexJob(), nil
+}
+
+// ReleaseStale fails the unfinished job of the provider that made no progress since since, so a new job can hold it
+func (r *ReindexJobRepo) ReleaseStale(ctx context.Context, provider string, since time.Time) error {
+	defer newSentrySpan(ctx, "ReindexJobRepo.ReleaseStale").Finish()
+
+	_, err := r.q.SearchReindexJob.FindMany(
+		SearchReindexJob.ActiveProvider.Equals(provider),
+		SearchReindexJob.UpdatedAt.Lt(since),
+	).Update(
+		SearchReindexJob.Status.Set(string(internal.FailedReindexStatus)),
+		SearchReindexJob.Message.Set("stopped reporting progress"),
+		SearchReindexJob.ActiveProvider.SetOptional(nil),
+	).Exec(ctx)
+	if err != nil {
+		return internal.WrapErrorf(err, internal.ErrUnknown, "failed to release stale reindex job")
+	}
+
+	return nil
+}
+
+// Update saves the progress of a job, a completed or failed job releases its provider
+func (r *ReindexJobRepo) Update(ctx context.Context, params internal.UpdateReindexJobParams) (internal.ReindexJob, error) {
+	defer newSentrySpan(ctx, "ReindexJobRepo.Update").Finish()
+
+	fields := []SearchReindexJobSetParam{
+		SearchReindexJob.Status.Set(string(params.Status)),
+		SearchReindexJob.Index.Set(params.Index),
+		SearchReindexJob.Total.Set(params.Total),
+		SearchReindexJob.Indexed.Set(params.Indexed),
+		SearchReindexJob.Message.Set(params.Message),
+	}
+
+	if (internal.ReindexJob{Status: params.Status}).Done() {
+		fields = append(fields, SearchReindexJob.ActiveProvider.SetOptional(nil))
+	}
+
+	job, err := r.q.SearchReindexJob.FindUnique(
+		SearchReindexJob.ID.Equals(params.ID),
+	).Update(
+		fields...,
+	).Exec(ctx)
+	if err != nil {
+		return internal.ReindexJob{}, internal.WrapErrorf(err, internal.ErrUnknown, "failed to update reindex job")
+	}
+
+	return job.toReindexJob(), nil
+}
@@ -2,6 +2,7 @@ package prisma
 
 import (
 	"context"
+	"fmt"
 
 	"fourleaves.studio/manga-scraper/internal"
 )
@@ -69,26 +70,129 @@ func (r *ScraperRepo) Find(ctx context.Context, id string) (internal.ScrapeReque
 	return receipt.toScrapeRequest(), nil
 }
 
-func (r *ScraperRepo) FindPendings(ctx context.Context, params internal.FindScrapeRequestParams) ([]internal.ScrapeRequest, error) {
-	defer newSentrySpan(ctx, "ScraperRepo.FindPending").Finish()
+type scrapeRequestRow struct {
+	ID string `json:"id"`
+}
+
+// FindPaginated filters with the same raw SQL conditions as Count, the ids of the page are read first
+// and the scrape requests are then loaded in that order
+func (r *ScraperRepo) FindPaginated(ctx context.Context, params internal.FindScrapeRequestParams) ([]internal.ScrapeRequest, error) {
+	defer newSentrySpan(ctx, "ScraperRepo.FindPaginated").Finish()
+
+	conditions, args := newScrapeRequestConditions(params)
+
+	direction := "ASC"
+	if params.Order == internal.DESC {
+		direction = "DESC"
+	}
+
+	var rows []scrapeRequestRow
+
+	err := r.q.Prisma.QueryRaw(
+		"SELECT id FROM `ScrapeRequest`"+newWhereClause(conditions)+
+			fmt.Sprintf(" ORDER BY createdAt %[1]s, id %[1]s LIMIT %[2]d OFFSET %[3]d", direction, params.Size, params.Size*(params.Page-1)),
+		args...,
+	).Exec(ctx, &rows)
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrUnknown, "failed to find scrape requests")
+	}
+
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]string, 0, len(rows))
+	for i := range rows {
+		ids = append(ids, rows[i].ID)
+	}
 
 	receipts, err := r.q.ScrapeRequest.FindMany(
-		ScrapeRequest.Status.Equals(string(params.Status)),
-	).Take(params.Size).Skip(params.Page * params.Size).OrderBy(
-		ScrapeRequest.CreatedAt.Order(newSortOrder(params.Order)),
+		ScrapeRequest.ID.In(ids),
 	).Exec(ctx)
 	if err != nil {
-		return nil, internal.WrapErrorf(err, internal.ErrUnknown, "failed to find pending scrape requests")
+		return nil, internal.WrapErrorf(err, internal.ErrUnknown, "failed to find scrape requests")
+	}
+
+	byID := make(map[string]ScrapeRequestModel, len(receipts))
+	for i := range receipts {
+		byID[receipts[i].ID] = receipts[i]
 	}
 
 	var result []internal.ScrapeRequest
-	for _, receipt := range receipts {
-		result = append(result, receipt.toScrapeRequest())
+	for _, id := range ids {
+		// a request deleted between the two reads is left out of the page
+		if receipt, ok := byID[id]; ok {
+			result = append(result, receipt.toScrapeRequest())
+		}
 	}
 
 	return result, nil
 }
 
+// Count returns the number of scrape requests matching the filters of params, ignoring the page
+func (r *ScraperRepo) Count(ctx context.Context, params internal.FindScrapeRequestParams) (int, error) {
+	defer newSentrySpan(ctx, "ScraperRepo.Count").Finish()
+
+	conditions, args := newScrapeRequestConditions(params)
+
+	total, err := queryCount(ctx, r.q, "SELECT COUNT(*) AS total FROM `ScrapeRequest`"+newWhereClause(conditions), args...)
+	if err != nil {
+		return 0, internal.WrapErrorf(err, internal.ErrUnknown, "failed to count scrape requests")
+	}
+
+	return total, nil
+}
+
+// newScrapeRequestConditions only filters on the fields that are set, FindPaginated and Count share it
+func newScrapeRequestConditions(params internal.FindScrapeRequestParams) ([]string, []interface{}) {
+	var (
+		conditions []string
+		args       []interface{}
+	)
+
+	if params.Type != "" {
+		conditions = append(conditions, "`type` = ?")
+		args = append(args, string(params.Type))
+	}
+
+	if params.Provider != "" {
+		conditions = append(conditions, "`provider` = ?")
+		args = append(args, params.Provider)
+	}
+
+	if params.Series != "" {
+		conditions = append(conditions, "`series` = ?")
+		args = append(args, params.Series)
+	}
+
+	if params.Status != "" {
+		conditions = append(conditions, "`status` = ?")
+		args = append(args, string(params.Status))
+	}
+
+	if params.Error != nil {
+		conditions = append(conditions, "`error` = ?")
+		args = append(args, *params.Error)
+	}
+
+	if params.Message != "" {
+		conditions = append(conditions, "`message` LIKE CONCAT('%', ?, '%')")
+		args = append(args, escapeLike(params.Message))
+	}
+
+	if !params.CreatedAfter.IsZero() {
+		conditions = append(conditions, "`createdAt` >= ?")
+		args = append(args, params.CreatedAfter.UTC().Format(browseTimeLayout))
+	}
+
+	if !params.CreatedBefore.IsZero() {
+		conditions = append(conditions, "`createdAt` < ?")
+		args = append(args, params.CreatedBefore.UTC().Format(browseTimeLayout))
+	}
+
+	return conditions, args
+}
+
 func (r *ScraperRepo) Update(ctx context.Context, params internal.UpdateScrapeRequestParams) (internal.ScrapeRequest, error) {
 	defer newSentrySpan(ctx, "ScraperRepo.Update").Finish()
 
@@ -96,7 +200,7 @@ func (r *ScraperRepo) Update(ctx context.Context, params internal.UpdateScrapeRe
 		ScrapeRequest.ID.Equals(params.ID),
 	).Update(
 		ScrapeRequest.Status.Set(string(params.Status)),
-		ScrapeRequest.Retries.Increment(1),
+		ScrapeRequest.Retries.Set(params.Retries),
 		ScrapeRequest.TotalTime.Set(params.TotalTime),
 		ScrapeRequest.Error.Set(params.Error),
 		ScrapeRequest.Message.Set(params.Message),
@@ -108,6 +212,79 @@ func (r *ScraperRepo) Update(ctx context.Context, params internal.UpdateScrapeRe
 	return receipt.toScrapeRequest(), nil
 }
 
+// Cancel marks a pending scrape request as cancelled, the scraper worker skips it once it is consumed
+func (r *ScraperRepo) Cancel(ctx context.Context, id string) (internal.ScrapeRequest, error) {
+	defer newSentrySpan(ctx, "ScraperRepo.Cancel").Finish()
+
+	res, err := r.q.ScrapeRequest.FindMany(
+		ScrapeRequest.ID.Equals(id),
+		ScrapeRequest.Status.Equals(string(internal.PendingRequestStatus)),
+	).Update(
+		ScrapeRequest.Status.Set(string(internal.CancelledRequestStatus)),
+		ScrapeRequest.Message.Set("Cancelled"),
+	).Exec(ctx)
+	if err != nil {
+		return internal.ScrapeRequest{}, internal.WrapErrorf(err, internal.ErrUnknown, "failed to cancel scrape request")
+	}
+
+	receipt, err := r.q.ScrapeRequest.FindUnique(
+		ScrapeRequest.ID.Equals(id),
+	).Exec(ctx)
+	if err != nil {
+		return internal.ScrapeRequest{}, internal.WrapErrorf(err, internal.ErrNotFound, "scrape request not found")
+	}
+
+	if res.Count == 0 {
+		return internal.ScrapeRequest{}, internal.NewErrorf(internal.ErrInvalidInput, "scrape request is %s, only pending requests can be cancelled", receipt.Status)
+	}
+
+	return receipt.toScrapeRequest(), nil
+}
+
+// requeueScrapeRequestQuery sets a failed or dead-lettered request back to pending unless its target already has a
+// pending request, MySQL only reads the table being updated through a derived table
+const requeueScrapeRequestQuery = "UPDATE `ScrapeRequest` SET status = ?, retries = 0, error = false, message = 'Retry requested', updatedAt = NOW(3)" +
+	" WHERE id = ? AND status IN (?, ?) AND NOT EXISTS (SELECT 1 FROM (" +
+	"SELECT id FROM `ScrapeRequest` WHERE type = ? AND provider = ? AND series = ? AND chapter = ? AND status = ?" +
+	") AS pending)"
+
+// Requeue sets a failed request back to pending so it can be published again, in a single conditional update
+// It reports false when the same target already has a pending request or the request is no longer failed
+func (r *ScraperRepo) Requeue(ctx context.Context, request internal.ScrapeRequest) (internal.ScrapeRequest, bool, error) {
+	defer newSentrySpan(ctx, "ScraperRepo.Requeue").Finish()
+
+	pending := string(internal.PendingRequestStatus)
+
+	res, err := r.q.Prisma.ExecuteRaw(
+		requeueScrapeRequestQuery,
+		pending,
+		request.ID,
+		string(internal.FailedRequestStatus),
+		string(internal.DeadLetterRequestStatus),
+		string(request.Type),
+		request.Provider,
+		request.Series,
+		request.Chapter,
+		pending,
+	).Exec(ctx)
+	if err != nil {
+		return internal.ScrapeRequest{}, false, internal.WrapErrorf(err, internal.ErrUnknown, "failed to requeue scrape request")
+	}
+
+	if res.Count == 0 {
+		return internal.ScrapeRequest{}, false, nil
+	}
+
+	receipt, err := r.q.ScrapeRequest.FindUnique(
+		ScrapeRequest.ID.Equals(request.ID),
+	).Exec(ctx)
+	if err != nil {
+		return internal.ScrapeRequest{}, false, internal.WrapErrorf(err, internal.ErrNotFound, "scrape request not found")
+	}
+
+	return receipt.toScrapeRequest(), true, nil
+}
+
 func (r *ScraperRepo) Delete(ctx context.Context, id string) error {
 	defer newSentrySpan(ctx, "ScraperRepo.Delete").Finish()
 
@@ -120,3 +297,26 @@ func (r *ScraperRepo) Delete(ctx context.Context, id string) error {
 
 	return nil
 }
+
+func (r *ScraperRepo) DeleteMany(ctx context.Context, params internal.DeleteScrapeRequestParams) (int, error) {
+	defer newSentrySpan(ctx, "ScraperRepo.DeleteMany").Finish()
+
+	filters := []ScrapeRequestWhereParam{
+		ScrapeRequest.CreatedAt.Lt(params.CreatedBefore),
+	}
+
+	if params.Status != "" {
+		filters = append(filters, ScrapeRequest.Status.Equals(string(params.Status)))
+	} else {
+		filters = append(filters, ScrapeRequest.Not(
+			ScrapeRequest.Status.Equals(string(internal.PendingRequestStatus)),
+		))
+	}
+
+	res, err := r.q.ScrapeRequest.FindMany(filters...).Delete().Exec(ctx)
+	if err != nil {
+		return 0, internal.WrapErrorf(err, internal.ErrUnknown, "failed to delete scrape requests")
+	}
+
+	return res.Count, nil
+}
@@ -0,0 +1,134 @@
+package prisma
+
+import (
+	"context"
+	"testing"
+
+	"fourleaves.studio/manga-scraper/internal"
+	prismamock "github.com/steebchen/prisma-client-go/engine/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewScrapeRequestConditions(t *testing.T) {
+	isError := true
+
+	conditions, args := newScrapeRequestConditions(internal.FindScrapeRequestParams{
+		Provider: "asura",
+		Status:   internal.DeadLetterRequestStatus,
+		Error:    &isError,
+		Message:  `100%_done\`,
+	})
+
+	require.Equal(t, []string{
+		"`provider` = ?",
+		"`status` = ?",
+		"`error` = ?",
+		"`message` LIKE CONCAT('%', ?, '%')",
+	}, conditions)
+	require.Equal(t, []interface{}{"asura", string(internal.DeadLetterRequestStatus), true, `100\%\_done\\`}, args)
+}
+
+func TestScraperRepo_FindPaginated(t *testing.T) {
+	client, mock, ensure := NewMock()
+	defer ensure(t)
+
+	scraperRepo := NewScraperRepo(client)
+
+	*mock.Expectations = append(*mock.Expectations, prismamock.Expectation{
+		Query: client.Prisma.QueryRaw(
+			"SELECT id FROM `ScrapeRequest` WHERE `status` = ? ORDER BY createdAt DESC, id DESC LIMIT 2 OFFSET 2",
+			string(internal.DeadLetterRequestStatus),
+		).ExtractQuery(),
+		Want: &[]scrapeRequestRow{{ID: "request-2"}, {ID: "request-1"}},
+	})
+
+	// the store returns the page out of order
+	mock.ScrapeRequest.Expect(
+		scraperRepo.q.ScrapeRequest.FindMany(
+			ScrapeRequest.ID.In([]string{"request-2", "request-1"}),
+		),
+	).ReturnsMany([]ScrapeRequestModel{
+		{InnerScrapeRequest: InnerScrapeRequest{ID: "request-1", Status: string(internal.DeadLetterRequestStatus)}},
+		{InnerScrapeRequest: InnerScrapeRequest{ID: "request-2", Status: string(internal.DeadLetterRequestStatus)}},
+	})
+
+	result, err := scraperRepo.FindPaginated(context.Background(), internal.FindScrapeRequestParams{
+		Status: internal.DeadLetterRequestStatus,
+		Order:  internal.DESC,
+		Size:   2,
+		Page:   2,
+	})
+
+	require.NoError(t, err)
+	require.Len(t, result, 2)
+	require.Equal(t, "request-2", result[0].ID)
+	require.Equal(t, "request-1", result[1].ID)
+}
+
+func expectRequeue(mock *Mock, q *PrismaClient, request internal.ScrapeRequest, count int) {
+	pending := string(internal.PendingRequestStatus)
+
+	*mock.Expectations = append(*mock.Expectations, prismamock.Expectation{
+		Query: q.Prisma.ExecuteRaw(
+			requeueScrapeRequestQuery,
+			pending,
+			request.ID,
+			string(internal.FailedRequestStatus),
+			string(internal.DeadLetterRequestStatus),
+			string(request.Type),
+			request.Provider,
+			request.Series,
+			request.Chapter,
+			pending,
+		).ExtractQuery(),
+		Want: count,
+	})
+}
+
+func TestScraperRepo_Requeue(t *testing.T) {
+	client, mock, ensure := NewMock()
+	defer ensure(t)
+
+	scraperRepo := NewScraperRepo(client)
+
+	request := internal.ScrapeRequest{
+		ID:       "request-1",
+		Type:     internal.ChapterListRequestType,
+		Provider: "asura",
+		Series:   "solo-leveling",
+		Status:   internal.DeadLetterRequestStatus,
+	}
+
+	expectRequeue(mock, client, request, 1)
+
+	mock.ScrapeRequest.Expect(
+		scraperRepo.q.ScrapeRequest.FindUnique(
+			ScrapeRequest.ID.Equals(request.ID),
+		),
+	).Returns(ScrapeRequestModel{
+		InnerScrapeRequest: InnerScrapeRequest{ID: request.ID, Status: string(internal.PendingRequestStatus)},
+	})
+
+	result, ok, err := scraperRepo.Requeue(context.Background(), request)
+
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, internal.PendingRequestStatus, result.Status)
+}
+
+func TestScraperRepo_Requeue_Skipped(t *testing.T) {
+	client, mock, ensure := NewMock()
+	defer ensure(t)
+
+	scraperRepo := NewScraperRepo(client)
+
+	// the request is no longer failed or its target already has a pending request
+	request := internal.ScrapeRequest{ID: "request-1", Type: internal.SeriesListRequestType, Provider: "asura", Status: internal.FailedRequestStatus}
+
+	expectRequeue(mock, client, request, 0)
+
+	_, ok, err := scraperRepo.Requeue(context.Background(), request)
+
+	require.NoError(t, err)
+	require.False(t, ok)
+}
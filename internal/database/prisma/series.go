@@ -2,7 +2,10 @@ package prisma
 
 import (
 	"context"
+	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
 	"fourleaves.studio/manga-scraper/internal"
 )
@@ -21,18 +24,34 @@ func (s *SeriesModel) toSeries() internal.Series {
 	provider := s.Provider()
 
 	return internal.Series{
-		Provider:      provider.Slug,
-		Slug:          s.Slug,
-		Title:         s.Title,
-		SourceURL:     provider.Scheme + provider.Host + s.SourcePath,
-		CoverURL:      s.ThumbnailURL,
-		Synopsis:      s.Synopsis,
-		Genres:        newStringSliceFromBytes(s.Genres),
-		ChaptersCount: s.ChaptersCount,
-		LatestChapter: s.LatestChapter,
+		Provider:        provider.Slug,
+		Slug:            s.Slug,
+		Title:           s.Title,
+		SourceURL:       provider.Scheme + provider.Host + s.SourcePath,
+		CoverURL:        s.ThumbnailURL,
+		Synopsis:        s.Synopsis,
+		Genres:          newStringSliceFromBytes(s.Genres),
+		Status:          internal.SeriesStatus(s.Status),
+		Type:            s.Type,
+		Author:          s.Author,
+		Artist:          s.Artist,
+		ReleaseYear:     s.ReleaseYear,
+		ChaptersCount:   s.ChaptersCount,
+		LatestChapter:   s.LatestChapter,
+		LatestChapterAt: s.latestChapterAt(),
+		UpdatedAt:       s.UpdatedAt,
 	}
 }
 
+func (s *SeriesModel) latestChapterAt() *time.Time {
+	at, ok := s.LatestChapterAt()
+	if !ok {
+		return nil
+	}
+
+	return &at
+}
+
 func (s *SeriesModel) toSeriesSR() internal.CreateScrapeRequestParams {
 	provider := s.Provider()
 
@@ -55,8 +74,14 @@ func (s *SeriesModel) toSeriesUpsert(provider *ProviderModel) internal.Series {
 		CoverURL:      s.ThumbnailURL,
 		Synopsis:      s.Synopsis,
 		Genres:        newStringSliceFromBytes(s.Genres),
+		Status:        internal.SeriesStatus(s.Status),
+		Type:          s.Type,
+		Author:        s.Author,
+		Artist:        s.Artist,
+		ReleaseYear:   s.ReleaseYear,
 		ChaptersCount: s.ChaptersCount,
 		LatestChapter: s.LatestChapter,
+		UpdatedAt:     s.UpdatedAt,
 	}
 }
 
@@ -86,15 +111,22 @@ func (p *ProviderModel) toSeriesList() []internal.Series {
 
 	for i := range seriesList {
 		result = append(result, internal.Series{
-			Provider:      p.Slug,
-			Slug:          seriesList[i].Slug,
-			Title:         seriesList[i].Title,
-			SourceURL:     p.Scheme + p.Host + seriesList[i].SourcePath,
-			CoverURL:      seriesList[i].ThumbnailURL,
-			Synopsis:      seriesList[i].Synopsis,
-			Genres:        newStringSliceFromBytes(seriesList[i].Genres),
-			ChaptersCount: seriesList[i].ChaptersCount,
-			LatestChapter: seriesList[i].LatestChapter,
+			Provider:        p.Slug,
+			Slug:            seriesList[i].Slug,
+			Title:           seriesList[i].Title,
+			SourceURL:       p.Scheme + p.Host + seriesList[i].SourcePath,
+			CoverURL:        seriesList[i].ThumbnailURL,
+			Synopsis:        seriesList[i].Synopsis,
+			Genres:          newStringSliceFromBytes(seriesList[i].Genres),
+			Status:          internal.SeriesStatus(seriesList[i].Status),
+			Type:            seriesList[i].Type,
+			Author:          seriesList[i].Author,
+			Artist:          seriesList[i].Artist,
+			ReleaseYear:     seriesList[i].ReleaseYear,
+			ChaptersCount:   seriesList[i].ChaptersCount,
+			LatestChapter:   seriesList[i].LatestChapter,
+			LatestChapterAt: seriesList[i].latestChapterAt(),
+			UpdatedAt:       seriesList[i].UpdatedAt,
 		})
 	}
 
@@ -141,7 +173,7 @@ func (p *ProviderModel) toChapterSR() []internal.CreateScrapeRequestParams {
 func (s *SeriesRepo) CreateInit(ctx context.Context, params internal.CreateInitSeriesParams) (internal.Series, error) {
 	defer newSentrySpan(ctx, "SeriesRepo.CreateInit").Finish()
 
-	series, err := s.q.Series.CreateOne(
+	create := s.q.Series.CreateOne(
 		Series.Slug.Set(params.Slug),
 		Series.Title.Set(params.Title),
 		Series.SourcePath.Set(params.SourcePath),
@@ -151,22 +183,27 @@ func (s *SeriesRepo) CreateInit(ctx context.Context, params internal.CreateInitS
 		Series.Provider.Link(Provider.Slug.Equals(params.Provider)),
 	).With(
 		Series.Provider.Fetch(),
+	).Tx()
+
+	err := s.q.Prisma.Transaction(
+		newSeriesChangeTx(s.q, params.Provider, params.Slug, internal.SeriesUpsertedEvent),
+		create,
 	).Exec(ctx)
 	if err != nil {
-		if _, ok := IsErrUniqueConstraint(err); ok {
+		if lookupSeries(ctx, s.q, params.Provider, params.Slug) == nil {
 			return internal.Series{}, internal.WrapErrorf(err, internal.ErrUniqueConstraint, "series already exists")
 		}
 
 		return internal.Series{}, internal.WrapErrorf(err, internal.ErrUnknown, "failed to create series")
 	}
 
-	return series.toSeries(), nil
+	return create.Result().toSeries(), nil
 }
 
 func (s *SeriesRepo) UpsertInit(ctx context.Context, params internal.CreateInitSeriesParams) (internal.Series, error) {
 	defer newSentrySpan(ctx, "SeriesRepo.UpsertInit").Finish()
 
-	series, err := s.q.Series.UpsertOne(
+	upsert := s.q.Series.UpsertOne(
 		Series.SeriesUnique(
 			Series.ProviderSlug.Equals(params.Provider),
 			Series.Slug.Equals(params.Slug),
@@ -182,6 +219,11 @@ func (s *SeriesRepo) UpsertInit(ctx context.Context, params internal.CreateInitS
 	).Update(
 		Series.Title.Set(params.Title),
 		Series.SourcePath.Set(params.SourcePath),
+	).Tx()
+
+	err := s.q.Prisma.Transaction(
+		newSeriesChangeTx(s.q, params.Provider, params.Slug, internal.SeriesUpsertedEvent),
+		upsert,
 	).Exec(ctx)
 	if err != nil {
 		return internal.Series{}, internal.WrapErrorf(err, internal.ErrUnknown, "failed to upsert series")
@@ -194,7 +236,7 @@ func (s *SeriesRepo) UpsertInit(ctx context.Context, params internal.CreateInitS
 		return internal.Series{}, internal.WrapErrorf(err, internal.ErrUnknown, "failed to find provider")
 	}
 
-	return series.toSeriesUpsert(provider), nil
+	return upsert.Result().toSeriesUpsert(provider), nil
 }
 
 func (s *SeriesRepo) Find(ctx context.Context, params internal.FindSeriesParams) (internal.Series, error) {
@@ -371,37 +413,312 @@ func (s *SeriesRepo) FindEmptyChapters(ctx context.Context, params internal.Find
 	return result, nil
 }
 
-func (s *SeriesRepo) FindPaginated(ctx context.Context, params internal.FindSeriesParams) ([]internal.Series, error) {
+// FindPaginated reads a page of the provider series keyed by slug after params.Cursor,
+// without a cursor params.Page is still honoured by offset for older clients
+func (s *SeriesRepo) FindPaginated(ctx context.Context, params internal.FindSeriesParams) (internal.SeriesPage, error) {
 	defer newSentrySpan(ctx, "SeriesRepo.FindPaginated").Finish()
 
+	cursor, err := internal.DecodeCursor(params.Cursor)
+	if err != nil {
+		return internal.SeriesPage{}, err
+	}
+
+	order := SortOrderDesc
+	if cursor.Ascending(params.Order) {
+		order = SortOrderAsc
+	}
+
+	var filters []SeriesWhereParam
+
+	if cursor != nil {
+		if cursor.Ascending(params.Order) {
+			filters = append(filters, Series.Slug.Gt(cursor.Slug))
+		} else {
+			filters = append(filters, Series.Slug.Lt(cursor.Slug))
+		}
+	}
+
+	// one extra series tells whether there is a page after this one
+	query := Provider.Series.Fetch(filters...).OrderBy(
+		Series.Slug.Order(order),
+	).Take(params.Size + 1)
+
+	offset := cursor == nil && params.Page > 1
+	if offset {
+		query = query.Skip(params.Size * (params.Page - 1))
+	}
+
 	provider, err := s.q.Provider.FindUnique(
 		Provider.Slug.Equals(params.Provider),
 	).With(
-		Provider.Series.Fetch().OrderBy(
-			Series.Slug.Order(newSortOrder(params.Order)),
-		).Take(params.Size).Skip(params.Size * (params.Page - 1)),
+		query,
 	).Exec(ctx)
 	if err != nil {
 		if IsErrNotFound(err) {
-			return nil, internal.WrapErrorf(err, internal.ErrNotFound, "provider not found")
+			return internal.SeriesPage{}, internal.WrapErrorf(err, internal.ErrNotFound, "provider not found")
 		}
 
-		return nil, internal.WrapErrorf(err, internal.ErrUnknown, "failed to find provider")
+		return internal.SeriesPage{}, internal.WrapErrorf(err, internal.ErrUnknown, "failed to find provider")
 	}
 
-	result := provider.toSeriesList()
+	series, prev, next := internal.KeysetPage(provider.toSeriesList(), params.Size, cursor, offset, func(series internal.Series) internal.Cursor {
+		return internal.Cursor{Slug: series.Slug}
+	})
 
-	if len(result) == 0 {
-		return nil, internal.WrapErrorf(err, internal.ErrNotFound, "no series found")
+	if len(series) == 0 {
+		return internal.SeriesPage{}, internal.NewErrorf(internal.ErrNotFound, "no series found")
 	}
 
-	return result, nil
+	total, err := s.count(ctx, params.Provider)
+	if err != nil {
+		return internal.SeriesPage{}, err
+	}
+
+	return internal.SeriesPage{
+		Series:     series,
+		PrevCursor: prev,
+		NextCursor: next,
+		Total:      total,
+	}, nil
+}
+
+const countSeriesQuery = "SELECT COUNT(*) AS total FROM `Series` WHERE providerSlug = ?"
+
+func (s *SeriesRepo) count(ctx context.Context, provider string) (int, error) {
+	defer newSentrySpan(ctx, "SeriesRepo.count").Finish()
+
+	total, err := queryCount(ctx, s.q, countSeriesQuery, provider)
+	if err != nil {
+		return 0, internal.WrapErrorf(err, internal.ErrUnknown, "failed to count series")
+	}
+
+	return total, nil
+}
+
+// browseSeriesColumns maps the series sorts to their column, series without chapters sort as the oldest
+var browseSeriesColumns = map[internal.SeriesSort]string{
+	internal.SlugSeriesSort:          "s.slug",
+	internal.TitleSeriesSort:         "s.title",
+	internal.ChaptersSeriesSort:      "s.chaptersCount",
+	internal.UpdatedSeriesSort:       "s.updatedAt",
+	internal.LatestChapterSeriesSort: "COALESCE(s.latestChapterAt, '1970-01-01 00:00:00.000')",
+}
+
+// browseTimeLayout is how times are compared with DATETIME(3) columns, prisma stores them in UTC
+const browseTimeLayout = "2006-01-02 15:04:05.000"
+
+type browseSeriesRow struct {
+	ID string `json:"id"`
+}
+
+// Browse filters and sorts series with raw SQL since the genres JSON column can not be filtered through the client,
+// the ids of the page are read first and the series are then loaded with their provider
+func (s *SeriesRepo) Browse(ctx context.Context, params internal.BrowseSeriesParams) (internal.SeriesPage, error) {
+	defer newSentrySpan(ctx, "SeriesRepo.Browse").Finish()
+
+	cursor, err := internal.DecodeCursor(params.Cursor)
+	if err != nil {
+		return internal.SeriesPage{}, err
+	}
+
+	sort := params.Sort
+	if sort == "" {
+		sort = internal.SlugSeriesSort
+	}
+
+	column := browseSeriesColumns[sort]
+	filters, args := newBrowseSeriesFilters(params)
+
+	direction, operator := "DESC", "<"
+	if cursor.Ascending(params.Order) {
+		direction, operator = "ASC", ">"
+	}
+
+	conditions := filters
+	pageArgs := args
+
+	if cursor != nil {
+		conditions = append(conditions[:len(conditions):len(conditions)], fmt.Sprintf(
+			"(%[1]s %[2]s ? OR (%[1]s = ? AND (s.providerSlug %[2]s ? OR (s.providerSlug = ? AND s.slug %[2]s ?))))",
+			column, operator,
+		))
+		pageArgs = append(args[:len(args):len(args)], cursor.Value, cursor.Value, cursor.Provider, cursor.Provider, cursor.Slug)
+	}
+
+	// one extra series tells whether there is a page after this one
+	var rows []browseSeriesRow
+
+	err = s.q.Prisma.QueryRaw(
+		"SELECT s.id FROM `Series` AS s"+newWhereClause(conditions)+
+			fmt.Sprintf(" ORDER BY %[1]s %[2]s, s.providerSlug %[2]s, s.slug %[2]s LIMIT %[3]d", column, direction, params.Size+1),
+		pageArgs...,
+	).Exec(ctx, &rows)
+	if err != nil {
+		return internal.SeriesPage{}, internal.WrapErrorf(err, internal.ErrUnknown, "failed to browse series")
+	}
+
+	if len(rows) == 0 {
+		return internal.SeriesPage{}, internal.NewErrorf(internal.ErrNotFound, "no series found")
+	}
+
+	ids := make([]string, 0, len(rows))
+	for i := range rows {
+		ids = append(ids, rows[i].ID)
+	}
+
+	models, err := s.q.Series.FindMany(
+		Series.ID.In(ids),
+	).With(
+		Series.Provider.Fetch(),
+	).Exec(ctx)
+	if err != nil {
+		return internal.SeriesPage{}, internal.WrapErrorf(err, internal.ErrUnknown, "failed to find series")
+	}
+
+	byID := make(map[string]SeriesModel, len(models))
+	for i := range models {
+		byID[models[i].ID] = models[i]
+	}
+
+	ordered := make([]SeriesModel, 0, len(ids))
+	for _, id := range ids {
+		// a series deleted between the two queries is left out of the page
+		if model, ok := byID[id]; ok {
+			ordered = append(ordered, model)
+		}
+	}
+
+	ordered, prev, next := internal.KeysetPage(ordered, params.Size, cursor, false, func(model SeriesModel) internal.Cursor {
+		return newBrowseSeriesCursor(model, sort)
+	})
+
+	series := make([]internal.Series, 0, len(ordered))
+	for i := range ordered {
+		series = append(series, ordered[i].toSeries())
+	}
+
+	total, err := queryCount(ctx, s.q, "SELECT COUNT(*) AS total FROM `Series` AS s"+newWhereClause(filters), args...)
+	if err != nil {
+		return internal.SeriesPage{}, internal.WrapErrorf(err, internal.ErrUnknown, "failed to count series")
+	}
+
+	return internal.SeriesPage{
+		Series:     series,
+		PrevCursor: prev,
+		NextCursor: next,
+		Total:      total,
+	}, nil
+}
+
+// newBrowseSeriesFilters returns the WHERE conditions of the params and their arguments, genres match case insensitively
+func newBrowseSeriesFilters(params internal.BrowseSeriesParams) ([]string, []interface{}) {
+	var (
+		filters []string
+		args    []interface{}
+	)
+
+	if params.Provider != "" {
+		filters = append(filters, "s.providerSlug = ?")
+		args = append(args, params.Provider)
+	}
+
+	if len(params.Genres) > 0 {
+		genres := make([]string, 0, len(params.Genres))
+
+		for _, genre := range params.Genres {
+			genres = append(genres, "JSON_CONTAINS(LOWER(s.genres), JSON_QUOTE(LOWER(?)))")
+			args = append(args, genre)
+		}
+
+		separator := " OR "
+		if params.GenresMatch == internal.AllGenresMatch {
+			separator = " AND "
+		}
+
+		filters = append(filters, "("+strings.Join(genres, separator)+")")
+	}
+
+	if params.Status != "" {
+		filters = append(filters, "s.status = ?")
+		args = append(args, string(params.Status))
+	}
+
+	if params.MinChapters > 0 {
+		filters = append(filters, "s.chaptersCount >= ?")
+		args = append(args, params.MinChapters)
+	}
+
+	if !params.UpdatedSince.IsZero() {
+		filters = append(filters, "s.updatedAt >= ?")
+		args = append(args, params.UpdatedSince.UTC().Format(browseTimeLayout))
+	}
+
+	return filters, args
+}
+
+func newWhereClause(conditions []string) string {
+	if len(conditions) == 0 {
+		return ""
+	}
+
+	return " WHERE " + strings.Join(conditions, " AND ")
+}
+
+func newBrowseSeriesCursor(model SeriesModel, sort internal.SeriesSort) internal.Cursor {
+	cursor := internal.Cursor{
+		Slug:     model.Slug,
+		Provider: model.ProviderSlug,
+	}
+
+	switch sort {
+	case internal.TitleSeriesSort:
+		cursor.Value = model.Title
+	case internal.ChaptersSeriesSort:
+		cursor.Value = strconv.Itoa(model.ChaptersCount)
+	case internal.UpdatedSeriesSort:
+		cursor.Value = model.UpdatedAt.UTC().Format(browseTimeLayout)
+	case internal.LatestChapterSeriesSort:
+		cursor.Value = time.Unix(0, 0).UTC().Format(browseTimeLayout)
+		if at, ok := model.LatestChapterAt(); ok {
+			cursor.Value = at.UTC().Format(browseTimeLayout)
+		}
+	default:
+		cursor.Value = model.Slug
+	}
+
+	return cursor
 }
 
 func (s *SeriesRepo) UpdateInit(ctx context.Context, params internal.UpdateInitSeriesParams) (internal.Series, error) {
 	defer newSentrySpan(ctx, "SeriesRepo.UpdateInit").Finish()
 
-	series, err := s.q.Series.FindUnique(
+	updates := []SeriesSetParam{
+		Series.ThumbnailURL.Set(params.ThumbnailURL),
+		Series.Synopsis.Set(params.Synopsis),
+		Series.Genres.Set(params.Genres),
+	}
+
+	if params.Status != "" {
+		updates = append(updates, Series.Status.Set(SeriesStatus(params.Status)))
+	}
+
+	if params.Type != "" {
+		updates = append(updates, Series.Type.Set(params.Type))
+	}
+
+	if params.Author != "" {
+		updates = append(updates, Series.Author.Set(params.Author))
+	}
+
+	if params.Artist != "" {
+		updates = append(updates, Series.Artist.Set(params.Artist))
+	}
+
+	if params.ReleaseYear != 0 {
+		updates = append(updates, Series.ReleaseYear.Set(params.ReleaseYear))
+	}
+
+	update := s.q.Series.FindUnique(
 		Series.SeriesUnique(
 			Series.ProviderSlug.Equals(params.Provider),
 			Series.Slug.Equals(params.Slug),
@@ -409,25 +726,28 @@ func (s *SeriesRepo) UpdateInit(ctx context.Context, params internal.UpdateInitS
 	).With(
 		Series.Provider.Fetch(),
 	).Update(
-		Series.ThumbnailURL.Set(params.ThumbnailURL),
-		Series.Synopsis.Set(params.Synopsis),
-		Series.Genres.Set(params.Genres),
+		updates...,
+	).Tx()
+
+	err := s.q.Prisma.Transaction(
+		newSeriesChangeTx(s.q, params.Provider, params.Slug, internal.SeriesUpsertedEvent),
+		update,
 	).Exec(ctx)
 	if err != nil {
-		if IsErrNotFound(err) {
-			return internal.Series{}, internal.WrapErrorf(err, internal.ErrNotFound, "series not found")
+		if lerr := lookupSeries(ctx, s.q, params.Provider, params.Slug); IsErrNotFound(lerr) {
+			return internal.Series{}, internal.WrapErrorf(lerr, internal.ErrNotFound, "series not found")
 		}
 
 		return internal.Series{}, internal.WrapErrorf(err, internal.ErrUnknown, "failed to update series")
 	}
 
-	return series.toSeries(), nil
+	return update.Result().toSeries(), nil
 }
 
 func (s *SeriesRepo) UpdateLatest(ctx context.Context, params internal.UpdateLatestSeriesParams) (internal.Series, error) {
 	defer newSentrySpan(ctx, "SeriesRepo.UpdateLatest").Finish()
 
-	series, err := s.q.Series.FindUnique(
+	update := s.q.Series.FindUnique(
 		Series.SeriesUnique(
 			Series.ProviderSlug.Equals(params.Provider),
 			Series.Slug.Equals(params.Slug),
@@ -437,30 +757,112 @@ func (s *SeriesRepo) UpdateLatest(ctx context.Context, params internal.UpdateLat
 	).Update(
 		Series.ChaptersCount.Increment(params.AddChapters),
 		Series.LatestChapter.Set(params.LatestChapter),
+	).Tx()
+
+	err := s.q.Prisma.Transaction(
+		newSeriesChangeTx(s.q, params.Provider, params.Slug, internal.SeriesUpsertedEvent),
+		update,
 	).Exec(ctx)
 	if err != nil {
-		if IsErrNotFound(err) {
-			return internal.Series{}, internal.WrapErrorf(err, internal.ErrNotFound, "series not found")
+		if lerr := lookupSeries(ctx, s.q, params.Provider, params.Slug); IsErrNotFound(lerr) {
+			return internal.Series{}, internal.WrapErrorf(lerr, internal.ErrNotFound, "series not found")
 		}
 
 		return internal.Series{}, internal.WrapErrorf(err, internal.ErrUnknown, "failed to update series")
 	}
 
-	return series.toSeries(), nil
+	return update.Result().toSeries(), nil
 }
 
-func (s *SeriesRepo) Delete(ctx context.Context, params internal.FindSeriesParams) error {
-	defer newSentrySpan(ctx, "SeriesRepo.Delete").Finish()
+// syncedChaptersCount and syncedLatestChapter compute the chapter columns of a series from the chapter table,
+// each takes the provider and the series slug
+const (
+	syncedChaptersCount = "(SELECT COUNT(*) FROM `Chapter` AS c WHERE c.providerSlug = ? AND c.seriesSlug = ?)"
+	syncedLatestChapter = "COALESCE((" +
+		"SELECT l.slug FROM `Chapter` AS l WHERE l.providerSlug = ? AND l.seriesSlug = ? ORDER BY l.number DESC, l.slug DESC LIMIT 1" +
+		"), '')"
+)
 
-	_, err := s.q.Series.FindUnique(
+// SyncChapters recomputes chaptersCount and latestChapter from the chapter table in a single statement,
+// so concurrent chapter list scrapes of the same series can not overwrite each other with stale values.
+// Chapters sharing a number are ordered by slug so the latest chapter does not flip between syncs.
+// The series is only marked for the indexer when the sync changes it
+func (s *SeriesRepo) SyncChapters(ctx context.Context, params internal.FindSeriesParams) (internal.Series, error) {
+	defer newSentrySpan(ctx, "SeriesRepo.SyncChapters").Finish()
+
+	change := newSeriesChangesTx(s.q, internal.SeriesUpsertedEvent,
+		"providerSlug = ? AND slug = ? AND (chaptersCount <> "+syncedChaptersCount+" OR latestChapter <> "+syncedLatestChapter+")",
+		params.Provider, params.Slug,
+		params.Provider, params.Slug,
+		params.Provider, params.Slug,
+	)
+
+	sync := s.q.Prisma.ExecuteRaw(
+		"UPDATE `Series` AS s "+
+			"JOIN (SELECT "+syncedChaptersCount+" AS chaptersCount, "+syncedLatestChapter+" AS latestChapter) AS agg "+
+			"SET s.updatedAt = IF(s.chaptersCount <> agg.chaptersCount OR s.latestChapter <> agg.latestChapter, NOW(3), s.updatedAt), "+
+			"s.latestChapterAt = IF(s.latestChapter <> agg.latestChapter AND agg.latestChapter <> '', NOW(3), s.latestChapterAt), "+
+			"s.chaptersCount = agg.chaptersCount, "+
+			"s.latestChapter = agg.latestChapter "+
+			"WHERE s.providerSlug = ? AND s.slug = ?",
+		params.Provider, params.Slug,
+		params.Provider, params.Slug,
+		params.Provider, params.Slug,
+	).Tx()
+
+	// the change runs first, it compares the series with the chapters before the sync updates it
+	err := s.q.Prisma.Transaction(change, sync).Exec(ctx)
+	if err != nil {
+		return internal.Series{}, internal.WrapErrorf(err, internal.ErrUnknown, "failed to sync series chapters")
+	}
+
+	series, err := s.q.Series.FindUnique(
 		Series.SeriesUnique(
 			Series.ProviderSlug.Equals(params.Provider),
 			Series.Slug.Equals(params.Slug),
 		),
-	).Delete().Exec(ctx)
+	).With(
+		Series.Provider.Fetch(),
+	).Exec(ctx)
 	if err != nil {
 		if IsErrNotFound(err) {
-			return internal.WrapErrorf(err, internal.ErrNotFound, "series not found")
+			return internal.Series{}, internal.WrapErrorf(err, internal.ErrNotFound, "series not found")
+		}
+
+		return internal.Series{}, internal.WrapErrorf(err, internal.ErrUnknown, "failed to find series")
+	}
+
+	return series.toSeries(), nil
+}
+
+// lookupSeries finds the series after a failed write, the error of a transaction does not tell
+// a missing series or a duplicate apart from other failures
+func lookupSeries(ctx context.Context, q *PrismaClient, provider, slug string) error {
+	_, err := q.Series.FindUnique(
+		Series.SeriesUnique(
+			Series.ProviderSlug.Equals(provider),
+			Series.Slug.Equals(slug),
+		),
+	).Exec(ctx)
+
+	return err
+}
+
+func (s *SeriesRepo) Delete(ctx context.Context, params internal.FindSeriesParams) error {
+	defer newSentrySpan(ctx, "SeriesRepo.Delete").Finish()
+
+	err := s.q.Prisma.Transaction(
+		newSeriesChangeTx(s.q, params.Provider, params.Slug, internal.SeriesDeletedEvent),
+		s.q.Series.FindUnique(
+			Series.SeriesUnique(
+				Series.ProviderSlug.Equals(params.Provider),
+				Series.Slug.Equals(params.Slug),
+			),
+		).Delete().Tx(),
+	).Exec(ctx)
+	if err != nil {
+		if lerr := lookupSeries(ctx, s.q, params.Provider, params.Slug); IsErrNotFound(lerr) {
+			return internal.WrapErrorf(lerr, internal.ErrNotFound, "series not found")
 		}
 
 		return internal.WrapErrorf(err, internal.ErrUnknown, "failed to delete series")
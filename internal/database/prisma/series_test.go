@@ -9,16 +9,86 @@ import (
 	"time"
 
 	"fourleaves.studio/manga-scraper/internal"
+	"github.com/steebchen/prisma-client-go/engine"
+	prismamock "github.com/steebchen/prisma-client-go/engine/mock"
 	"github.com/steebchen/prisma-client-go/engine/protocol"
 	"github.com/stretchr/testify/require"
 )
 
-func createRandomInitSeries(t *testing.T, provider ProviderModel) (SeriesModel, internal.Series) {
-	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+// batchEngine runs the statements of a transaction one by one against the mock expectations,
+// the mock engine does not implement batches. The first failing statement fails the transaction
+type batchEngine struct {
+	engine.Engine
+}
+
+func (e batchEngine) Batch(ctx context.Context, payload interface{}, v interface{}) error {
+	response := v.(*protocol.GQLBatchResponse)
+
+	for _, request := range payload.(protocol.GQLBatchRequest).Batch {
+		var result json.RawMessage
+		if err := e.Do(ctx, request, &result); err != nil {
+			return err
+		}
+
+		response.Result = append(response.Result, protocol.GQLResponse{
+			Data: protocol.Data{Result: result},
+		})
+	}
+
+	return nil
+}
+
+// newMockTx returns a mock client that also runs transactions
+func newMockTx() (*PrismaClient, *Mock, func(t *testing.T)) {
 	client, mock, ensure := NewMock()
-	defer ensure(t)
+	client.Engine = batchEngine{client.Engine}
 
-	seriesRepo := NewSeriesRepo(client)
+	return client, mock, ensure
+}
+
+// expectSeriesChange expects the change every series write records before it runs
+func expectSeriesChange(mock *Mock, q *PrismaClient, provider, slug, event string) {
+	*mock.Expectations = append(*mock.Expectations, prismamock.Expectation{
+		Query: newSeriesChangeTx(q, provider, slug, event).ExtractQuery(),
+		Want:  1,
+	})
+}
+
+// expectSeriesChanges expects the changes a bulk series write records before it runs
+func expectSeriesChanges(mock *Mock, q *PrismaClient, event, where string, args ...interface{}) {
+	*mock.Expectations = append(*mock.Expectations, prismamock.Expectation{
+		Query: newSeriesChangesTx(q, event, where, args...).ExtractQuery(),
+		Want:  1,
+	})
+}
+
+// expectLookupSeries expects the lookup that classifies the error of a failed series write
+func expectLookupSeries(mock *Mock, q *PrismaClient, provider, slug string) *seriesMockExec {
+	return mock.Series.Expect(
+		q.Series.FindUnique(
+			Series.SeriesUnique(
+				Series.ProviderSlug.Equals(provider),
+				Series.Slug.Equals(slug),
+			),
+		),
+	)
+}
+
+func requireErrorCode(t *testing.T, err error, code internal.ErrorCode) {
+	t.Helper()
+
+	var ierr *internal.Error
+
+	require.Error(t, err)
+	require.ErrorAs(t, err, &ierr)
+	require.Equal(t, code, ierr.Code())
+}
+
+// createRandomInitSeries builds a series fixture
+func createRandomInitSeries(t *testing.T, provider ProviderModel) (SeriesModel, internal.Series) {
+	t.Helper()
+
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
 
 	expModel := SeriesModel{
 		InnerSeries: InnerSeries{
@@ -34,6 +104,32 @@ func createRandomInitSeries(t *testing.T, provider ProviderModel) (SeriesModel,
 		},
 	}
 
+	expResult := internal.Series{
+		Provider:      provider.Slug,
+		Slug:          expModel.Slug,
+		Title:         expModel.Title,
+		SourceURL:     provider.Scheme + provider.Host + expModel.SourcePath,
+		CoverURL:      expModel.ThumbnailURL,
+		Synopsis:      expModel.Synopsis,
+		Genres:        newStringSliceFromBytes(expModel.Genres),
+		ChaptersCount: 0,
+		LatestChapter: "",
+	}
+
+	return expModel, expResult
+}
+
+func TestSeriesRepo_CreateInit(t *testing.T) {
+	providerModel, _ := createRandomProvider(t)
+	expModel, expResult := createRandomInitSeries(t, providerModel)
+
+	client, mock, ensure := newMockTx()
+	defer ensure(t)
+
+	seriesRepo := NewSeriesRepo(client)
+
+	expectSeriesChange(mock, seriesRepo.q, providerModel.Slug, expModel.Slug, internal.SeriesUpsertedEvent)
+
 	mock.Series.Expect(
 		seriesRepo.q.Series.CreateOne(
 			Series.Slug.Set(expModel.Slug),
@@ -43,27 +139,15 @@ func createRandomInitSeries(t *testing.T, provider ProviderModel) (SeriesModel,
 			Series.Synopsis.Set(expModel.Synopsis),
 			Series.Genres.Set(expModel.Genres),
 			Series.Provider.Link(
-				Provider.Slug.Equals(provider.Slug),
+				Provider.Slug.Equals(providerModel.Slug),
 			),
 		).With(
 			Series.Provider.Fetch(),
 		),
 	).Returns(expModel)
 
-	expResult := internal.Series{
-		Provider:      provider.Slug,
-		Slug:          expModel.Slug,
-		Title:         expModel.Title,
-		SourceURL:     provider.Scheme + provider.Host + expModel.SourcePath,
-		CoverURL:      expModel.ThumbnailURL,
-		Synopsis:      expModel.Synopsis,
-		Genres:        newStringSliceFromBytes(expModel.Genres),
-		ChaptersCount: 0,
-		LatestChapter: "",
-	}
-
 	createdSeries, err := seriesRepo.CreateInit(context.Background(), internal.CreateInitSeriesParams{
-		Provider:   provider.Slug,
+		Provider:   providerModel.Slug,
 		Slug:       expModel.Slug,
 		Title:      expModel.Title,
 		SourcePath: expModel.SourcePath,
@@ -71,23 +155,19 @@ func createRandomInitSeries(t *testing.T, provider ProviderModel) (SeriesModel,
 
 	require.NoError(t, err)
 	require.Equal(t, expResult, createdSeries)
-
-	return expModel, expResult
-}
-
-func TestSeriesRepo_CreateInit(t *testing.T) {
-	providerModel, _ := createRandomProvider(t)
-	createRandomInitSeries(t, providerModel)
 }
 
 func TestSeriesRepo_CreateInit_UniqueConstraint(t *testing.T) {
 	providerModel, _ := createRandomProvider(t)
+	seriesModel, _ := createRandomInitSeries(t, providerModel)
 
-	client, mock, ensure := NewMock()
+	client, mock, ensure := newMockTx()
 	defer ensure(t)
 
 	seriesRepo := NewSeriesRepo(client)
 
+	expectSeriesChange(mock, seriesRepo.q, providerModel.Slug, "slug", internal.SeriesUpsertedEvent)
+
 	mock.Series.Expect(
 		seriesRepo.q.Series.CreateOne(
 			Series.Slug.Set("slug"),
@@ -111,6 +191,8 @@ func TestSeriesRepo_CreateInit_UniqueConstraint(t *testing.T) {
 		ErrorCode: "P2002",
 	})
 
+	expectLookupSeries(mock, seriesRepo.q, providerModel.Slug, "slug").Returns(seriesModel)
+
 	_, err := seriesRepo.CreateInit(context.Background(), internal.CreateInitSeriesParams{
 		Provider:   providerModel.Slug,
 		Slug:       "slug",
@@ -118,19 +200,19 @@ func TestSeriesRepo_CreateInit_UniqueConstraint(t *testing.T) {
 		SourcePath: "",
 	})
 
-	require.Error(t, err)
-	_, ok := IsErrUniqueConstraint(err)
-	require.True(t, ok)
+	requireErrorCode(t, err, internal.ErrUniqueConstraint)
 }
 
 func TestSeriesRepo_CreateInit_UnknownError(t *testing.T) {
 	providerModel, _ := createRandomProvider(t)
 
-	client, mock, ensure := NewMock()
+	client, mock, ensure := newMockTx()
 	defer ensure(t)
 
 	seriesRepo := NewSeriesRepo(client)
 
+	expectSeriesChange(mock, seriesRepo.q, providerModel.Slug, "slug", internal.SeriesUpsertedEvent)
+
 	mock.Series.Expect(
 		seriesRepo.q.Series.CreateOne(
 			Series.Slug.Set("slug"),
@@ -147,6 +229,8 @@ func TestSeriesRepo_CreateInit_UnknownError(t *testing.T) {
 		),
 	).Errors(fmt.Errorf("unexpected error"))
 
+	expectLookupSeries(mock, seriesRepo.q, providerModel.Slug, "slug").Errors(ErrNotFound)
+
 	_, err := seriesRepo.CreateInit(context.Background(), internal.CreateInitSeriesParams{
 		Provider:   providerModel.Slug,
 		Slug:       "slug",
@@ -154,9 +238,15 @@ func TestSeriesRepo_CreateInit_UnknownError(t *testing.T) {
 		SourcePath: "",
 	})
 
-	require.Error(t, err)
-	_, ok := IsErrUniqueConstraint(err)
-	require.False(t, ok)
+	requireErrorCode(t, err, internal.ErrUnknown)
+}
+
+// expectCount expects a raw COUNT(*) query, the generated model mocks only cover the query builder
+func expectCount(client *PrismaClient, m *Mock, total int, query string, args ...interface{}) {
+	*m.Expectations = append(*m.Expectations, prismamock.Expectation{
+		Query: client.Prisma.QueryRaw(query, args...).ExtractQuery(),
+		Want:  &[]countRow{{Total: BigInt(total)}},
+	})
 }
 
 func TestSeriesRepo_Find(t *testing.T) {
@@ -353,7 +443,6 @@ func TestSeriesRepo_FindAll_UnknownError(t *testing.T) {
 
 func TestSeriesRepo_FindPaginated(t *testing.T) {
 	providerModel, provider := createRandomProvider(t)
-	_, _ = createRandomInitSeries(t, providerModel)
 	expModel2, expResult2 := createRandomInitSeries(t, providerModel)
 
 	providerModelWithRel := createRandomProviderWithSeriesRel(providerModel, []SeriesModel{expModel2})
@@ -369,10 +458,12 @@ func TestSeriesRepo_FindPaginated(t *testing.T) {
 		).With(
 			Provider.Series.Fetch().OrderBy(
 				Series.Slug.Order(SortOrderAsc),
-			).Take(1).Skip(1),
+			).Take(2).Skip(1),
 		),
 	).Returns(providerModelWithRel)
 
+	expectCount(client, mock, 2, countSeriesQuery, providerModel.Slug)
+
 	result, err := seriesRepo.FindPaginated(context.Background(), internal.FindSeriesParams{
 		Provider: provider.Slug,
 		Order:    internal.ASC,
@@ -381,8 +472,53 @@ func TestSeriesRepo_FindPaginated(t *testing.T) {
 	})
 
 	require.NoError(t, err)
-	require.Len(t, result, 1)
-	require.Equal(t, expResult2, result[0])
+	require.Len(t, result.Series, 1)
+	require.Equal(t, expResult2, result.Series[0])
+	require.Equal(t, 2, result.Total)
+	require.Equal(t, internal.Cursor{Slug: expModel2.Slug, Before: true}.Encode(), result.PrevCursor)
+	require.Empty(t, result.NextCursor)
+}
+
+func TestSeriesRepo_FindPaginated_Cursor(t *testing.T) {
+	providerModel, provider := createRandomProvider(t)
+	expModel1, _ := createRandomInitSeries(t, providerModel)
+	expModel2, expResult2 := createRandomInitSeries(t, providerModel)
+	expModel3, _ := createRandomInitSeries(t, providerModel)
+
+	providerModelWithRel := createRandomProviderWithSeriesRel(providerModel, []SeriesModel{expModel2, expModel3})
+
+	client, mock, ensure := NewMock()
+	defer ensure(t)
+
+	seriesRepo := NewSeriesRepo(client)
+
+	mock.Provider.Expect(
+		seriesRepo.q.Provider.FindUnique(
+			Provider.Slug.Equals(providerModel.Slug),
+		).With(
+			Provider.Series.Fetch(
+				Series.Slug.Gt(expModel1.Slug),
+			).OrderBy(
+				Series.Slug.Order(SortOrderAsc),
+			).Take(2),
+		),
+	).Returns(providerModelWithRel)
+
+	expectCount(client, mock, 3, countSeriesQuery, providerModel.Slug)
+
+	result, err := seriesRepo.FindPaginated(context.Background(), internal.FindSeriesParams{
+		Provider: provider.Slug,
+		Order:    internal.ASC,
+		Size:     1,
+		Cursor:   internal.Cursor{Slug: expModel1.Slug}.Encode(),
+	})
+
+	require.NoError(t, err)
+	require.Len(t, result.Series, 1)
+	require.Equal(t, expResult2, result.Series[0])
+	require.Equal(t, 3, result.Total)
+	require.Equal(t, internal.Cursor{Slug: expModel2.Slug, Before: true}.Encode(), result.PrevCursor)
+	require.Equal(t, internal.Cursor{Slug: expModel2.Slug}.Encode(), result.NextCursor)
 }
 
 func TestSeriesRepo_FindPaginated_NotFound(t *testing.T) {
@@ -399,7 +535,7 @@ func TestSeriesRepo_FindPaginated_NotFound(t *testing.T) {
 		).With(
 			Provider.Series.Fetch().OrderBy(
 				Series.Slug.Order(SortOrderAsc),
-			).Take(1).Skip(1),
+			).Take(2).Skip(1),
 		),
 	).Errors(ErrNotFound)
 
@@ -428,7 +564,7 @@ func TestSeriesRepo_FindPaginated_UnknownError(t *testing.T) {
 		).With(
 			Provider.Series.Fetch().OrderBy(
 				Series.Slug.Order(SortOrderAsc),
-			).Take(1).Skip(1),
+			).Take(2).Skip(1),
 		),
 	).Errors(fmt.Errorf("unknown error"))
 
@@ -448,7 +584,7 @@ func TestSeriesRepo_UpdateInit(t *testing.T) {
 	seriesModel, _ := createRandomInitSeries(t, providerModel)
 	genresBytes := []byte("[\"genre1\",\"genre2\"]")
 	var genres []string
-	json.Unmarshal(genresBytes, &genres)
+	require.NoError(t, json.Unmarshal(genresBytes, &genres))
 
 	updatedModel := SeriesModel{
 		InnerSeries: InnerSeries{
@@ -464,11 +600,13 @@ func TestSeriesRepo_UpdateInit(t *testing.T) {
 		},
 	}
 
-	client, mock, ensure := NewMock()
+	client, mock, ensure := newMockTx()
 	defer ensure(t)
 
 	seriesRepo := NewSeriesRepo(client)
 
+	expectSeriesChange(mock, seriesRepo.q, providerModel.Slug, seriesModel.Slug, internal.SeriesUpsertedEvent)
+
 	mock.Series.Expect(
 		seriesRepo.q.Series.FindUnique(
 			Series.SeriesUnique(
@@ -512,11 +650,13 @@ func TestSeriesRepo_UpdateInit_NotFound(t *testing.T) {
 	providerModel, _ := createRandomProvider(t)
 	seriesModel, _ := createRandomInitSeries(t, providerModel)
 
-	client, mock, ensure := NewMock()
+	client, mock, ensure := newMockTx()
 	defer ensure(t)
 
 	seriesRepo := NewSeriesRepo(client)
 
+	expectSeriesChange(mock, seriesRepo.q, providerModel.Slug, seriesModel.Slug, internal.SeriesUpsertedEvent)
+
 	mock.Series.Expect(
 		seriesRepo.q.Series.FindUnique(
 			Series.SeriesUnique(
@@ -532,6 +672,8 @@ func TestSeriesRepo_UpdateInit_NotFound(t *testing.T) {
 		),
 	).Errors(ErrNotFound)
 
+	expectLookupSeries(mock, seriesRepo.q, providerModel.Slug, seriesModel.Slug).Errors(ErrNotFound)
+
 	_, err := seriesRepo.UpdateInit(context.Background(), internal.UpdateInitSeriesParams{
 		Provider:     providerModel.Slug,
 		Slug:         seriesModel.Slug,
@@ -540,7 +682,7 @@ func TestSeriesRepo_UpdateInit_NotFound(t *testing.T) {
 		Genres:       []byte("[\"genre1\",\"genre2\"]"),
 	})
 
-	require.Error(t, err)
+	requireErrorCode(t, err, internal.ErrNotFound)
 	require.True(t, IsErrNotFound(err))
 }
 
@@ -548,11 +690,13 @@ func TestSeriesRepo_UpdateInit_UnknownError(t *testing.T) {
 	providerModel, _ := createRandomProvider(t)
 	seriesModel, _ := createRandomInitSeries(t, providerModel)
 
-	client, mock, ensure := NewMock()
+	client, mock, ensure := newMockTx()
 	defer ensure(t)
 
 	seriesRepo := NewSeriesRepo(client)
 
+	expectSeriesChange(mock, seriesRepo.q, providerModel.Slug, seriesModel.Slug, internal.SeriesUpsertedEvent)
+
 	mock.Series.Expect(
 		seriesRepo.q.Series.FindUnique(
 			Series.SeriesUnique(
@@ -568,6 +712,8 @@ func TestSeriesRepo_UpdateInit_UnknownError(t *testing.T) {
 		),
 	).Errors(fmt.Errorf("unknown error"))
 
+	expectLookupSeries(mock, seriesRepo.q, providerModel.Slug, seriesModel.Slug).Returns(seriesModel)
+
 	_, err := seriesRepo.UpdateInit(context.Background(), internal.UpdateInitSeriesParams{
 		Provider:     providerModel.Slug,
 		Slug:         seriesModel.Slug,
@@ -576,8 +722,7 @@ func TestSeriesRepo_UpdateInit_UnknownError(t *testing.T) {
 		Genres:       []byte("[\"genre1\",\"genre2\"]"),
 	})
 
-	require.Error(t, err)
-	require.False(t, IsErrNotFound(err))
+	requireErrorCode(t, err, internal.ErrUnknown)
 }
 
 func TestSeriesRepo_UpdateLatest(t *testing.T) {
@@ -600,11 +745,13 @@ func TestSeriesRepo_UpdateLatest(t *testing.T) {
 		},
 	}
 
-	client, mock, ensure := NewMock()
+	client, mock, ensure := newMockTx()
 	defer ensure(t)
 
 	seriesRepo := NewSeriesRepo(client)
 
+	expectSeriesChange(mock, seriesRepo.q, providerModel.Slug, seriesModel.Slug, internal.SeriesUpsertedEvent)
+
 	mock.Series.Expect(
 		seriesRepo.q.Series.FindUnique(
 			Series.SeriesUnique(
@@ -646,11 +793,13 @@ func TestSeriesRepo_UpdateLatest_NotFound(t *testing.T) {
 	providerModel, _ := createRandomProvider(t)
 	seriesModel, _ := createRandomInitSeries(t, providerModel)
 
-	client, mock, ensure := NewMock()
+	client, mock, ensure := newMockTx()
 	defer ensure(t)
 
 	seriesRepo := NewSeriesRepo(client)
 
+	expectSeriesChange(mock, seriesRepo.q, providerModel.Slug, seriesModel.Slug, internal.SeriesUpsertedEvent)
+
 	mock.Series.Expect(
 		seriesRepo.q.Series.FindUnique(
 			Series.SeriesUnique(
@@ -665,6 +814,8 @@ func TestSeriesRepo_UpdateLatest_NotFound(t *testing.T) {
 		),
 	).Errors(ErrNotFound)
 
+	expectLookupSeries(mock, seriesRepo.q, providerModel.Slug, seriesModel.Slug).Errors(ErrNotFound)
+
 	_, err := seriesRepo.UpdateLatest(context.Background(), internal.UpdateLatestSeriesParams{
 		Provider:      providerModel.Slug,
 		Slug:          seriesModel.Slug,
@@ -672,7 +823,7 @@ func TestSeriesRepo_UpdateLatest_NotFound(t *testing.T) {
 		LatestChapter: "latest-chapter",
 	})
 
-	require.Error(t, err)
+	requireErrorCode(t, err, internal.ErrNotFound)
 	require.True(t, IsErrNotFound(err))
 }
 
@@ -680,11 +831,13 @@ func TestSeriesRepo_UpdateLatest_UnknownError(t *testing.T) {
 	providerModel, _ := createRandomProvider(t)
 	seriesModel, _ := createRandomInitSeries(t, providerModel)
 
-	client, mock, ensure := NewMock()
+	client, mock, ensure := newMockTx()
 	defer ensure(t)
 
 	seriesRepo := NewSeriesRepo(client)
 
+	expectSeriesChange(mock, seriesRepo.q, providerModel.Slug, seriesModel.Slug, internal.SeriesUpsertedEvent)
+
 	mock.Series.Expect(
 		seriesRepo.q.Series.FindUnique(
 			Series.SeriesUnique(
@@ -699,6 +852,8 @@ func TestSeriesRepo_UpdateLatest_UnknownError(t *testing.T) {
 		),
 	).Errors(fmt.Errorf("unknown error"))
 
+	expectLookupSeries(mock, seriesRepo.q, providerModel.Slug, seriesModel.Slug).Returns(seriesModel)
+
 	_, err := seriesRepo.UpdateLatest(context.Background(), internal.UpdateLatestSeriesParams{
 		Provider:      providerModel.Slug,
 		Slug:          seriesModel.Slug,
@@ -706,19 +861,20 @@ func TestSeriesRepo_UpdateLatest_UnknownError(t *testing.T) {
 		LatestChapter: "latest-chapter",
 	})
 
-	require.Error(t, err)
-	require.False(t, IsErrNotFound(err))
+	requireErrorCode(t, err, internal.ErrUnknown)
 }
 
 func TestSeriesRepo_Delete(t *testing.T) {
 	providerModel, _ := createRandomProvider(t)
 	seriesModel, _ := createRandomInitSeries(t, providerModel)
 
-	client, mock, ensure := NewMock()
+	client, mock, ensure := newMockTx()
 	defer ensure(t)
 
 	seriesRepo := NewSeriesRepo(client)
 
+	expectSeriesChange(mock, seriesRepo.q, providerModel.Slug, seriesModel.Slug, internal.SeriesDeletedEvent)
+
 	mock.Series.Expect(
 		seriesRepo.q.Series.FindUnique(
 			Series.SeriesUnique(
@@ -739,11 +895,13 @@ func TestSeriesRepo_Delete(t *testing.T) {
 func TestSeriesRepo_Delete_NotFound(t *testing.T) {
 	providerModel, _ := createRandomProvider(t)
 
-	client, mock, ensure := NewMock()
+	client, mock, ensure := newMockTx()
 	defer ensure(t)
 
 	seriesRepo := NewSeriesRepo(client)
 
+	expectSeriesChange(mock, seriesRepo.q, providerModel.Slug, "non-existent-slug", internal.SeriesDeletedEvent)
+
 	mock.Series.Expect(
 		seriesRepo.q.Series.FindUnique(
 			Series.SeriesUnique(
@@ -753,23 +911,27 @@ func TestSeriesRepo_Delete_NotFound(t *testing.T) {
 		).Delete(),
 	).Errors(ErrNotFound)
 
+	expectLookupSeries(mock, seriesRepo.q, providerModel.Slug, "non-existent-slug").Errors(ErrNotFound)
+
 	err := seriesRepo.Delete(context.Background(), internal.FindSeriesParams{
 		Provider: providerModel.Slug,
 		Slug:     "non-existent-slug",
 	})
 
-	require.Error(t, err)
+	requireErrorCode(t, err, internal.ErrNotFound)
 	require.True(t, IsErrNotFound(err))
 }
 
 func TestSeriesRepo_Delete_UnknownError(t *testing.T) {
 	providerModel, _ := createRandomProvider(t)
 
-	client, mock, ensure := NewMock()
+	client, mock, ensure := newMockTx()
 	defer ensure(t)
 
 	seriesRepo := NewSeriesRepo(client)
 
+	expectSeriesChange(mock, seriesRepo.q, providerModel.Slug, "unknown-error-slug", internal.SeriesDeletedEvent)
+
 	mock.Series.Expect(
 		seriesRepo.q.Series.FindUnique(
 			Series.SeriesUnique(
@@ -779,11 +941,12 @@ func TestSeriesRepo_Delete_UnknownError(t *testing.T) {
 		).Delete(),
 	).Errors(fmt.Errorf("unknown error"))
 
+	expectLookupSeries(mock, seriesRepo.q, providerModel.Slug, "unknown-error-slug").Returns(SeriesModel{})
+
 	err := seriesRepo.Delete(context.Background(), internal.FindSeriesParams{
 		Provider: providerModel.Slug,
 		Slug:     "unknown-error-slug",
 	})
 
-	require.Error(t, err)
-	require.False(t, IsErrNotFound(err))
+	requireErrorCode(t, err, internal.ErrUnknown)
 }
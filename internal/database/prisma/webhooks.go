@@ -0,0 +1,318 @@
+package prisma
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"fourleaves.studio/manga-scraper/internal"
+)
+
+type WebhookRepo struct {
+	q *PrismaClient
+}
+
+func NewWebhookRepo(prismaClient *PrismaClient) *WebhookRepo {
+	return &WebhookRepo{
+		q: prismaClient,
+	}
+}
+
+func (w *WebhookSubscriptionModel) toWebhookSubscription() internal.WebhookSubscription {
+	return internal.WebhookSubscription{
+		ID:        w.ID,
+		URL:       w.URL,
+		Provider:  w.Provider,
+		Series:    w.Series,
+		IsActive:  w.IsActive,
+		Secret:    w.Secret,
+		CreatedAt: w.CreatedAt,
+		UpdatedAt: w.UpdatedAt,
+	}
+}
+
+func (w *WebhookDeliveryModel) toWebhookDelivery() internal.WebhookDelivery {
+	return internal.WebhookDelivery{
+		ID:             w.ID,
+		SubscriptionID: w.SubscriptionID,
+		Event:          w.Event,
+		Payload:        json.RawMessage(w.Payload),
+		Status:         internal.WebhookDeliveryStatus(w.Status),
+		Attempts:       w.Attempts,
+		ResponseStatus: w.ResponseStatus,
+		Error:          w.Error,
+		NextAttemptAt:  w.NextAttemptAt,
+		CreatedAt:      w.CreatedAt,
+		UpdatedAt:      w.UpdatedAt,
+	}
+}
+
+func (r *WebhookRepo) CreateSubscription(ctx context.Context, params internal.WebhookSubscriptionParams) (internal.WebhookSubscription, error) {
+	defer newSentrySpan(ctx, "WebhookRepo.CreateSubscription").Finish()
+
+	optional := []WebhookSubscriptionSetParam{
+		WebhookSubscription.Provider.Set(params.Provider),
+		WebhookSubscription.Series.Set(params.Series),
+	}
+
+	if params.IsActive != nil {
+		optional = append(optional, WebhookSubscription.IsActive.Set(*params.IsActive))
+	}
+
+	subscription, err := r.q.WebhookSubscription.CreateOne(
+		WebhookSubscription.URL.Set(params.URL),
+		WebhookSubscription.Secret.Set(params.Secret),
+		optional...,
+	).Exec(ctx)
+	if err != nil {
+		return internal.WebhookSubscription{}, internal.WrapErrorf(err, internal.ErrUnknown, "failed to create webhook subscription")
+	}
+
+	return subscription.toWebhookSubscription(), nil
+}
+
+func (r *WebhookRepo) FindSubscription(ctx context.Context, id string) (internal.WebhookSubscription, error) {
+	defer newSentrySpan(ctx, "WebhookRepo.FindSubscription").Finish()
+
+	subscription, err := r.q.WebhookSubscription.FindUnique(
+		WebhookSubscription.ID.Equals(id),
+	).Exec(ctx)
+	if err != nil {
+		if IsErrNotFound(err) {
+			return internal.WebhookSubscription{}, internal.WrapErrorf(err, internal.ErrNotFound, "webhook subscription not found")
+		}
+
+		return internal.WebhookSubscription{}, internal.WrapErrorf(err, internal.ErrUnknown, "failed to find webhook subscription")
+	}
+
+	return subscription.toWebhookSubscription(), nil
+}
+
+func (r *WebhookRepo) FindSubscriptions(ctx context.Context) ([]internal.WebhookSubscription, error) {
+	defer newSentrySpan(ctx, "WebhookRepo.FindSubscriptions").Finish()
+
+	subscriptions, err := r.q.WebhookSubscription.FindMany().OrderBy(
+		WebhookSubscription.CreatedAt.Order(SortOrderAsc),
+	).Exec(ctx)
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrUnknown, "failed to find webhook subscriptions")
+	}
+
+	result := make([]internal.WebhookSubscription, 0, len(subscriptions))
+	for i := range subscriptions {
+		result = append(result, subscriptions[i].toWebhookSubscription())
+	}
+
+	return result, nil
+}
+
+// FindMatchingSubscriptions returns the active subscriptions that want events of the given series,
+// an empty provider or series on the subscription matches everything
+func (r *WebhookRepo) FindMatchingSubscriptions(ctx context.Context, provider, series string) ([]internal.WebhookSubscription, error) {
+	defer newSentrySpan(ctx, "WebhookRepo.FindMatchingSubscriptions").Finish()
+
+	subscriptions, err := r.q.WebhookSubscription.FindMany(
+		WebhookSubscription.IsActive.Equals(true),
+		WebhookSubscription.Provider.In([]string{"", provider}),
+		WebhookSubscription.Series.In([]string{"", series}),
+	).Exec(ctx)
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrUnknown, "failed to find webhook subscriptions")
+	}
+
+	var result []internal.WebhookSubscription
+	for i := range subscriptions {
+		// a series filter without its provider would match the same slug on every provider
+		if s := subscriptions[i].toWebhookSubscription(); s.Matches(provider, series) {
+			result = append(result, s)
+		}
+	}
+
+	return result, nil
+}
+
+func (r *WebhookRepo) UpdateSubscription(ctx context.Context, params internal.WebhookSubscriptionParams) (internal.WebhookSubscription, error) {
+	defer newSentrySpan(ctx, "WebhookRepo.UpdateSubscription").Finish()
+
+	fields := []WebhookSubscriptionSetParam{
+		WebhookSubscription.URL.Set(params.URL),
+		WebhookSubscription.Provider.Set(params.Provider),
+		WebhookSubscription.Series.Set(params.Series),
+	}
+
+	if params.IsActive != nil {
+		fields = append(fields, WebhookSubscription.IsActive.Set(*params.IsActive))
+	}
+
+	if params.Secret != "" {
+		fields = append(fields, WebhookSubscription.Secret.Set(params.Secret))
+	}
+
+	subscription, err := r.q.WebhookSubscription.FindUnique(
+		WebhookSubscription.ID.Equals(params.ID),
+	).Update(
+		fields...,
+	).Exec(ctx)
+	if err != nil {
+		if IsErrNotFound(err) {
+			return internal.WebhookSubscription{}, internal.WrapErrorf(err, internal.ErrNotFound, "webhook subscription not found")
+		}
+
+		return internal.WebhookSubscription{}, internal.WrapErrorf(err, internal.ErrUnknown, "failed to update webhook subscription")
+	}
+
+	return subscription.toWebhookSubscription(), nil
+}
+
+func (r *WebhookRepo) DeleteSubscription(ctx context.Context, id string) error {
+	defer newSentrySpan(ctx, "WebhookRepo.DeleteSubscription").Finish()
+
+	_, err := r.q.WebhookSubscription.FindUnique(
+		WebhookSubscription.ID.Equals(id),
+	).Delete().Exec(ctx)
+	if err != nil {
+		if IsErrNotFound(err) {
+			return internal.WrapErrorf(err, internal.ErrNotFound, "webhook subscription not found")
+		}
+
+		return internal.WrapErrorf(err, internal.ErrUnknown, "failed to delete webhook subscription")
+	}
+
+	return nil
+}
+
+func (r *WebhookRepo) CreateDelivery(ctx context.Context, params internal.CreateWebhookDeliveryParams) (internal.WebhookDelivery, error) {
+	defer newSentrySpan(ctx, "WebhookRepo.CreateDelivery").Finish()
+
+	delivery, err := r.q.WebhookDelivery.CreateOne(
+		WebhookDelivery.Event.Set(params.Event),
+		WebhookDelivery.Payload.Set(params.Payload),
+		WebhookDelivery.DedupeKey.Set(params.DedupeKey),
+		WebhookDelivery.Status.Set(string(internal.PendingDeliveryStatus)),
+		WebhookDelivery.Error.Set(""),
+		WebhookDelivery.Subscription.Link(
+			WebhookSubscription.ID.Equals(params.SubscriptionID),
+		),
+	).Exec(ctx)
+	if err != nil {
+		if _, ok := IsErrUniqueConstraint(err); ok {
+			return r.findDelivery(ctx, params)
+		}
+
+		return internal.WebhookDelivery{}, internal.WrapErrorf(err, internal.ErrUnknown, "failed to create webhook delivery")
+	}
+
+	return delivery.toWebhookDelivery(), nil
+}
+
+// findDelivery returns the delivery a redelivered event already created
+func (r *WebhookRepo) findDelivery(ctx context.Context, params internal.CreateWebhookDeliveryParams) (internal.WebhookDelivery, error) {
+	delivery, err := r.q.WebhookDelivery.FindUnique(
+		WebhookDelivery.DeliveryUnique(
+			WebhookDelivery.SubscriptionID.Equals(params.SubscriptionID),
+			WebhookDelivery.Event.Equals(params.Event),
+			WebhookDelivery.DedupeKey.Equals(params.DedupeKey),
+		),
+	).Exec(ctx)
+	if err != nil {
+		if IsErrNotFound(err) {
+			return internal.WebhookDelivery{}, internal.WrapErrorf(err, internal.ErrNotFound, "webhook delivery not found")
+		}
+
+		return internal.WebhookDelivery{}, internal.WrapErrorf(err, internal.ErrUnknown, "failed to find webhook delivery")
+	}
+
+	return delivery.toWebhookDelivery(), nil
+}
+
+// FindDueDeliveries returns the pending deliveries whose next attempt is due, oldest first
+func (r *WebhookRepo) FindDueDeliveries(ctx context.Context, limit int) ([]internal.WebhookDelivery, error) {
+	defer newSentrySpan(ctx, "WebhookRepo.FindDueDeliveries").Finish()
+
+	deliveries, err := r.q.WebhookDelivery.FindMany(
+		WebhookDelivery.Status.Equals(string(internal.PendingDeliveryStatus)),
+		WebhookDelivery.NextAttemptAt.Lte(time.Now()),
+	).OrderBy(
+		WebhookDelivery.NextAttemptAt.Order(SortOrderAsc),
+	).Take(limit).Exec(ctx)
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrUnknown, "failed to find webhook deliveries")
+	}
+
+	result := make([]internal.WebhookDelivery, 0, len(deliveries))
+	for i := range deliveries {
+		result = append(result, deliveries[i].toWebhookDelivery())
+	}
+
+	return result, nil
+}
+
+// ClaimDelivery pushes the next attempt of a due delivery to until, so other workers skip it while it is being sent
+// It reports false when another worker claimed the delivery first
+func (r *WebhookRepo) ClaimDelivery(ctx context.Context, delivery internal.WebhookDelivery, until time.Time) (bool, error) {
+	defer newSentrySpan(ctx, "WebhookRepo.ClaimDelivery").Finish()
+
+	result, err := r.q.WebhookDelivery.FindMany(
+		WebhookDelivery.ID.Equals(delivery.ID),
+		WebhookDelivery.Status.Equals(string(internal.PendingDeliveryStatus)),
+		WebhookDelivery.NextAttemptAt.Equals(delivery.NextAttemptAt),
+	).Update(
+		WebhookDelivery.NextAttemptAt.Set(until),
+	).Exec(ctx)
+	if err != nil {
+		return false, internal.WrapErrorf(err, internal.ErrUnknown, "failed to claim webhook delivery")
+	}
+
+	return result.Count == 1, nil
+}
+
+func (r *WebhookRepo) UpdateDelivery(ctx context.Context, params internal.UpdateWebhookDeliveryParams) (internal.WebhookDelivery, error) {
+	defer newSentrySpan(ctx, "WebhookRepo.UpdateDelivery").Finish()
+
+	delivery, err := r.q.WebhookDelivery.FindUnique(
+		WebhookDelivery.ID.Equals(params.ID),
+	).Update(
+		WebhookDelivery.Status.Set(string(params.Status)),
+		WebhookDelivery.Attempts.Set(params.Attempts),
+		WebhookDelivery.ResponseStatus.Set(params.ResponseStatus),
+		WebhookDelivery.Error.Set(params.Error),
+		WebhookDelivery.NextAttemptAt.Set(params.NextAttemptAt),
+	).Exec(ctx)
+	if err != nil {
+		if IsErrNotFound(err) {
+			return internal.WebhookDelivery{}, internal.WrapErrorf(err, internal.ErrNotFound, "webhook delivery not found")
+		}
+
+		return internal.WebhookDelivery{}, internal.WrapErrorf(err, internal.ErrUnknown, "failed to update webhook delivery")
+	}
+
+	return delivery.toWebhookDelivery(), nil
+}
+
+func (r *WebhookRepo) FindDeliveries(ctx context.Context, params internal.FindWebhookDeliveriesParams) ([]internal.WebhookDelivery, error) {
+	defer newSentrySpan(ctx, "WebhookRepo.FindDeliveries").Finish()
+
+	filters := []WebhookDeliveryWhereParam{
+		WebhookDelivery.SubscriptionID.Equals(params.SubscriptionID),
+	}
+
+	if params.Status != "" {
+		filters = append(filters, WebhookDelivery.Status.Equals(string(params.Status)))
+	}
+
+	deliveries, err := r.q.WebhookDelivery.FindMany(
+		filters...,
+	).Take(params.Size).Skip(params.Size * (params.Page - 1)).OrderBy(
+		WebhookDelivery.CreatedAt.Order(SortOrderDesc),
+	).Exec(ctx)
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrUnknown, "failed to find webhook deliveries")
+	}
+
+	result := make([]internal.WebhookDelivery, 0, len(deliveries))
+	for i := range deliveries {
+		result = append(result, deliveries[i].toWebhookDelivery())
+	}
+
+	return result, nil
+}
@@ -0,0 +1,562 @@
+package prisma
+
+import (
+	"context"
+	"encoding/json"
+
+	"fourleaves.studio/manga-scraper/internal"
+)
+
+type WorkRepo struct {
+	q *PrismaClient
+}
+
+func NewWorkRepo(prismaClient *PrismaClient) *WorkRepo {
+	return &WorkRepo{
+		q: prismaClient,
+	}
+}
+
+func (w *WorkModel) toWork() internal.Work {
+	work := internal.Work{
+		ID:        w.ID,
+		Title:     w.Title,
+		Aliases:   newStringSliceFromBytes(w.Aliases),
+		CreatedAt: w.CreatedAt,
+		UpdatedAt: w.UpdatedAt,
+	}
+
+	if w.RelationsWork.Series == nil {
+		return work
+	}
+
+	seriesList := w.Series()
+
+	work.Sources = make([]internal.SeriesSource, 0, len(seriesList))
+	for i := range seriesList {
+		work.Sources = append(work.Sources, seriesList[i].toSeriesSource())
+	}
+
+	return work
+}
+
+func (s *SeriesModel) toSeriesSource() internal.SeriesSource {
+	return internal.SeriesSource{
+		Provider:      s.ProviderSlug,
+		Slug:          s.Slug,
+		Title:         s.Title,
+		ChaptersCount: s.ChaptersCount,
+		LatestChapter: s.LatestChapter,
+	}
+}
+
+func (c *WorkCandidateModel) toWorkCandidate() internal.WorkCandidate {
+	candidate := internal.WorkCandidate{
+		ID:        c.ID,
+		WorkID:    c.WorkID,
+		Provider:  c.ProviderSlug,
+		Series:    c.SeriesSlug,
+		Reason:    c.Reason,
+		Status:    internal.WorkCandidateStatus(c.Status),
+		CreatedAt: c.CreatedAt,
+		UpdatedAt: c.UpdatedAt,
+	}
+
+	if c.RelationsWorkCandidate.Work != nil {
+		candidate.WorkTitle = c.Work().Title
+	}
+
+	if c.RelationsWorkCandidate.Series != nil {
+		candidate.SeriesTitle = c.Series().Title
+	}
+
+	return candidate
+}
+
+func (r *WorkRepo) Create(ctx context.Context, params internal.CreateWorkParams) (internal.Work, error) {
+	defer newSentrySpan(ctx, "WorkRepo.Create").Finish()
+
+	work, err := r.q.Work.CreateOne(
+		Work.Title.Set(params.Title),
+		Work.Aliases.Set([]byte("[]")),
+	).Exec(ctx)
+	if err != nil {
+		return internal.Work{}, internal.WrapErrorf(err, internal.ErrUnknown, "failed to create work")
+	}
+
+	if err := r.link(ctx, work.ID, params.Provider, params.Series); err != nil {
+		return internal.Work{}, err
+	}
+
+	return r.Find(ctx, work.ID)
+}
+
+func (r *WorkRepo) Find(ctx context.Context, id string) (internal.Work, error) {
+	defer newSentrySpan(ctx, "WorkRepo.Find").Finish()
+
+	work, err := r.q.Work.FindUnique(
+		Work.ID.Equals(id),
+	).With(
+		Work.Series.Fetch().OrderBy(
+			Series.ProviderSlug.Order(SortOrderAsc),
+		),
+	).Exec(ctx)
+	if err != nil {
+		if IsErrNotFound(err) {
+			return internal.Work{}, internal.WrapErrorf(err, internal.ErrNotFound, "work not found")
+		}
+
+		return internal.Work{}, internal.WrapErrorf(err, internal.ErrUnknown, "failed to find work")
+	}
+
+	return work.toWork(), nil
+}
+
+// FindBySeries returns the work the series is linked to
+func (r *WorkRepo) FindBySeries(ctx context.Context, params internal.FindSeriesParams) (internal.Work, error) {
+	defer newSentrySpan(ctx, "WorkRepo.FindBySeries").Finish()
+
+	series, err := r.q.Series.FindUnique(
+		Series.SeriesUnique(
+			Series.ProviderSlug.Equals(params.Provider),
+			Series.Slug.Equals(params.Slug),
+		),
+	).Select(
+		Series.WorkID.Field(),
+	).Exec(ctx)
+	if err != nil {
+		if IsErrNotFound(err) {
+			return internal.Work{}, internal.WrapErrorf(err, internal.ErrNotFound, "series not found")
+		}
+
+		return internal.Work{}, internal.WrapErrorf(err, internal.ErrUnknown, "failed to find series")
+	}
+
+	workID, ok := series.WorkID()
+	if !ok {
+		return internal.Work{}, internal.NewErrorf(internal.ErrNotFound, "series is not linked to a work")
+	}
+
+	return r.Find(ctx, workID)
+}
+
+// FindAll returns every work with its sources, the linking job matches titles against them in memory
+func (r *WorkRepo) FindAll(ctx context.Context) ([]internal.Work, error) {
+	defer newSentrySpan(ctx, "WorkRepo.FindAll").Finish()
+
+	works, err := r.q.Work.FindMany().With(
+		Work.Series.Fetch(),
+	).OrderBy(
+		Work.CreatedAt.Order(SortOrderAsc),
+	).Exec(ctx)
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrUnknown, "failed to find works")
+	}
+
+	result := make([]internal.Work, 0, len(works))
+	for i := range works {
+		result = append(result, works[i].toWork())
+	}
+
+	return result, nil
+}
+
+type chapterSourceRow struct {
+	Provider string `json:"providerSlug"`
+	Series   string `json:"seriesSlug"`
+	Slug     string `json:"slug"`
+}
+
+// FindChapterSources returns the chapters with the same number in the other series of the chapter's work
+func (r *WorkRepo) FindChapterSources(ctx context.Context, chapter internal.Chapter) ([]internal.ChapterSource, error) {
+	defer newSentrySpan(ctx, "WorkRepo.FindChapterSources").Finish()
+
+	var rows []chapterSourceRow
+
+	err := r.q.Prisma.QueryRaw(
+		"SELECT c.providerSlug, c.seriesSlug, c.slug FROM `Series` AS s "+
+			"JOIN `Series` AS o ON o.workId = s.workId AND o.id <> s.id "+
+			"JOIN `Chapter` AS c ON c.providerSlug = o.providerSlug AND c.seriesSlug = o.slug "+
+			"WHERE s.providerSlug = ? AND s.slug = ? AND c.number = ? "+
+			"ORDER BY c.providerSlug",
+		chapter.Provider, chapter.Series, chapter.Number,
+	).Exec(ctx, &rows)
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrUnknown, "failed to find chapter sources")
+	}
+
+	result := make([]internal.ChapterSource, 0, len(rows))
+	for i := range rows {
+		result = append(result, internal.ChapterSource{
+			Provider: rows[i].Provider,
+			Series:   rows[i].Series,
+			Slug:     rows[i].Slug,
+		})
+	}
+
+	return result, nil
+}
+
+func (r *WorkRepo) Update(ctx context.Context, params internal.UpdateWorkParams) (internal.Work, error) {
+	defer newSentrySpan(ctx, "WorkRepo.Update").Finish()
+
+	aliases, err := json.Marshal(params.Aliases)
+	if err != nil {
+		return internal.Work{}, internal.WrapErrorf(err, internal.ErrUnknown, "json.Marshal")
+	}
+
+	_, err = r.q.Work.FindUnique(
+		Work.ID.Equals(params.ID),
+	).Update(
+		Work.Title.Set(params.Title),
+		Work.Aliases.Set(aliases),
+	).Exec(ctx)
+	if err != nil {
+		if IsErrNotFound(err) {
+			return internal.Work{}, internal.WrapErrorf(err, internal.ErrNotFound, "work not found")
+		}
+
+		return internal.Work{}, internal.WrapErrorf(err, internal.ErrUnknown, "failed to update work")
+	}
+
+	return r.Find(ctx, params.ID)
+}
+
+// Link moves the series to the work, drops its pending candidates and deletes the work it leaves when that is now empty
+func (r *WorkRepo) Link(ctx context.Context, params internal.WorkSeriesParams) (internal.Work, error) {
+	defer newSentrySpan(ctx, "WorkRepo.Link").Finish()
+
+	series, err := r.q.Series.FindUnique(
+		Series.SeriesUnique(
+			Series.ProviderSlug.Equals(params.Provider),
+			Series.Slug.Equals(params.Series),
+		),
+	).Exec(ctx)
+	if err != nil {
+		if IsErrNotFound(err) {
+			return internal.Work{}, internal.WrapErrorf(err, internal.ErrNotFound, "series not found")
+		}
+
+		return internal.Work{}, internal.WrapErrorf(err, internal.ErrUnknown, "failed to find series")
+	}
+
+	if _, err := r.Find(ctx, params.WorkID); err != nil {
+		return internal.Work{}, err
+	}
+
+	if err := r.link(ctx, params.WorkID, params.Provider, params.Series); err != nil {
+		return internal.Work{}, err
+	}
+
+	_, err = r.q.WorkCandidate.FindMany(
+		WorkCandidate.ProviderSlug.Equals(params.Provider),
+		WorkCandidate.SeriesSlug.Equals(params.Series),
+		WorkCandidate.Or(
+			WorkCandidate.WorkID.Equals(params.WorkID),
+			WorkCandidate.Status.Equals(string(internal.PendingCandidateStatus)),
+		),
+	).Delete().Exec(ctx)
+	if err != nil {
+		return internal.Work{}, internal.WrapErrorf(err, internal.ErrUnknown, "failed to delete work candidates")
+	}
+
+	if previous, ok := series.WorkID(); ok && previous != params.WorkID {
+		if err := r.deleteIfEmpty(ctx, previous); err != nil {
+			return internal.Work{}, err
+		}
+	}
+
+	return r.Find(ctx, params.WorkID)
+}
+
+// Split moves the series out of the work into a new work of its own,
+// and records the pair as rejected so the linking job does not suggest it again
+func (r *WorkRepo) Split(ctx context.Context, params internal.WorkSeriesParams) (internal.Work, error) {
+	defer newSentrySpan(ctx, "WorkRepo.Split").Finish()
+
+	work, err := r.Find(ctx, params.WorkID)
+	if err != nil {
+		return internal.Work{}, err
+	}
+
+	var source *internal.SeriesSource
+
+	for i := range work.Sources {
+		if work.Sources[i].Provider == params.Provider && work.Sources[i].Slug == params.Series {
+			source = &work.Sources[i]
+		}
+	}
+
+	if source == nil {
+		return internal.Work{}, internal.NewErrorf(internal.ErrNotFound, "series is not linked to the work")
+	}
+
+	if len(work.Sources) == 1 {
+		return internal.Work{}, internal.NewErrorf(internal.ErrInvalidInput, "series is the only source of the work")
+	}
+
+	split, err := r.Create(ctx, internal.CreateWorkParams{
+		Title:    source.Title,
+		Provider: params.Provider,
+		Series:   params.Series,
+	})
+	if err != nil {
+		return internal.Work{}, err
+	}
+
+	_, err = r.q.WorkCandidate.UpsertOne(
+		WorkCandidate.CandidateUnique(
+			WorkCandidate.WorkID.Equals(params.WorkID),
+			WorkCandidate.ProviderSlug.Equals(params.Provider),
+			WorkCandidate.SeriesSlug.Equals(params.Series),
+		),
+	).Create(
+		WorkCandidate.Reason.Set("split"),
+		WorkCandidate.Status.Set(string(internal.RejectedCandidateStatus)),
+		WorkCandidate.Work.Link(Work.ID.Equals(params.WorkID)),
+		WorkCandidate.Series.Link(Series.SeriesUnique(
+			Series.ProviderSlug.Equals(params.Provider),
+			Series.Slug.Equals(params.Series),
+		)),
+	).Update(
+		WorkCandidate.Status.Set(string(internal.RejectedCandidateStatus)),
+	).Exec(ctx)
+	if err != nil {
+		return internal.Work{}, internal.WrapErrorf(err, internal.ErrUnknown, "failed to reject work candidate")
+	}
+
+	return split, nil
+}
+
+// Merge moves every series of the source work to the target work, keeps the source title as an alias
+// and deletes the source work
+func (r *WorkRepo) Merge(ctx context.Context, params internal.MergeWorksParams) (internal.Work, error) {
+	defer newSentrySpan(ctx, "WorkRepo.Merge").Finish()
+
+	target, err := r.Find(ctx, params.TargetID)
+	if err != nil {
+		return internal.Work{}, err
+	}
+
+	source, err := r.Find(ctx, params.SourceID)
+	if err != nil {
+		return internal.Work{}, err
+	}
+
+	err = r.q.Prisma.Transaction(
+		newSeriesChangesTx(r.q, internal.SeriesUpsertedEvent, "workId = ?", params.SourceID),
+		r.q.Prisma.ExecuteRaw(
+			"UPDATE `Series` SET workId = ? WHERE workId = ?",
+			params.TargetID, params.SourceID,
+		).Tx(),
+	).Exec(ctx)
+	if err != nil {
+		return internal.Work{}, internal.WrapErrorf(err, internal.ErrUnknown, "failed to move work series")
+	}
+
+	for i := range source.Sources {
+		_, err = r.q.WorkCandidate.FindMany(
+			WorkCandidate.WorkID.Equals(params.TargetID),
+			WorkCandidate.ProviderSlug.Equals(source.Sources[i].Provider),
+			WorkCandidate.SeriesSlug.Equals(source.Sources[i].Slug),
+		).Delete().Exec(ctx)
+		if err != nil {
+			return internal.Work{}, internal.WrapErrorf(err, internal.ErrUnknown, "failed to delete work candidates")
+		}
+	}
+
+	if _, err := r.Update(ctx, internal.UpdateWorkParams{
+		ID:      target.ID,
+		Title:   target.Title,
+		Aliases: mergeAliases(target, source),
+	}); err != nil {
+		return internal.Work{}, err
+	}
+
+	_, err = r.q.Work.FindUnique(
+		Work.ID.Equals(params.SourceID),
+	).Delete().Exec(ctx)
+	if err != nil {
+		return internal.Work{}, internal.WrapErrorf(err, internal.ErrUnknown, "failed to delete work")
+	}
+
+	return r.Find(ctx, params.TargetID)
+}
+
+// DeleteEmpty deletes the works that have no series left, e.g. after their series were deleted
+func (r *WorkRepo) DeleteEmpty(ctx context.Context) (int, error) {
+	defer newSentrySpan(ctx, "WorkRepo.DeleteEmpty").Finish()
+
+	result, err := r.q.Prisma.ExecuteRaw(
+		"DELETE w FROM `Work` AS w LEFT JOIN `Series` AS s ON s.workId = w.id WHERE s.id IS NULL",
+	).Exec(ctx)
+	if err != nil {
+		return 0, internal.WrapErrorf(err, internal.ErrUnknown, "failed to delete empty works")
+	}
+
+	return result.Count, nil
+}
+
+func (r *WorkRepo) CreateCandidate(ctx context.Context, params internal.CreateWorkCandidateParams) (internal.WorkCandidate, error) {
+	defer newSentrySpan(ctx, "WorkRepo.CreateCandidate").Finish()
+
+	candidate, err := r.q.WorkCandidate.CreateOne(
+		WorkCandidate.Reason.Set(params.Reason),
+		WorkCandidate.Status.Set(string(params.Status)),
+		WorkCandidate.Work.Link(Work.ID.Equals(params.WorkID)),
+		WorkCandidate.Series.Link(Series.SeriesUnique(
+			Series.ProviderSlug.Equals(params.Provider),
+			Series.Slug.Equals(params.Series),
+		)),
+	).Exec(ctx)
+	if err != nil {
+		if _, ok := IsErrUniqueConstraint(err); ok {
+			return internal.WorkCandidate{}, internal.WrapErrorf(err, internal.ErrUniqueConstraint, "work candidate already exists")
+		}
+
+		return internal.WorkCandidate{}, internal.WrapErrorf(err, internal.ErrUnknown, "failed to create work candidate")
+	}
+
+	return candidate.toWorkCandidate(), nil
+}
+
+func (r *WorkRepo) FindCandidate(ctx context.Context, id string) (internal.WorkCandidate, error) {
+	defer newSentrySpan(ctx, "WorkRepo.FindCandidate").Finish()
+
+	candidate, err := r.q.WorkCandidate.FindUnique(
+		WorkCandidate.ID.Equals(id),
+	).With(
+		WorkCandidate.Work.Fetch(),
+		WorkCandidate.Series.Fetch(),
+	).Exec(ctx)
+	if err != nil {
+		if IsErrNotFound(err) {
+			return internal.WorkCandidate{}, internal.WrapErrorf(err, internal.ErrNotFound, "work candidate not found")
+		}
+
+		return internal.WorkCandidate{}, internal.WrapErrorf(err, internal.ErrUnknown, "failed to find work candidate")
+	}
+
+	return candidate.toWorkCandidate(), nil
+}
+
+func (r *WorkRepo) FindCandidates(ctx context.Context, params internal.FindWorkCandidatesParams) ([]internal.WorkCandidate, error) {
+	defer newSentrySpan(ctx, "WorkRepo.FindCandidates").Finish()
+
+	var filters []WorkCandidateWhereParam
+
+	if params.Status != "" {
+		filters = append(filters, WorkCandidate.Status.Equals(string(params.Status)))
+	}
+
+	candidates, err := r.q.WorkCandidate.FindMany(
+		filters...,
+	).With(
+		WorkCandidate.Work.Fetch(),
+		WorkCandidate.Series.Fetch(),
+	).Take(params.Size).Skip(params.Size * (params.Page - 1)).OrderBy(
+		WorkCandidate.CreatedAt.Order(SortOrderDesc),
+	).Exec(ctx)
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrUnknown, "failed to find work candidates")
+	}
+
+	result := make([]internal.WorkCandidate, 0, len(candidates))
+	for i := range candidates {
+		result = append(result, candidates[i].toWorkCandidate())
+	}
+
+	return result, nil
+}
+
+// FindAllCandidates returns every candidate regardless of status, the linking job uses it to skip known pairs
+func (r *WorkRepo) FindAllCandidates(ctx context.Context) ([]internal.WorkCandidate, error) {
+	defer newSentrySpan(ctx, "WorkRepo.FindAllCandidates").Finish()
+
+	candidates, err := r.q.WorkCandidate.FindMany().Exec(ctx)
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrUnknown, "failed to find work candidates")
+	}
+
+	result := make([]internal.WorkCandidate, 0, len(candidates))
+	for i := range candidates {
+		result = append(result, candidates[i].toWorkCandidate())
+	}
+
+	return result, nil
+}
+
+func (r *WorkRepo) RejectCandidate(ctx context.Context, id string) (internal.WorkCandidate, error) {
+	defer newSentrySpan(ctx, "WorkRepo.RejectCandidate").Finish()
+
+	_, err := r.q.WorkCandidate.FindUnique(
+		WorkCandidate.ID.Equals(id),
+	).Update(
+		WorkCandidate.Status.Set(string(internal.RejectedCandidateStatus)),
+	).Exec(ctx)
+	if err != nil {
+		if IsErrNotFound(err) {
+			return internal.WorkCandidate{}, internal.WrapErrorf(err, internal.ErrNotFound, "work candidate not found")
+		}
+
+		return internal.WorkCandidate{}, internal.WrapErrorf(err, internal.ErrUnknown, "failed to reject work candidate")
+	}
+
+	return r.FindCandidate(ctx, id)
+}
+
+func (r *WorkRepo) link(ctx context.Context, workID, provider, slug string) error {
+	err := r.q.Prisma.Transaction(
+		newSeriesChangeTx(r.q, provider, slug, internal.SeriesUpsertedEvent),
+		r.q.Series.FindUnique(
+			Series.SeriesUnique(
+				Series.ProviderSlug.Equals(provider),
+				Series.Slug.Equals(slug),
+			),
+		).Update(
+			Series.Work.Link(Work.ID.Equals(workID)),
+		).Tx(),
+	).Exec(ctx)
+	if err != nil {
+		if lerr := lookupSeries(ctx, r.q, provider, slug); IsErrNotFound(lerr) {
+			return internal.WrapErrorf(lerr, internal.ErrNotFound, "series not found")
+		}
+
+		return internal.WrapErrorf(err, internal.ErrUnknown, "failed to link series to work")
+	}
+
+	return nil
+}
+
+func (r *WorkRepo) deleteIfEmpty(ctx context.Context, id string) error {
+	_, err := r.q.Prisma.ExecuteRaw(
+		"DELETE w FROM `Work` AS w LEFT JOIN `Series` AS s ON s.workId = w.id WHERE w.id = ? AND s.id IS NULL",
+		id,
+	).Exec(ctx)
+	if err != nil {
+		return internal.WrapErrorf(err, internal.ErrUnknown, "failed to delete empty work")
+	}
+
+	return nil
+}
+
+// mergeAliases keeps the aliases of both works and the source title, without duplicates of the target title
+func mergeAliases(target, source internal.Work) []string {
+	seen := map[string]bool{
+		internal.NormalizeTitle(target.Title): true,
+	}
+
+	aliases := make([]string, 0, len(target.Aliases)+len(source.Aliases)+1)
+
+	for _, alias := range append(append(append([]string{}, target.Aliases...), source.Title), source.Aliases...) {
+		key := internal.NormalizeTitle(alias)
+		if key == "" || seen[key] {
+			continue
+		}
+
+		seen[key] = true
+		aliases = append(aliases, alias)
+	}
+
+	return aliases
+}
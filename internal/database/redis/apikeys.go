@@ -0,0 +1,207 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"fourleaves.studio/manga-scraper/internal"
+	"github.com/labstack/echo/v4"
+	"github.com/redis/go-redis/v9"
+)
+
+type APIKeyStore interface {
+	Create(ctx context.Context, params internal.APIKeyParams) (internal.APIKey, error)
+	Find(ctx context.Context, id string) (internal.APIKey, error)
+	FindByHash(ctx context.Context, hash string) (internal.APIKey, error)
+	FindAll(ctx context.Context) ([]internal.APIKey, error)
+	Update(ctx context.Context, params internal.APIKeyParams) (internal.APIKey, error)
+	Rotate(ctx context.Context, params internal.APIKeyParams) (internal.APIKey, error)
+	Revoke(ctx context.Context, id string) (internal.APIKey, error)
+}
+
+// APIKeyCache caches the keys by hash for the requests authenticating with them,
+// a key is dropped from the cache before and after it is updated, rotated or revoked
+type APIKeyCache struct {
+	*cacheClient
+	store APIKeyStore
+}
+
+func NewAPIKeyCache(redisURL string, store APIKeyStore, expiration time.Duration, logger echo.Logger) *APIKeyCache {
+	return &APIKeyCache{
+		cacheClient: newCacheClient(redisURL, expiration, logger),
+		store:       store,
+	}
+}
+
+func (c *APIKeyCache) Create(ctx context.Context, params internal.APIKeyParams) (internal.APIKey, error) {
+	defer newSentrySpan(ctx, "APIKeyCache.Create").Finish()
+
+	return c.store.Create(ctx, params)
+}
+
+func (c *APIKeyCache) Find(ctx context.Context, id string) (internal.APIKey, error) {
+	defer newSentrySpan(ctx, "APIKeyCache.Find").Finish()
+
+	return c.store.Find(ctx, id)
+}
+
+// FindByHash is cached, unknown keys are not and always reach the store
+func (c *APIKeyCache) FindByHash(ctx context.Context, hash string) (internal.APIKey, error) {
+	defer newSentrySpan(ctx, "APIKeyCache.FindByHash").Finish()
+
+	key, err := fetch(ctx, c.cacheClient, "APIKeyCache.FindByHash", apiKeyHashKey(hash), func(ctx context.Context) (internal.APIKey, error) {
+		return c.store.FindByHash(ctx, hash)
+	})
+	if err != nil {
+		return internal.APIKey{}, internal.WrapErrorf(err, internal.ErrUnknown, "store.FindByHash")
+	}
+
+	return key, nil
+}
+
+func (c *APIKeyCache) FindAll(ctx context.Context) ([]internal.APIKey, error) {
+	defer newSentrySpan(ctx, "APIKeyCache.FindAll").Finish()
+
+	return c.store.FindAll(ctx)
+}
+
+func (c *APIKeyCache) Update(ctx context.Context, params internal.APIKeyParams) (internal.APIKey, error) {
+	defer newSentrySpan(ctx, "APIKeyCache.Update").Finish()
+
+	return c.change(ctx, params.ID, "store.Update", func(ctx context.Context) (internal.APIKey, error) {
+		return c.store.Update(ctx, params)
+	})
+}
+
+// Rotate drops the previous key from the cache, it stops working right away
+func (c *APIKeyCache) Rotate(ctx context.Context, params internal.APIKeyParams) (internal.APIKey, error) {
+	defer newSentrySpan(ctx, "APIKeyCache.Rotate").Finish()
+
+	return c.change(ctx, params.ID, "store.Rotate", func(ctx context.Context) (internal.APIKey, error) {
+		return c.store.Rotate(ctx, params)
+	})
+}
+
+func (c *APIKeyCache) Revoke(ctx context.Context, id string) (internal.APIKey, error) {
+	defer newSentrySpan(ctx, "APIKeyCache.Revoke").Finish()
+
+	return c.change(ctx, id, "store.Revoke", func(ctx context.Context) (internal.APIKey, error) {
+		return c.store.Revoke(ctx, id)
+	})
+}
+
+// change writes the key between two deletes of its cached hash. The first one stops serving the cached key
+// before the write, the second one drops what a request authenticating during the write loaded from the store
+func (c *APIKeyCache) change(ctx context.Context, id, op string, write func(ctx context.Context) (internal.APIKey, error)) (internal.APIKey, error) {
+	previous, err := c.store.Find(ctx, id)
+	if err != nil {
+		return internal.APIKey{}, internal.WrapErrorf(err, internal.ErrUnknown, "store.Find")
+	}
+
+	cacheKey := apiKeyHashKey(previous.Hash)
+
+	if err := c.delete(ctx, cacheKey); err != nil {
+		return internal.APIKey{}, internal.WrapErrorf(err, internal.ErrUnknown, "delete")
+	}
+
+	key, err := write(ctx)
+	if err != nil {
+		return internal.APIKey{}, internal.WrapErrorf(err, internal.ErrUnknown, op)
+	}
+
+	if err := c.delete(ctx, cacheKey); err != nil {
+		return internal.APIKey{}, internal.WrapErrorf(err, internal.ErrUnknown, "delete")
+	}
+
+	return key, nil
+}
+
+// APIKeyUsageCounter counts the requests of every API key in fixed windows of a minute and a UTC day,
+// a window key expires once the window is over
+type APIKeyUsageCounter struct {
+	client *redis.Client
+}
+
+func NewAPIKeyUsageCounter(redisURL string) *APIKeyUsageCounter {
+	opts, _ := redis.ParseURL(redisURL)
+	return &APIKeyUsageCounter{
+		client: redis.NewClient(opts),
+	}
+}
+
+// Record counts a request of the key and returns the usage including it
+func (u *APIKeyUsageCounter) Record(ctx context.Context, id string) (internal.APIKeyUsage, error) {
+	defer newSentrySpan(ctx, "APIKeyUsageCounter.Record").Finish()
+
+	usage := newAPIKeyUsage(time.Now())
+	minuteKey, dayKey := usageKeys(id, usage)
+
+	var minute, day *redis.IntCmd
+
+	_, err := u.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		minute = pipe.Incr(ctx, minuteKey)
+		pipe.ExpireAt(ctx, minuteKey, usage.MinuteResetAt)
+		day = pipe.Incr(ctx, dayKey)
+		pipe.ExpireAt(ctx, dayKey, usage.DayResetAt)
+
+		return nil
+	})
+	if err != nil {
+		return internal.APIKeyUsage{}, internal.WrapErrorf(err, internal.ErrUnknown, "client.TxPipelined")
+	}
+
+	usage.Minute = int(minute.Val())
+	usage.Day = int(day.Val())
+
+	return usage, nil
+}
+
+// Find returns the usage of the key in the current windows without counting a request
+func (u *APIKeyUsageCounter) Find(ctx context.Context, id string) (internal.APIKeyUsage, error) {
+	defer newSentrySpan(ctx, "APIKeyUsageCounter.Find").Finish()
+
+	usage := newAPIKeyUsage(time.Now())
+	minuteKey, dayKey := usageKeys(id, usage)
+
+	values, err := u.client.MGet(ctx, minuteKey, dayKey).Result()
+	if err != nil {
+		return internal.APIKeyUsage{}, internal.WrapErrorf(err, internal.ErrUnknown, "client.MGet")
+	}
+
+	usage.Minute = counterValue(values[0])
+	usage.Day = counterValue(values[1])
+
+	return usage, nil
+}
+
+func newAPIKeyUsage(now time.Time) internal.APIKeyUsage {
+	now = now.UTC()
+
+	return internal.APIKeyUsage{
+		MinuteResetAt: now.Truncate(time.Minute).Add(time.Minute),
+		DayResetAt:    time.Date(now.Year(), now.Month(), now.Day()+1, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+// usageKeys names the counters after the end of their window, so a new window starts from zero
+func usageKeys(id string, usage internal.APIKeyUsage) (string, string) {
+	return fmt.Sprintf("v1:apikey:%s:_minute:%d", id, usage.MinuteResetAt.Unix()),
+		fmt.Sprintf("v1:apikey:%s:_day:%s", id, usage.DayResetAt.Format("20060102"))
+}
+
+func counterValue(value interface{}) int {
+	s, ok := value.(string)
+	if !ok {
+		return 0
+	}
+
+	count, _ := strconv.Atoi(s)
+
+	return count
+}
+
+func apiKeyHashKey(hash string) string {
+	return fmt.Sprintf("v1:apikey:_hash:%s", hash)
+}
@@ -0,0 +1,187 @@
+package redis
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"fourleaves.studio/manga-scraper/internal"
+	"github.com/labstack/echo/v4"
+)
+
+// fakeAPIKeyStore keeps the keys by hash and counts the lookups by hash
+type fakeAPIKeyStore struct {
+	APIKeyStore
+	keys    map[string]internal.APIKey
+	lookups int
+	// onWrite runs at the start of every write, before the key changes
+	onWrite func()
+}
+
+func (f *fakeAPIKeyStore) find(id string) (string, internal.APIKey) {
+	for hash, key := range f.keys {
+		if key.ID == id {
+			return hash, key
+		}
+	}
+
+	return "", internal.APIKey{}
+}
+
+func (f *fakeAPIKeyStore) Find(_ context.Context, id string) (internal.APIKey, error) {
+	if _, key := f.find(id); key.ID != "" {
+		return key, nil
+	}
+
+	return internal.APIKey{}, internal.NewErrorf(internal.ErrNotFound, "api key not found")
+}
+
+func (f *fakeAPIKeyStore) FindByHash(_ context.Context, hash string) (internal.APIKey, error) {
+	f.lookups++
+
+	key, ok := f.keys[hash]
+	if !ok {
+		return internal.APIKey{}, internal.NewErrorf(internal.ErrNotFound, "api key not found")
+	}
+
+	return key, nil
+}
+
+func (f *fakeAPIKeyStore) Rotate(_ context.Context, params internal.APIKeyParams) (internal.APIKey, error) {
+	f.written()
+
+	hash, key := f.find(params.ID)
+	delete(f.keys, hash)
+
+	key.Hash = params.Hash
+	f.keys[params.Hash] = key
+
+	return key, nil
+}
+
+func (f *fakeAPIKeyStore) Revoke(_ context.Context, id string) (internal.APIKey, error) {
+	f.written()
+
+	hash, key := f.find(id)
+
+	revokedAt := time.Now()
+	key.RevokedAt = &revokedAt
+	f.keys[hash] = key
+
+	return key, nil
+}
+
+func (f *fakeAPIKeyStore) written() {
+	if f.onWrite != nil {
+		f.onWrite()
+	}
+}
+
+func newTestAPIKeyCache(t *testing.T) (*APIKeyCache, *fakeAPIKeyStore) {
+	t.Helper()
+
+	mr := newTestRedis(t)
+	store := &fakeAPIKeyStore{
+		keys: map[string]internal.APIKey{
+			"hash-1": {ID: "1", Hash: "hash-1", Scopes: []internal.APIKeyScope{internal.ReadAPIKeyScope}},
+		},
+	}
+
+	return NewAPIKeyCache("redis://"+mr.Addr(), store, time.Minute, echo.New().Logger), store
+}
+
+func TestAPIKeyCache_FindByHash(t *testing.T) {
+	ctx := context.Background()
+	cache, store := newTestAPIKeyCache(t)
+
+	for i := 0; i < 2; i++ {
+		key, err := cache.FindByHash(ctx, "hash-1")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if key.ID != "1" || !key.HasScope(internal.ReadAPIKeyScope) {
+			t.Errorf("unexpected key %+v", key)
+		}
+	}
+
+	if store.lookups != 1 {
+		t.Errorf("expected the key to be looked up once, got %d lookups", store.lookups)
+	}
+
+	if _, err := cache.FindByHash(ctx, "unknown"); err == nil {
+		t.Error("expected an error for an unknown key")
+	}
+}
+
+func TestAPIKeyCache_Revoke(t *testing.T) {
+	ctx := context.Background()
+	cache, _ := newTestAPIKeyCache(t)
+
+	if _, err := cache.FindByHash(ctx, "hash-1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, err := cache.Revoke(ctx, "1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	key, err := cache.FindByHash(ctx, "hash-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if key.RevokedAt == nil {
+		t.Error("expected the revoked key, got the cached one")
+	}
+}
+
+func TestAPIKeyCache_Revoke_ConcurrentLookup(t *testing.T) {
+	ctx := context.Background()
+	cache, store := newTestAPIKeyCache(t)
+
+	if _, err := cache.FindByHash(ctx, "hash-1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// a request authenticating while the key is revoked caches the key as it was before
+	store.onWrite = func() {
+		if key, err := cache.FindByHash(ctx, "hash-1"); err != nil || key.RevokedAt != nil {
+			t.Errorf("expected the key before the revocation, got %+v (%v)", key, err)
+		}
+	}
+
+	if _, err := cache.Revoke(ctx, "1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	key, err := cache.FindByHash(ctx, "hash-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if key.RevokedAt == nil {
+		t.Error("expected the revoked key, got the one cached during the revocation")
+	}
+}
+
+func TestAPIKeyCache_Rotate(t *testing.T) {
+	ctx := context.Background()
+	cache, _ := newTestAPIKeyCache(t)
+
+	if _, err := cache.FindByHash(ctx, "hash-1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, err := cache.Rotate(ctx, internal.APIKeyParams{ID: "1", Hash: "hash-2"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, err := cache.FindByHash(ctx, "hash-1"); err == nil {
+		t.Error("expected the previous key to stop working")
+	}
+
+	if key, err := cache.FindByHash(ctx, "hash-2"); err != nil || key.ID != "1" {
+		t.Errorf("expected the new key to work, got %+v (%v)", key, err)
+	}
+}
@@ -0,0 +1,327 @@
+package redis
+
+import (
+	"bytes"
+	"context"
+	"encoding/gob"
+	"errors"
+	"expvar"
+	"fmt"
+	"time"
+
+	"github.com/getsentry/sentry-go"
+	"github.com/labstack/echo/v4"
+	"github.com/redis/go-redis/v9"
+	"golang.org/x/sync/singleflight"
+)
+
+const (
+	// staleWhileRevalidate is how long an entry past its expiration is still served while a single request refreshes
+	// it, entries deleted by an invalidation are never served stale
+	staleWhileRevalidate = 5 * time.Minute
+	// versionExpiration keeps the version of a written or deleted key for longer than any load of the key takes
+	versionExpiration = 10 * time.Minute
+)
+
+// errOutdated is returned when the key was written or deleted while its value was being loaded
+var errOutdated = errors.New("cache key changed during load")
+
+// cacheMetrics counts the hits, stale hits and misses of every cached method, published at /debug/vars
+var cacheMetrics = expvar.NewMap("cache")
+
+func newSentrySpan(ctx context.Context, operation string) *sentry.Span {
+	span := sentry.StartSpan(ctx, operation)
+	span.Name = "fourleaves.studio/manga-scraper/internal/database/redis"
+	span.SetTag("db.system", "redis")
+
+	return span
+}
+
+// entry is what the caches keep in Redis, the key outlives StaleAt by staleWhileRevalidate
+type entry[T any] struct {
+	Value   T
+	StaleAt time.Time
+}
+
+// cacheClient is the Redis side shared by the caches, misses of the same key within a process share one store read
+type cacheClient struct {
+	client     *redis.Client
+	expiration time.Duration
+	logger     echo.Logger
+	group      singleflight.Group
+}
+
+func newCacheClient(redisURL string, expiration time.Duration, logger echo.Logger) *cacheClient {
+	opts, _ := redis.ParseURL(redisURL)
+	return &cacheClient{
+		client:     redis.NewClient(opts),
+		expiration: expiration,
+		logger:     logger,
+	}
+}
+
+// fetch returns the cached value of key, loading and caching it on a miss. A stale value is returned right away
+// and refreshed in the background, concurrent misses and refreshes of a key share a single load
+func fetch[T any](ctx context.Context, c *cacheClient, method, key string, load func(ctx context.Context) (T, error)) (T, error) {
+	cached, err := get[T](ctx, c, key)
+	if err == nil {
+		if time.Now().Before(cached.StaleAt) {
+			c.record(method, "hit")
+
+			return cached.Value, nil
+		}
+
+		c.record(method, "stale")
+
+		c.logger.Debugj(map[string]interface{}{
+			"_source": method,
+			"_msg":    "stale cache",
+			"key":     key,
+		})
+
+		// the refresh outlives the request, its result is shared with misses of the key meanwhile
+		c.group.DoChan(key, func() (interface{}, error) {
+			return refresh(context.WithoutCancel(ctx), c, method, key, load)
+		})
+
+		return cached.Value, nil
+	}
+
+	c.record(method, "miss")
+
+	c.logger.Debugj(map[string]interface{}{
+		"_source": method,
+		"_msg":    "cache miss",
+		"key":     key,
+	})
+
+	value, err, _ := c.group.Do(key, func() (interface{}, error) {
+		return refresh(context.WithoutCancel(ctx), c, method, key, load)
+	})
+	if err != nil {
+		var zero T
+
+		return zero, err
+	}
+
+	return value.(T), nil
+}
+
+// refresh loads the value from the store and caches it, a failed write only costs the next read a miss.
+// The value is not cached when the key was written or deleted during the load, it may predate that change
+func refresh[T any](ctx context.Context, c *cacheClient, method, key string, load func(ctx context.Context) (T, error)) (T, error) {
+	// without the version the load cannot be checked, it is served uncached
+	version, err := c.version(ctx, key)
+	if err != nil {
+		return load(ctx)
+	}
+
+	value, err := load(ctx)
+	if err != nil {
+		return value, err
+	}
+
+	c.logger.Debugj(map[string]interface{}{
+		"_source": method,
+		"_msg":    "set cache",
+		"key":     key,
+		"value":   value,
+	})
+
+	if err := putLoaded(ctx, c, key, value, version); errors.Is(err, errOutdated) {
+		c.record(method, "outdated")
+
+		c.logger.Debugj(map[string]interface{}{
+			"_source": method,
+			"_msg":    "outdated load",
+			"key":     key,
+		})
+	} else if err != nil {
+		c.logger.Warnj(map[string]interface{}{
+			"_source": method,
+			"_msg":    "failed to set cache",
+			"key":     key,
+			"error":   err.Error(),
+		})
+	}
+
+	return value, nil
+}
+
+func get[T any](ctx context.Context, c *cacheClient, key string) (entry[T], error) {
+	defer newSentrySpan(ctx, "cacheClient.get").Finish()
+
+	data, err := c.client.Get(ctx, key).Bytes()
+	if err != nil {
+		return entry[T]{}, err
+	}
+
+	var cached entry[T]
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&cached); err != nil {
+		return entry[T]{}, err
+	}
+
+	return cached, nil
+}
+
+// put caches the value of key, fresh for the cache expiration. The loads of key in flight are not cached
+func put[T any](ctx context.Context, c *cacheClient, key string, value T) error {
+	defer newSentrySpan(ctx, "cacheClient.put").Finish()
+
+	data, err := encodeEntry(value, c.expiration)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Set(ctx, key, data, c.expiration+staleWhileRevalidate)
+		bumpVersions(ctx, pipe, key)
+
+		return nil
+	})
+
+	return err
+}
+
+// putLoaded caches a value of key loaded at version, it returns errOutdated when the key changed since
+func putLoaded[T any](ctx context.Context, c *cacheClient, key string, value T, version int64) error {
+	defer newSentrySpan(ctx, "cacheClient.putLoaded").Finish()
+
+	data, err := encodeEntry(value, c.expiration)
+	if err != nil {
+		return err
+	}
+
+	err = c.client.Watch(ctx, func(tx *redis.Tx) error {
+		current, err := tx.Get(ctx, versionKey(key)).Int64()
+		if err != nil && !errors.Is(err, redis.Nil) {
+			return err
+		}
+
+		if current != version {
+			return errOutdated
+		}
+
+		_, err = tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			pipe.Set(ctx, key, data, c.expiration+staleWhileRevalidate)
+
+			return nil
+		})
+
+		return err
+	}, versionKey(key))
+	if errors.Is(err, redis.TxFailedErr) {
+		return errOutdated
+	}
+
+	return err
+}
+
+// version returns the version of key, a missing version is version 0
+func (c *cacheClient) version(ctx context.Context, key string) (int64, error) {
+	version, err := c.client.Get(ctx, versionKey(key)).Int64()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return 0, err
+	}
+
+	return version, nil
+}
+
+// delete removes the keys, the loads of the keys in flight are not cached
+func (c *cacheClient) delete(ctx context.Context, keys ...string) error {
+	defer newSentrySpan(ctx, "cacheClient.delete").Finish()
+
+	_, err := c.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, keys...)
+		bumpVersions(ctx, pipe, keys...)
+
+		return nil
+	})
+
+	return err
+}
+
+// namespace returns the key prefix of the current generation of name, keys of older generations are never read
+// again and expire on their own
+func (c *cacheClient) namespace(ctx context.Context, name string) string {
+	defer newSentrySpan(ctx, "cacheClient.namespace").Finish()
+
+	// a missing generation key is generation 0
+	generation, _ := c.client.Get(ctx, generationKey(name)).Int64()
+
+	return fmt.Sprintf("%s:g%d", name, generation)
+}
+
+// invalidate starts a new generation of every namespace, which outdates all of their keys at once
+func (c *cacheClient) invalidate(ctx context.Context, names ...string) error {
+	defer newSentrySpan(ctx, "cacheClient.invalidate").Finish()
+
+	_, err := c.client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, name := range names {
+			pipe.Incr(ctx, generationKey(name))
+		}
+
+		return nil
+	})
+
+	return err
+}
+
+func (c *cacheClient) record(method, outcome string) {
+	cacheMetrics.Add(method+"."+outcome, 1)
+}
+
+func encodeEntry[T any](value T, expiration time.Duration) ([]byte, error) {
+	var b bytes.Buffer
+
+	if err := gob.NewEncoder(&b).Encode(entry[T]{Value: value, StaleAt: time.Now().Add(expiration)}); err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
+
+// bumpVersions outdates the loads in flight of the keys written or deleted in the pipeline
+func bumpVersions(ctx context.Context, pipe redis.Pipeliner, keys ...string) {
+	for _, key := range keys {
+		pipe.Incr(ctx, versionKey(key))
+		pipe.Expire(ctx, versionKey(key), versionExpiration)
+	}
+}
+
+func generationKey(name string) string {
+	return name + ":_gen"
+}
+
+func versionKey(key string) string {
+	return key + ":_ver"
+}
+
+func seriesListNamespace(provider string) string {
+	return fmt.Sprintf("v1:series:%s:_list", provider)
+}
+
+func chapterListNamespace(provider, series string) string {
+	return fmt.Sprintf("v1:chapters:%s:%s:_list", provider, series)
+}
+
+func seriesKey(provider, slug string) string {
+	return fmt.Sprintf("v1:series:%s:%s", provider, slug)
+}
+
+func chapterKey(provider, series, slug string) string {
+	return fmt.Sprintf("v1:chapters:%s:%s:%s", provider, series, slug)
+}
+
+func latestChapterKey(provider, series string) string {
+	return fmt.Sprintf("v1:chapters:%s:%s:_latest", provider, series)
+}
+
+func chapterCountKey(provider, series string) string {
+	return fmt.Sprintf("v1:chapters:%s:%s:_count", provider, series)
+}
+
+// bcKey is the key of the backward compatible response cached next to key
+func bcKey(key string) string {
+	return key + ":_bc"
+}
@@ -0,0 +1,197 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+func newTestCacheClient(t *testing.T, expiration time.Duration) *cacheClient {
+	t.Helper()
+
+	mr := newTestRedis(t)
+
+	return newCacheClient("redis://"+mr.Addr(), expiration, echo.New().Logger)
+}
+
+func TestFetch(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name      string
+		loadErr   error
+		wantLoads int32
+		wantErr   bool
+	}{
+		{"Loaded once", nil, 1, false},
+		{"Load errors are not cached", fmt.Errorf("unavailable"), 2, true},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			c := newTestCacheClient(t, time.Minute)
+
+			var loads atomic.Int32
+
+			load := func(context.Context) (string, error) {
+				loads.Add(1)
+				return "solo-leveling", tc.loadErr
+			}
+
+			for i := 0; i < 2; i++ {
+				value, err := fetch(ctx, c, "Test.Fetch", "v1:series:asura:solo-leveling", load)
+				if (err != nil) != tc.wantErr {
+					t.Fatalf("expected error: %v, got: %v", tc.wantErr, err)
+				}
+
+				if !tc.wantErr && value != "solo-leveling" {
+					t.Errorf("unexpected value %q", value)
+				}
+			}
+
+			if got := loads.Load(); got != tc.wantLoads {
+				t.Errorf("expected %d loads, got %d", tc.wantLoads, got)
+			}
+		})
+	}
+}
+
+func TestFetch_Stale(t *testing.T) {
+	ctx := context.Background()
+
+	// entries are stale as soon as they are written, and kept for staleWhileRevalidate
+	c := newTestCacheClient(t, 0)
+
+	var loads atomic.Int32
+
+	load := func(context.Context) (int32, error) {
+		return loads.Add(1), nil
+	}
+
+	if value, _ := fetch(ctx, c, "Test.Fetch", "v1:chapters:asura:solo-leveling:_count", load); value != 1 {
+		t.Fatalf("expected the loaded value 1, got %d", value)
+	}
+
+	if value, _ := fetch(ctx, c, "Test.Fetch", "v1:chapters:asura:solo-leveling:_count", load); value != 1 {
+		t.Errorf("expected the stale value 1, got %d", value)
+	}
+
+	deadline := time.Now().Add(time.Second)
+	for loads.Load() < 2 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	if got := loads.Load(); got != 2 {
+		t.Fatalf("expected the stale value to be refreshed in the background, got %d loads", got)
+	}
+}
+
+func TestFetch_ChangedDuringLoad(t *testing.T) {
+	ctx := context.Background()
+	key := "v1:series:asura:solo-leveling"
+
+	tests := []struct {
+		name   string
+		change func(c *cacheClient) error
+		want   string
+	}{
+		{"Deleted", func(c *cacheClient) error { return c.delete(ctx, key) }, ""},
+		{"Written", func(c *cacheClient) error { return put(ctx, c, key, "written") }, "written"},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			c := newTestCacheClient(t, time.Minute)
+
+			_, err := fetch(ctx, c, "Test.Fetch", key, func(context.Context) (string, error) {
+				if err := tc.change(c); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				return "loaded", nil
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			cached, err := get[string](ctx, c, key)
+			if tc.want == "" {
+				if err == nil {
+					t.Errorf("expected the outdated load not to be cached, got %q", cached.Value)
+				}
+
+				return
+			}
+
+			if err != nil || cached.Value != tc.want {
+				t.Errorf("expected %q to be kept, got %q (%v)", tc.want, cached.Value, err)
+			}
+		})
+	}
+}
+
+func TestCacheClient_Namespace(t *testing.T) {
+	ctx := context.Background()
+	c := newTestCacheClient(t, time.Minute)
+
+	asura := seriesListNamespace("asura")
+	flame := seriesListNamespace("flame")
+
+	if got := c.namespace(ctx, asura); got != asura+":g0" {
+		t.Errorf("expected generation 0 before any invalidation, got %q", got)
+	}
+
+	if err := c.invalidate(ctx, asura); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := c.invalidate(ctx, asura); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got := c.namespace(ctx, asura); got != asura+":g2" {
+		t.Errorf("expected generation 2 after two invalidations, got %q", got)
+	}
+
+	if got := c.namespace(ctx, flame); got != flame+":g0" {
+		t.Errorf("expected the other namespace to be kept, got %q", got)
+	}
+}
+
+func TestCacheClient_Invalidate(t *testing.T) {
+	ctx := context.Background()
+	c := newTestCacheClient(t, time.Minute)
+
+	name := chapterListNamespace("asura", "solo-leveling")
+
+	var loads atomic.Int32
+
+	load := func(context.Context) (int32, error) {
+		return loads.Add(1), nil
+	}
+
+	for i := 0; i < 2; i++ {
+		if _, err := fetch(ctx, c, "Test.Fetch", c.namespace(ctx, name)+":asc:all", load); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	if err := c.invalidate(ctx, name); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	value, err := fetch(ctx, c, "Test.Fetch", c.namespace(ctx, name)+":asc:all", load)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if value != 2 {
+		t.Errorf("expected the list to be loaded again after the invalidation, got %d", value)
+	}
+}
@@ -7,7 +7,6 @@ import (
 
 	"fourleaves.studio/manga-scraper/internal"
 	"github.com/labstack/echo/v4"
-	"github.com/redis/go-redis/v9"
 )
 
 type ChapterStore interface {
@@ -18,25 +17,20 @@ type ChapterStore interface {
 	Count(ctx context.Context, params internal.FindChapterParams) (int, error)
 	FindAll(ctx context.Context, params internal.FindChapterParams) ([]internal.Chapter, error)
 	FindListWithRel(ctx context.Context, params internal.FindChapterParams) (internal.ChapterList, error)
-	FindPaginated(ctx context.Context, params internal.FindChapterParams) ([]internal.Chapter, error)
+	FindPaginated(ctx context.Context, params internal.FindChapterParams) (internal.ChapterPage, error)
 	UpdateInit(ctx context.Context, params internal.UpdateInitChapterParams) (internal.Chapter, error)
 	Delete(ctx context.Context, params internal.FindChapterParams) error
 }
 
 type ChapterCache struct {
-	client     *redis.Client
-	store      ChapterStore
-	expiration time.Duration
-	logger     echo.Logger
+	*cacheClient
+	store ChapterStore
 }
 
 func NewChapterCache(redisURL string, store ChapterStore, expiration time.Duration, logger echo.Logger) *ChapterCache {
-	opts, _ := redis.ParseURL(redisURL)
 	return &ChapterCache{
-		client:     redis.NewClient(opts),
-		store:      store,
-		expiration: expiration,
-		logger:     logger,
+		cacheClient: newCacheClient(redisURL, expiration, logger),
+		store:       store,
 	}
 }
 
@@ -48,7 +42,7 @@ func (c *ChapterCache) CreateInit(ctx context.Context, params internal.CreateIni
 		return internal.Chapter{}, internal.WrapErrorf(err, internal.ErrUnknown, "store.CreateInit")
 	}
 
-	cacheKey := fmt.Sprintf("v1:chapters:%s:%s:%s", params.Provider, params.Series, params.Slug)
+	cacheKey := chapterKey(params.Provider, params.Series, params.Slug)
 
 	c.logger.Debugj(map[string]interface{}{
 		"_source": "ChapterCache.CreateInit",
@@ -57,9 +51,9 @@ func (c *ChapterCache) CreateInit(ctx context.Context, params internal.CreateIni
 		"value":   chapter,
 	})
 
-	err = c.setChapter(ctx, cacheKey, chapter)
+	err = put(ctx, c.cacheClient, cacheKey, chapter)
 	if err != nil {
-		return internal.Chapter{}, internal.WrapErrorf(err, internal.ErrUnknown, "setChapter")
+		return internal.Chapter{}, internal.WrapErrorf(err, internal.ErrUnknown, "put")
 	}
 
 	return chapter, nil
@@ -68,295 +62,106 @@ func (c *ChapterCache) CreateInit(ctx context.Context, params internal.CreateIni
 func (c *ChapterCache) Find(ctx context.Context, params internal.FindChapterParams) (internal.Chapter, error) {
 	defer newSentrySpan(ctx, "ChapterCache.Find").Finish()
 
-	cacheKey := fmt.Sprintf("v1:chapters:%s:%s:%s", params.Provider, params.Series, params.Slug)
+	cacheKey := chapterKey(params.Provider, params.Series, params.Slug)
 
-	c.logger.Debugj(map[string]interface{}{
-		"_source": "ChapterCache.Find",
-		"_msg":    "get cache",
-		"key":     cacheKey,
-	})
-
-	data, err := c.getChapter(ctx, cacheKey)
-	if err == nil {
-		return data, nil
-	}
-
-	c.logger.Debugj(map[string]interface{}{
-		"_source": "ChapterCache.Find",
-		"_msg":    "cache miss",
-		"key":     cacheKey,
+	chapter, err := fetch(ctx, c.cacheClient, "ChapterCache.Find", cacheKey, func(ctx context.Context) (internal.Chapter, error) {
+		return c.store.Find(ctx, params)
 	})
-
-	chapter, err := c.store.Find(ctx, params)
 	if err != nil {
 		return internal.Chapter{}, internal.WrapErrorf(err, internal.ErrUnknown, "store.Find")
 	}
 
-	c.logger.Debugj(map[string]interface{}{
-		"_source": "ChapterCache.Find",
-		"_msg":    "set cache",
-		"key":     cacheKey,
-		"value":   chapter,
-	})
-
-	err = c.setChapter(ctx, cacheKey, chapter)
-	if err != nil {
-		return internal.Chapter{}, internal.WrapErrorf(err, internal.ErrUnknown, "setChapter")
-	}
-
 	return chapter, nil
 }
 
 func (c *ChapterCache) FindBC(ctx context.Context, params internal.FindChapterParams) (internal.ChapterBC, error) {
 	defer newSentrySpan(ctx, "ChapterCache.FindBC").Finish()
 
-	cacheKey := fmt.Sprintf("v1:chapters:%s:%s:%s:_bc", params.Provider, params.Series, params.Slug)
+	cacheKey := bcKey(chapterKey(params.Provider, params.Series, params.Slug))
 
-	c.logger.Debugj(map[string]interface{}{
-		"_source": "ChapterCache.FindBC",
-		"_msg":    "get cache",
-		"key":     cacheKey,
+	chapter, err := fetch(ctx, c.cacheClient, "ChapterCache.FindBC", cacheKey, func(ctx context.Context) (internal.ChapterBC, error) {
+		return c.store.FindBC(ctx, params)
 	})
-
-	data, err := c.getChapterBC(ctx, cacheKey)
-	if err == nil {
-		return data, nil
-	}
-
-	c.logger.Debugj(map[string]interface{}{
-		"_source": "ChapterCache.FindBC",
-		"_msg":    "cache miss",
-		"key":     cacheKey,
-	})
-
-	chapter, err := c.store.FindBC(ctx, params)
 	if err != nil {
 		return internal.ChapterBC{}, internal.WrapErrorf(err, internal.ErrUnknown, "store.FindBC")
 	}
 
-	c.logger.Debugj(map[string]interface{}{
-		"_source": "ChapterCache.FindBC",
-		"_msg":    "set cache",
-		"key":     cacheKey,
-		"value":   chapter,
-	})
-
-	err = c.setChapterBC(ctx, cacheKey, chapter)
-	if err != nil {
-		return internal.ChapterBC{}, internal.WrapErrorf(err, internal.ErrUnknown, "setChapterBC")
-	}
-
 	return chapter, nil
 }
 
 func (c *ChapterCache) FindLatest(ctx context.Context, params internal.FindChapterParams) (internal.Chapter, error) {
 	defer newSentrySpan(ctx, "ChapterCache.FindLatest").Finish()
 
-	cacheKey := fmt.Sprintf("v1:chapters:%s:%s:_latest", params.Provider, params.Series)
-
-	c.logger.Debugj(map[string]interface{}{
-		"_source": "ChapterCache.FindLatest",
-		"_msg":    "get cache",
-		"key":     cacheKey,
-	})
-
-	data, err := c.getChapter(ctx, cacheKey)
-	if err == nil {
-		return data, nil
-	}
+	cacheKey := latestChapterKey(params.Provider, params.Series)
 
-	c.logger.Debugj(map[string]interface{}{
-		"_source": "ChapterCache.FindLatest",
-		"_msg":    "cache miss",
-		"key":     cacheKey,
+	chapter, err := fetch(ctx, c.cacheClient, "ChapterCache.FindLatest", cacheKey, func(ctx context.Context) (internal.Chapter, error) {
+		return c.store.FindLatest(ctx, params)
 	})
-
-	chapter, err := c.store.FindLatest(ctx, params)
 	if err != nil {
 		return internal.Chapter{}, internal.WrapErrorf(err, internal.ErrUnknown, "store.FindLatest")
 	}
 
-	c.logger.Debugj(map[string]interface{}{
-		"_source": "ChapterCache.FindLatest",
-		"_msg":    "set cache",
-		"key":     cacheKey,
-		"value":   chapter,
-	})
-
-	err = c.setChapter(ctx, cacheKey, chapter)
-	if err != nil {
-		return internal.Chapter{}, internal.WrapErrorf(err, internal.ErrUnknown, "setChapter")
-	}
-
 	return chapter, nil
 }
 
 func (c *ChapterCache) Count(ctx context.Context, params internal.FindChapterParams) (int, error) {
 	defer newSentrySpan(ctx, "ChapterCache.Count").Finish()
 
-	cacheKey := fmt.Sprintf("v1:chapters:%s:%s:_count", params.Provider, params.Series)
+	cacheKey := chapterCountKey(params.Provider, params.Series)
 
-	c.logger.Debugj(map[string]interface{}{
-		"_source": "ChapterCache.Count",
-		"_msg":    "get cache",
-		"key":     cacheKey,
-	})
-
-	data, err := c.getChaptersCount(ctx, cacheKey)
-	if err == nil {
-		return data, nil
-	}
-
-	c.logger.Debugj(map[string]interface{}{
-		"_source": "ChapterCache.Count",
-		"_msg":    "cache miss",
-		"key":     cacheKey,
+	count, err := fetch(ctx, c.cacheClient, "ChapterCache.Count", cacheKey, func(ctx context.Context) (int, error) {
+		return c.store.Count(ctx, params)
 	})
-
-	count, err := c.store.Count(ctx, params)
 	if err != nil {
 		return 0, internal.WrapErrorf(err, internal.ErrUnknown, "store.Count")
 	}
 
-	c.logger.Debugj(map[string]interface{}{
-		"_source": "ChapterCache.Count",
-		"_msg":    "set cache",
-		"key":     cacheKey,
-		"value":   count,
-	})
-
-	err = c.setChaptersCount(ctx, cacheKey, count)
-	if err != nil {
-		return 0, internal.WrapErrorf(err, internal.ErrUnknown, "setChaptersCount")
-	}
-
 	return count, nil
 }
 
 func (c *ChapterCache) FindAll(ctx context.Context, params internal.FindChapterParams) ([]internal.Chapter, error) {
 	defer newSentrySpan(ctx, "ChapterCache.FindAll").Finish()
 
-	cacheKey := fmt.Sprintf("v1:chapters:%s:%s:_list:%s:all", params.Provider, params.Series, params.Order)
+	cacheKey := fmt.Sprintf("%s:%s:all", c.namespace(ctx, chapterListNamespace(params.Provider, params.Series)), params.Order)
 
-	c.logger.Debugj(map[string]interface{}{
-		"_source": "ChapterCache.FindAll",
-		"_msg":    "get cache",
-		"key":     cacheKey,
+	chapters, err := fetch(ctx, c.cacheClient, "ChapterCache.FindAll", cacheKey, func(ctx context.Context) ([]internal.Chapter, error) {
+		return c.store.FindAll(ctx, params)
 	})
-
-	data, err := c.getManyChapters(ctx, cacheKey)
-	if err == nil {
-		return data, nil
-	}
-
-	c.logger.Debugj(map[string]interface{}{
-		"_source": "ChapterCache.FindAll",
-		"_msg":    "cache miss",
-		"key":     cacheKey,
-	})
-
-	chapters, err := c.store.FindAll(ctx, params)
 	if err != nil {
 		return nil, internal.WrapErrorf(err, internal.ErrUnknown, "store.FindAll")
 	}
 
-	c.logger.Debugj(map[string]interface{}{
-		"_source": "ChapterCache.FindAll",
-		"_msg":    "set cache",
-		"key":     cacheKey,
-		"value":   chapters,
-	})
-
-	err = c.setManyChapters(ctx, cacheKey, chapters)
-	if err != nil {
-		return nil, internal.WrapErrorf(err, internal.ErrUnknown, "setManyChapters")
-	}
-
 	return chapters, nil
 }
 
 func (c *ChapterCache) FindListWithRel(ctx context.Context, params internal.FindChapterParams) (internal.ChapterList, error) {
 	defer newSentrySpan(ctx, "ChapterCache.FindListWithRel").Finish()
 
-	cacheKey := fmt.Sprintf("v1:chapters:%s:%s:_list:%s:_rel", params.Provider, params.Series, params.Order)
+	cacheKey := fmt.Sprintf("%s:%s:_rel", c.namespace(ctx, chapterListNamespace(params.Provider, params.Series)), params.Order)
 
-	c.logger.Debugj(map[string]interface{}{
-		"_source": "ChapterCache.FindListWithRel",
-		"_msg":    "get cache",
-		"key":     cacheKey,
+	chapterList, err := fetch(ctx, c.cacheClient, "ChapterCache.FindListWithRel", cacheKey, func(ctx context.Context) (internal.ChapterList, error) {
+		return c.store.FindListWithRel(ctx, params)
 	})
-
-	data, err := c.getChapterList(ctx, cacheKey)
-	if err == nil {
-		return data, nil
-	}
-
-	c.logger.Debugj(map[string]interface{}{
-		"_source": "ChapterCache.FindListWithRel",
-		"_msg":    "cache miss",
-		"key":     cacheKey,
-	})
-
-	chapterList, err := c.store.FindListWithRel(ctx, params)
 	if err != nil {
 		return internal.ChapterList{}, internal.WrapErrorf(err, internal.ErrUnknown, "store.FindListWithRel")
 	}
 
-	c.logger.Debugj(map[string]interface{}{
-		"_source": "ChapterCache.FindListWithRel",
-		"_msg":    "set cache",
-		"key":     cacheKey,
-		"value":   chapterList,
-	})
-
-	err = c.setChapterList(ctx, cacheKey, chapterList)
-	if err != nil {
-		return internal.ChapterList{}, internal.WrapErrorf(err, internal.ErrUnknown, "setChapterList")
-	}
-
 	return chapterList, nil
 }
 
-func (c *ChapterCache) FindPaginated(ctx context.Context, params internal.FindChapterParams) ([]internal.Chapter, error) {
+func (c *ChapterCache) FindPaginated(ctx context.Context, params internal.FindChapterParams) (internal.ChapterPage, error) {
 	defer newSentrySpan(ctx, "ChapterCache.FindPaginated").Finish()
 
-	cacheKey := fmt.Sprintf("v1:chapters:%s:%s:_list:%s:page:%d:size:%d", params.Provider, params.Series, params.Order, params.Page, params.Size)
-
-	c.logger.Debugj(map[string]interface{}{
-		"_source": "ChapterCache.FindPaginated",
-		"_msg":    "get cache",
-		"key":     cacheKey,
-	})
-
-	data, err := c.getManyChapters(ctx, cacheKey)
-	if err == nil {
-		return data, nil
-	}
+	cacheKey := fmt.Sprintf("%s:%s:cursor:%s:page:%d:size:%d", c.namespace(ctx, chapterListNamespace(params.Provider, params.Series)), params.Order, params.Cursor, params.Page, params.Size)
 
-	c.logger.Debugj(map[string]interface{}{
-		"_source": "ChapterCache.FindPaginated",
-		"_msg":    "cache miss",
-		"key":     cacheKey,
+	page, err := fetch(ctx, c.cacheClient, "ChapterCache.FindPaginated", cacheKey, func(ctx context.Context) (internal.ChapterPage, error) {
+		return c.store.FindPaginated(ctx, params)
 	})
-
-	chapters, err := c.store.FindPaginated(ctx, params)
 	if err != nil {
-		return nil, internal.WrapErrorf(err, internal.ErrUnknown, "store.FindPaginated")
+		return internal.ChapterPage{}, internal.WrapErrorf(err, internal.ErrUnknown, "store.FindPaginated")
 	}
 
-	c.logger.Debugj(map[string]interface{}{
-		"_source": "ChapterCache.FindPaginated",
-		"_msg":    "set cache",
-		"key":     cacheKey,
-		"value":   chapters,
-	})
-
-	err = c.setManyChapters(ctx, cacheKey, chapters)
-	if err != nil {
-		return nil, internal.WrapErrorf(err, internal.ErrUnknown, "setManyChapters")
-	}
-
-	return chapters, nil
+	return page, nil
 }
 
 func (c *ChapterCache) UpdateInit(ctx context.Context, params internal.UpdateInitChapterParams) (internal.Chapter, error) {
@@ -367,7 +172,7 @@ func (c *ChapterCache) UpdateInit(ctx context.Context, params internal.UpdateIni
 		return internal.Chapter{}, internal.WrapErrorf(err, internal.ErrUnknown, "store.UpdateInit")
 	}
 
-	cacheKey := fmt.Sprintf("v1:chapters:%s:%s:%s", params.Provider, params.Series, params.Slug)
+	cacheKey := chapterKey(params.Provider, params.Series, params.Slug)
 
 	c.logger.Debugj(map[string]interface{}{
 		"_source": "ChapterCache.UpdateInit",
@@ -376,12 +181,12 @@ func (c *ChapterCache) UpdateInit(ctx context.Context, params internal.UpdateIni
 		"value":   chapter,
 	})
 
-	err = c.setChapter(ctx, cacheKey, chapter)
+	err = put(ctx, c.cacheClient, cacheKey, chapter)
 	if err != nil {
-		return internal.Chapter{}, internal.WrapErrorf(err, internal.ErrUnknown, "setChapter")
+		return internal.Chapter{}, internal.WrapErrorf(err, internal.ErrUnknown, "put")
 	}
 
-	_ = c.deleteManyChapters(ctx, fmt.Sprintf("v1:chapters:%s:%s:_list:*", params.Provider, params.Series))
+	_ = c.invalidate(ctx, chapterListNamespace(params.Provider, params.Series))
 
 	return chapter, nil
 }
@@ -389,7 +194,7 @@ func (c *ChapterCache) UpdateInit(ctx context.Context, params internal.UpdateIni
 func (c *ChapterCache) Delete(ctx context.Context, params internal.FindChapterParams) error {
 	defer newSentrySpan(ctx, "ChapterCache.Delete").Finish()
 
-	cacheKey := fmt.Sprintf("v1:chapters:%s:%s:%s", params.Provider, params.Series, params.Slug)
+	cacheKey := chapterKey(params.Provider, params.Series, params.Slug)
 
 	c.logger.Debugj(map[string]interface{}{
 		"_source": "ChapterCache.Delete",
@@ -397,12 +202,12 @@ func (c *ChapterCache) Delete(ctx context.Context, params internal.FindChapterPa
 		"key":     cacheKey,
 	})
 
-	err := c.deleteChapter(ctx, cacheKey)
+	err := c.delete(ctx, cacheKey)
 	if err != nil {
-		return internal.WrapErrorf(err, internal.ErrUnknown, "deleteChapter")
+		return internal.WrapErrorf(err, internal.ErrUnknown, "delete")
 	}
 
-	_ = c.deleteManyChapters(ctx, fmt.Sprintf("v1:chapters:%s:%s:_list:*", params.Provider, params.Series))
+	_ = c.invalidate(ctx, chapterListNamespace(params.Provider, params.Series))
 
 	return nil
 }
@@ -0,0 +1,97 @@
+package redis
+
+import (
+	"context"
+
+	"fourleaves.studio/manga-scraper/internal"
+	"github.com/redis/go-redis/v9"
+)
+
+// Invalidation lists the cache keys to delete and the namespaces whose generation to bump
+type Invalidation struct {
+	Keys       []string
+	Namespaces []string
+}
+
+// seriesInvalidation outdates the given series of the provider and every series list of the provider
+func seriesInvalidation(provider string, slugs ...string) Invalidation {
+	invalidation := Invalidation{
+		Namespaces: []string{seriesListNamespace(provider)},
+	}
+
+	for _, slug := range slugs {
+		invalidation.Keys = append(invalidation.Keys,
+			seriesKey(provider, slug),
+			bcKey(seriesKey(provider, slug)),
+		)
+	}
+
+	return invalidation
+}
+
+// chaptersInvalidation outdates the given chapters of the series, along with its chapter lists, count and latest chapter
+func chaptersInvalidation(provider, series string, slugs ...string) Invalidation {
+	invalidation := Invalidation{
+		Keys: []string{
+			latestChapterKey(provider, series),
+			chapterCountKey(provider, series),
+		},
+		Namespaces: []string{chapterListNamespace(provider, series)},
+	}
+
+	for _, slug := range slugs {
+		invalidation.Keys = append(invalidation.Keys,
+			chapterKey(provider, series, slug),
+			bcKey(chapterKey(provider, series, slug)),
+		)
+	}
+
+	return invalidation
+}
+
+// Invalidator drops the cache keys outdated by writes made outside the REST server, it is the CacheInvalidator
+// of the scraper and cron workers. The caches of every REST instance share the Redis it writes to
+type Invalidator struct {
+	client *redis.Client
+}
+
+func NewInvalidator(redisURL string) *Invalidator {
+	opts, _ := redis.ParseURL(redisURL)
+	return &Invalidator{
+		client: redis.NewClient(opts),
+	}
+}
+
+func (i *Invalidator) SeriesChanged(ctx context.Context, provider string, slugs ...string) error {
+	defer newSentrySpan(ctx, "Invalidator.SeriesChanged").Finish()
+
+	return i.apply(ctx, seriesInvalidation(provider, slugs...))
+}
+
+func (i *Invalidator) ChaptersChanged(ctx context.Context, provider, series string, slugs ...string) error {
+	defer newSentrySpan(ctx, "Invalidator.ChaptersChanged").Finish()
+
+	return i.apply(ctx, chaptersInvalidation(provider, series, slugs...))
+}
+
+// apply deletes the keys and starts a new generation of the namespaces in a single round trip,
+// the REST instances loading the keys meanwhile do not cache what they load
+func (i *Invalidator) apply(ctx context.Context, invalidation Invalidation) error {
+	_, err := i.client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		if len(invalidation.Keys) > 0 {
+			pipe.Del(ctx, invalidation.Keys...)
+			bumpVersions(ctx, pipe, invalidation.Keys...)
+		}
+
+		for _, name := range invalidation.Namespaces {
+			pipe.Incr(ctx, generationKey(name))
+		}
+
+		return nil
+	})
+	if err != nil {
+		return internal.WrapErrorf(err, internal.ErrUnknown, "client.Pipelined")
+	}
+
+	return nil
+}
@@ -0,0 +1,107 @@
+package redis
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+)
+
+// newTestRedis starts an in-memory Redis closed with the test
+func newTestRedis(t *testing.T) *miniredis.Miniredis {
+	t.Helper()
+
+	return miniredis.RunT(t)
+}
+
+func TestInvalidator_SeriesChanged(t *testing.T) {
+	mr := newTestRedis(t)
+
+	for _, key := range []string{
+		"v1:series:asura:solo-leveling",
+		"v1:series:asura:solo-leveling:_bc",
+		"v1:series:asura:omniscient-reader",
+		"v1:series:flame:solo-leveling",
+	} {
+		_ = mr.Set(key, "cached")
+	}
+
+	invalidator := NewInvalidator("redis://" + mr.Addr())
+
+	if err := invalidator.SeriesChanged(context.Background(), "asura", "solo-leveling"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, key := range []string{"v1:series:asura:solo-leveling", "v1:series:asura:solo-leveling:_bc"} {
+		if mr.Exists(key) {
+			t.Errorf("expected %s to be deleted", key)
+		}
+	}
+
+	for _, key := range []string{"v1:series:asura:omniscient-reader", "v1:series:flame:solo-leveling"} {
+		if !mr.Exists(key) {
+			t.Errorf("expected %s to be kept", key)
+		}
+	}
+
+	if got, _ := mr.Get(generationKey(seriesListNamespace("asura"))); got != "1" {
+		t.Errorf("expected the asura series lists at generation 1, got %q", got)
+	}
+
+	if mr.Exists(generationKey(seriesListNamespace("flame"))) {
+		t.Error("expected the flame series lists to be kept")
+	}
+}
+
+func TestInvalidator_ChaptersChanged(t *testing.T) {
+	mr := newTestRedis(t)
+
+	for _, key := range []string{
+		"v1:chapters:asura:solo-leveling:_latest",
+		"v1:chapters:asura:solo-leveling:_count",
+		"v1:chapters:asura:solo-leveling:chapter-1",
+		"v1:chapters:asura:solo-leveling:chapter-1:_bc",
+		"v1:chapters:asura:solo-leveling:chapter-2",
+	} {
+		_ = mr.Set(key, "cached")
+	}
+
+	_ = mr.Set(generationKey(chapterListNamespace("asura", "solo-leveling")), "4")
+
+	invalidator := NewInvalidator("redis://" + mr.Addr())
+
+	if err := invalidator.ChaptersChanged(context.Background(), "asura", "solo-leveling", "chapter-1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, key := range []string{
+		"v1:chapters:asura:solo-leveling:_latest",
+		"v1:chapters:asura:solo-leveling:_count",
+		"v1:chapters:asura:solo-leveling:chapter-1",
+		"v1:chapters:asura:solo-leveling:chapter-1:_bc",
+	} {
+		if mr.Exists(key) {
+			t.Errorf("expected %s to be deleted", key)
+		}
+	}
+
+	if !mr.Exists("v1:chapters:asura:solo-leveling:chapter-2") {
+		t.Error("expected chapter-2 to be kept")
+	}
+
+	if got, _ := mr.Get(generationKey(chapterListNamespace("asura", "solo-leveling"))); got != "5" {
+		t.Errorf("expected the chapter lists at generation 5, got %q", got)
+	}
+}
+
+func TestInvalidator_Unavailable(t *testing.T) {
+	mr := newTestRedis(t)
+
+	invalidator := NewInvalidator("redis://" + mr.Addr())
+
+	mr.Close()
+
+	if err := invalidator.SeriesChanged(context.Background(), "asura", "solo-leveling"); err == nil {
+		t.Error("expected an error while Redis is down")
+	}
+}
@@ -7,7 +7,6 @@ import (
 
 	"fourleaves.studio/manga-scraper/internal"
 	"github.com/labstack/echo/v4"
-	"github.com/redis/go-redis/v9"
 )
 
 type ProviderStore interface {
@@ -20,19 +19,14 @@ type ProviderStore interface {
 }
 
 type ProviderCache struct {
-	client     *redis.Client
-	store      ProviderStore
-	expiration time.Duration
-	logger     echo.Logger
+	*cacheClient
+	store ProviderStore
 }
 
 func NewProviderCache(redisURL string, store ProviderStore, expiration time.Duration, logger echo.Logger) *ProviderCache {
-	opts, _ := redis.ParseURL(redisURL)
 	return &ProviderCache{
-		client:     redis.NewClient(opts),
-		store:      store,
-		expiration: expiration,
-		logger:     logger,
+		cacheClient: newCacheClient(redisURL, expiration, logger),
+		store:       store,
 	}
 }
 
@@ -53,9 +47,9 @@ func (p *ProviderCache) Create(ctx context.Context, params internal.ProviderPara
 		"value":   provider,
 	})
 
-	err = p.setProvider(ctx, cacheKey, provider)
+	err = put(ctx, p.cacheClient, cacheKey, provider)
 	if err != nil {
-		return internal.Provider{}, internal.WrapErrorf(err, internal.ErrUnknown, "setProvider")
+		return internal.Provider{}, internal.WrapErrorf(err, internal.ErrUnknown, "put")
 	}
 
 	return provider, nil
@@ -66,40 +60,13 @@ func (p *ProviderCache) Find(ctx context.Context, slug string) (internal.Provide
 
 	cacheKey := fmt.Sprintf("v1:provider:%s", slug)
 
-	p.logger.Debugj(map[string]interface{}{
-		"_source": "ProviderCache.Find",
-		"_msg":    "get cache",
-		"key":     cacheKey,
-	})
-
-	data, err := p.getProvider(ctx, cacheKey)
-	if err == nil {
-		return data, nil
-	}
-
-	p.logger.Debugj(map[string]interface{}{
-		"_source": "ProviderCache.Find",
-		"_msg":    "cache miss",
-		"key":     cacheKey,
+	provider, err := fetch(ctx, p.cacheClient, "ProviderCache.Find", cacheKey, func(ctx context.Context) (internal.Provider, error) {
+		return p.store.Find(ctx, slug)
 	})
-
-	provider, err := p.store.Find(ctx, slug)
 	if err != nil {
 		return internal.Provider{}, internal.WrapErrorf(err, internal.ErrUnknown, "store.Find")
 	}
 
-	p.logger.Debugj(map[string]interface{}{
-		"_source": "ProviderCache.Find",
-		"_msg":    "set cache",
-		"key":     cacheKey,
-		"value":   provider,
-	})
-
-	err = p.setProvider(ctx, cacheKey, provider)
-	if err != nil {
-		return internal.Provider{}, internal.WrapErrorf(err, internal.ErrUnknown, "setProvider")
-	}
-
 	return provider, nil
 }
 
@@ -108,40 +75,13 @@ func (p *ProviderCache) FindBC(ctx context.Context, slug string) (internal.Provi
 
 	cacheKey := fmt.Sprintf("v1:provider:%s:_bc", slug)
 
-	p.logger.Debugj(map[string]interface{}{
-		"_source": "ProviderCache.FindBC",
-		"_msg":    "get cache",
-		"key":     cacheKey,
+	provider, err := fetch(ctx, p.cacheClient, "ProviderCache.FindBC", cacheKey, func(ctx context.Context) (internal.ProviderBC, error) {
+		return p.store.FindBC(ctx, slug)
 	})
-
-	data, err := p.getProviderBC(ctx, cacheKey)
-	if err == nil {
-		return data, nil
-	}
-
-	p.logger.Debugj(map[string]interface{}{
-		"_source": "ProviderCache.FindBC",
-		"_msg":    "cache miss",
-		"key":     cacheKey,
-	})
-
-	provider, err := p.store.FindBC(ctx, slug)
 	if err != nil {
 		return internal.ProviderBC{}, internal.WrapErrorf(err, internal.ErrUnknown, "store.FindBC")
 	}
 
-	p.logger.Debugj(map[string]interface{}{
-		"_source": "ProviderCache.FindBC",
-		"_msg":    "set cache",
-		"key":     cacheKey,
-		"value":   provider,
-	})
-
-	err = p.setProviderBC(ctx, cacheKey, provider)
-	if err != nil {
-		return internal.ProviderBC{}, internal.WrapErrorf(err, internal.ErrUnknown, "setProviderBC")
-	}
-
 	return provider, nil
 }
 
@@ -150,40 +90,13 @@ func (p *ProviderCache) FindAll(ctx context.Context, order internal.SortOrder) (
 
 	cacheKey := "v1:providers:_list"
 
-	p.logger.Debugj(map[string]interface{}{
-		"_source": "ProviderCache.FindAll",
-		"_msg":    "get cache",
-		"key":     cacheKey,
-	})
-
-	data, err := p.getManyProviders(ctx, cacheKey)
-	if err == nil {
-		return data, nil
-	}
-
-	p.logger.Debugj(map[string]interface{}{
-		"_source": "ProviderCache.FindAll",
-		"_msg":    "cache miss",
-		"key":     cacheKey,
+	providers, err := fetch(ctx, p.cacheClient, "ProviderCache.FindAll", cacheKey, func(ctx context.Context) ([]internal.Provider, error) {
+		return p.store.FindAll(ctx, order)
 	})
-
-	providers, err := p.store.FindAll(ctx, order)
 	if err != nil {
 		return nil, internal.WrapErrorf(err, internal.ErrUnknown, "store.FindAll")
 	}
 
-	p.logger.Debugj(map[string]interface{}{
-		"_source": "ProviderCache.FindAll",
-		"_msg":    "set cache",
-		"key":     cacheKey,
-		"value":   providers,
-	})
-
-	err = p.setManyProviders(ctx, cacheKey, providers)
-	if err != nil {
-		return nil, internal.WrapErrorf(err, internal.ErrUnknown, "setManyProviders")
-	}
-
 	return providers, nil
 }
 
@@ -204,12 +117,12 @@ func (p *ProviderCache) Update(ctx context.Context, params internal.ProviderPara
 		"value":   provider,
 	})
 
-	err = p.setProvider(ctx, cacheKey, provider)
+	err = put(ctx, p.cacheClient, cacheKey, provider)
 	if err != nil {
-		return internal.Provider{}, internal.WrapErrorf(err, internal.ErrUnknown, "setProvider")
+		return internal.Provider{}, internal.WrapErrorf(err, internal.ErrUnknown, "put")
 	}
 
-	_ = p.deleteProvider(ctx, "v1:providers:_list")
+	_ = p.delete(ctx, fmt.Sprintf("v1:provider:%s:_bc", provider.Slug), "v1:providers:_list")
 
 	return provider, nil
 }
@@ -225,8 +138,7 @@ func (p *ProviderCache) Delete(ctx context.Context, slug string) error {
 		"key":     cacheKey,
 	})
 
-	_ = p.deleteProvider(ctx, cacheKey)
-	_ = p.deleteProvider(ctx, "v1:providers:_list")
+	_ = p.delete(ctx, cacheKey, fmt.Sprintf("v1:provider:%s:_bc", slug), "v1:providers:_list")
 
 	return p.store.Delete(ctx, slug)
 }
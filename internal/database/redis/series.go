@@ -7,7 +7,6 @@ import (
 
 	"fourleaves.studio/manga-scraper/internal"
 	"github.com/labstack/echo/v4"
-	"github.com/redis/go-redis/v9"
 )
 
 type SeriesStore interface {
@@ -15,26 +14,23 @@ type SeriesStore interface {
 	Find(ctx context.Context, params internal.FindSeriesParams) (internal.Series, error)
 	FindBC(ctx context.Context, params internal.FindSeriesParams) (internal.SeriesBC, error)
 	FindAll(ctx context.Context, params internal.FindSeriesParams) ([]internal.Series, error)
-	FindPaginated(ctx context.Context, params internal.FindSeriesParams) ([]internal.Series, error)
+	FindPaginated(ctx context.Context, params internal.FindSeriesParams) (internal.SeriesPage, error)
+	Browse(ctx context.Context, params internal.BrowseSeriesParams) (internal.SeriesPage, error)
 	UpdateInit(ctx context.Context, params internal.UpdateInitSeriesParams) (internal.Series, error)
 	UpdateLatest(ctx context.Context, params internal.UpdateLatestSeriesParams) (internal.Series, error)
+	SyncChapters(ctx context.Context, params internal.FindSeriesParams) (internal.Series, error)
 	Delete(ctx context.Context, params internal.FindSeriesParams) error
 }
 
 type SeriesCache struct {
-	client     *redis.Client
-	store      SeriesStore
-	expiration time.Duration
-	logger     echo.Logger
+	*cacheClient
+	store SeriesStore
 }
 
 func NewSeriesCache(redisURL string, store SeriesStore, expiration time.Duration, logger echo.Logger) *SeriesCache {
-	opts, _ := redis.ParseURL(redisURL)
 	return &SeriesCache{
-		client:     redis.NewClient(opts),
-		store:      store,
-		expiration: expiration,
-		logger:     logger,
+		cacheClient: newCacheClient(redisURL, expiration, logger),
+		store:       store,
 	}
 }
 
@@ -46,189 +42,74 @@ func (s *SeriesCache) CreateInit(ctx context.Context, params internal.CreateInit
 		return internal.Series{}, internal.WrapErrorf(err, internal.ErrUnknown, "store.CreateInit")
 	}
 
-	cacheKey := fmt.Sprintf("v1:series:%s:%s", series.Provider, series.Slug)
-
-	s.logger.Debugj(map[string]interface{}{
-		"_source": "SeriesCache.CreateInit",
-		"_msg":    "set cache",
-		"key":     cacheKey,
-		"value":   series,
-	})
-
-	err = s.setSeries(ctx, cacheKey, series)
-	if err != nil {
-		return internal.Series{}, internal.WrapErrorf(err, internal.ErrUnknown, "setSeries")
-	}
-
-	return series, nil
+	return s.setSeries(ctx, "SeriesCache.CreateInit", series)
 }
 
 func (s *SeriesCache) Find(ctx context.Context, params internal.FindSeriesParams) (internal.Series, error) {
 	defer newSentrySpan(ctx, "SeriesCache.Find").Finish()
 
-	cacheKey := fmt.Sprintf("v1:series:%s:%s", params.Provider, params.Slug)
+	cacheKey := seriesKey(params.Provider, params.Slug)
 
-	s.logger.Debugj(map[string]interface{}{
-		"_source": "SeriesCache.Find",
-		"_msg":    "get cache",
-		"key":     cacheKey,
+	series, err := fetch(ctx, s.cacheClient, "SeriesCache.Find", cacheKey, func(ctx context.Context) (internal.Series, error) {
+		return s.store.Find(ctx, params)
 	})
-
-	series, err := s.getSeries(ctx, cacheKey)
-	if err == nil {
-		return series, nil
-	}
-
-	s.logger.Debugj(map[string]interface{}{
-		"_source": "SeriesCache.Find",
-		"_msg":    "cache miss",
-		"key":     cacheKey,
-	})
-
-	series, err = s.store.Find(ctx, params)
 	if err != nil {
 		return internal.Series{}, internal.WrapErrorf(err, internal.ErrUnknown, "store.Find")
 	}
 
-	s.logger.Debugj(map[string]interface{}{
-		"_source": "SeriesCache.Find",
-		"_msg":    "set cache",
-		"key":     cacheKey,
-		"value":   series,
-	})
-
-	err = s.setSeries(ctx, cacheKey, series)
-	if err != nil {
-		return internal.Series{}, internal.WrapErrorf(err, internal.ErrUnknown, "setSeries")
-	}
-
 	return series, nil
 }
 
 func (s *SeriesCache) FindBC(ctx context.Context, params internal.FindSeriesParams) (internal.SeriesBC, error) {
 	defer newSentrySpan(ctx, "SeriesCache.FindBC").Finish()
 
-	cacheKey := fmt.Sprintf("v1:series:%s:%s:_bc", params.Provider, params.Slug)
+	cacheKey := bcKey(seriesKey(params.Provider, params.Slug))
 
-	s.logger.Debugj(map[string]interface{}{
-		"_source": "SeriesCache.FindBC",
-		"_msg":    "get cache",
-		"key":     cacheKey,
-	})
-
-	series, err := s.getSeriesBC(ctx, cacheKey)
-	if err == nil {
-		return series, nil
-	}
-
-	s.logger.Debugj(map[string]interface{}{
-		"_source": "SeriesCache.FindBC",
-		"_msg":    "cache miss",
-		"key":     cacheKey,
+	series, err := fetch(ctx, s.cacheClient, "SeriesCache.FindBC", cacheKey, func(ctx context.Context) (internal.SeriesBC, error) {
+		return s.store.FindBC(ctx, params)
 	})
-
-	series, err = s.store.FindBC(ctx, params)
 	if err != nil {
 		return internal.SeriesBC{}, internal.WrapErrorf(err, internal.ErrUnknown, "store.FindBC")
 	}
 
-	s.logger.Debugj(map[string]interface{}{
-		"_source": "SeriesCache.FindBC",
-		"_msg":    "set cache",
-		"key":     cacheKey,
-		"value":   series,
-	})
-
-	err = s.setSeriesBC(ctx, cacheKey, series)
-	if err != nil {
-		return internal.SeriesBC{}, internal.WrapErrorf(err, internal.ErrUnknown, "setSeriesBC")
-	}
-
 	return series, nil
 }
 
 func (s *SeriesCache) FindAll(ctx context.Context, params internal.FindSeriesParams) ([]internal.Series, error) {
 	defer newSentrySpan(ctx, "SeriesCache.FindAll").Finish()
 
-	cacheKey := fmt.Sprintf("v1:series:%s:_list:%s:all", params.Provider, params.Order)
-
-	s.logger.Debugj(map[string]interface{}{
-		"_source": "SeriesCache.FindAll",
-		"_msg":    "get cache",
-		"key":     cacheKey,
-	})
-
-	data, err := s.getManySeries(ctx, cacheKey)
-	if err == nil {
-		return data, nil
-	}
+	cacheKey := fmt.Sprintf("%s:%s:all", s.namespace(ctx, seriesListNamespace(params.Provider)), params.Order)
 
-	s.logger.Debugj(map[string]interface{}{
-		"_source": "SeriesCache.FindAll",
-		"_msg":    "cache miss",
-		"key":     cacheKey,
+	series, err := fetch(ctx, s.cacheClient, "SeriesCache.FindAll", cacheKey, func(ctx context.Context) ([]internal.Series, error) {
+		return s.store.FindAll(ctx, params)
 	})
-
-	series, err := s.store.FindAll(ctx, params)
 	if err != nil {
 		return nil, internal.WrapErrorf(err, internal.ErrUnknown, "store.FindAll")
 	}
 
-	s.logger.Debugj(map[string]interface{}{
-		"_source": "SeriesCache.FindAll",
-		"_msg":    "set cache",
-		"key":     cacheKey,
-		"value":   series,
-	})
-
-	err = s.setManySeries(ctx, cacheKey, series)
-	if err != nil {
-		return nil, internal.WrapErrorf(err, internal.ErrUnknown, "setManySeries")
-	}
-
 	return series, nil
 }
 
-func (s *SeriesCache) FindPaginated(ctx context.Context, params internal.FindSeriesParams) ([]internal.Series, error) {
+func (s *SeriesCache) FindPaginated(ctx context.Context, params internal.FindSeriesParams) (internal.SeriesPage, error) {
 	defer newSentrySpan(ctx, "SeriesCache.FindPaginated").Finish()
 
-	cacheKey := fmt.Sprintf("v1:series:%s:_list:%s:page:%d:size:%d", params.Provider, params.Order, params.Page, params.Size)
+	cacheKey := fmt.Sprintf("%s:%s:cursor:%s:page:%d:size:%d", s.namespace(ctx, seriesListNamespace(params.Provider)), params.Order, params.Cursor, params.Page, params.Size)
 
-	s.logger.Debugj(map[string]interface{}{
-		"_source": "SeriesCache.FindPaginated",
-		"_msg":    "get cache",
-		"key":     cacheKey,
-	})
-
-	data, err := s.getManySeries(ctx, cacheKey)
-	if err == nil {
-		return data, nil
-	}
-
-	s.logger.Debugj(map[string]interface{}{
-		"_source": "SeriesCache.FindPaginated",
-		"_msg":    "cache miss",
-		"key":     cacheKey,
+	page, err := fetch(ctx, s.cacheClient, "SeriesCache.FindPaginated", cacheKey, func(ctx context.Context) (internal.SeriesPage, error) {
+		return s.store.FindPaginated(ctx, params)
 	})
-
-	series, err := s.store.FindPaginated(ctx, params)
 	if err != nil {
-		return nil, internal.WrapErrorf(err, internal.ErrUnknown, "store.FindPaginated")
+		return internal.SeriesPage{}, internal.WrapErrorf(err, internal.ErrUnknown, "store.FindPaginated")
 	}
 
-	s.logger.Debugj(map[string]interface{}{
-		"_source": "SeriesCache.FindPaginated",
-		"_msg":    "set cache",
-		"key":     cacheKey,
-		"value":   series,
-	})
+	return page, nil
+}
 
-	err = s.setManySeries(ctx, cacheKey, series)
-	if err != nil {
-		return nil, internal.WrapErrorf(err, internal.ErrUnknown, "setManySeries")
-	}
+// Browse is not cached, the filter combinations are too many to invalidate them on every series update
+func (s *SeriesCache) Browse(ctx context.Context, params internal.BrowseSeriesParams) (internal.SeriesPage, error) {
+	defer newSentrySpan(ctx, "SeriesCache.Browse").Finish()
 
-	return series, nil
+	return s.store.Browse(ctx, params)
 }
 
 func (s *SeriesCache) UpdateInit(ctx context.Context, params internal.UpdateInitSeriesParams) (internal.Series, error) {
@@ -239,23 +120,7 @@ func (s *SeriesCache) UpdateInit(ctx context.Context, params internal.UpdateInit
 		return internal.Series{}, internal.WrapErrorf(err, internal.ErrUnknown, "store.UpdateInit")
 	}
 
-	cacheKey := fmt.Sprintf("v1:series:%s:%s", series.Provider, series.Slug)
-
-	s.logger.Debugj(map[string]interface{}{
-		"_source": "SeriesCache.UpdateInit",
-		"_msg":    "set cache",
-		"key":     cacheKey,
-		"value":   series,
-	})
-
-	err = s.setSeries(ctx, cacheKey, series)
-	if err != nil {
-		return internal.Series{}, internal.WrapErrorf(err, internal.ErrUnknown, "setSeries")
-	}
-
-	_ = s.deleteManySeries(ctx, fmt.Sprintf("v1:series:%s:_list:*", series.Provider))
-
-	return series, nil
+	return s.setSeries(ctx, "SeriesCache.UpdateInit", series)
 }
 
 func (s *SeriesCache) UpdateLatest(ctx context.Context, params internal.UpdateLatestSeriesParams) (internal.Series, error) {
@@ -266,29 +131,24 @@ func (s *SeriesCache) UpdateLatest(ctx context.Context, params internal.UpdateLa
 		return internal.Series{}, internal.WrapErrorf(err, internal.ErrUnknown, "store.UpdateLatest")
 	}
 
-	cacheKey := fmt.Sprintf("v1:series:%s:%s", series.Provider, series.Slug)
+	return s.setSeries(ctx, "SeriesCache.UpdateLatest", series)
+}
 
-	s.logger.Debugj(map[string]interface{}{
-		"_source": "SeriesCache.UpdateLatest",
-		"_msg":    "set cache",
-		"key":     cacheKey,
-		"value":   series,
-	})
+func (s *SeriesCache) SyncChapters(ctx context.Context, params internal.FindSeriesParams) (internal.Series, error) {
+	defer newSentrySpan(ctx, "SeriesCache.SyncChapters").Finish()
 
-	err = s.setSeries(ctx, cacheKey, series)
+	series, err := s.store.SyncChapters(ctx, params)
 	if err != nil {
-		return internal.Series{}, internal.WrapErrorf(err, internal.ErrUnknown, "setSeries")
+		return internal.Series{}, internal.WrapErrorf(err, internal.ErrUnknown, "store.SyncChapters")
 	}
 
-	_ = s.deleteManySeries(ctx, fmt.Sprintf("v1:series:%s:_list:*", series.Provider))
-
-	return series, nil
+	return s.setSeries(ctx, "SeriesCache.SyncChapters", series)
 }
 
 func (s *SeriesCache) Delete(ctx context.Context, params internal.FindSeriesParams) error {
 	defer newSentrySpan(ctx, "SeriesCache.Delete").Finish()
 
-	cacheKey := fmt.Sprintf("v1:series:%s:%s", params.Provider, params.Slug)
+	cacheKey := seriesKey(params.Provider, params.Slug)
 
 	s.logger.Debugj(map[string]interface{}{
 		"_source": "SeriesCache.Delete",
@@ -296,8 +156,29 @@ func (s *SeriesCache) Delete(ctx context.Context, params internal.FindSeriesPara
 		"key":     cacheKey,
 	})
 
-	_ = s.deleteSeries(ctx, cacheKey)
-	_ = s.deleteManySeries(ctx, fmt.Sprintf("v1:series:%s:_list:*", params.Provider))
+	_ = s.delete(ctx, cacheKey, bcKey(cacheKey))
+	_ = s.invalidate(ctx, seriesListNamespace(params.Provider))
 
 	return s.store.Delete(ctx, params)
 }
+
+// setSeries caches the written series and outdates the series lists of its provider
+func (s *SeriesCache) setSeries(ctx context.Context, method string, series internal.Series) (internal.Series, error) {
+	cacheKey := seriesKey(series.Provider, series.Slug)
+
+	s.logger.Debugj(map[string]interface{}{
+		"_source": method,
+		"_msg":    "set cache",
+		"key":     cacheKey,
+		"value":   series,
+	})
+
+	if err := put(ctx, s.cacheClient, cacheKey, series); err != nil {
+		return internal.Series{}, internal.WrapErrorf(err, internal.ErrUnknown, "put")
+	}
+
+	_ = s.delete(ctx, bcKey(cacheKey))
+	_ = s.invalidate(ctx, seriesListNamespace(series.Provider))
+
+	return series, nil
+}
@@ -0,0 +1,313 @@
+package elasticsearch
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"fourleaves.studio/manga-scraper/internal"
+	opensearchapi "github.com/opensearch-project/opensearch-go/v2/opensearchapi"
+)
+
+// versionedIndexPrefix prefixes the indices a reindex builds, they sit outside the series-* searches
+// until the alias of the provider points at them
+const versionedIndexPrefix = "series_"
+
+func versionedIndex(provider string, version time.Time) string {
+	return versionedIndexPrefix + provider + "_" + strconv.FormatInt(version.UnixMilli(), 10)
+}
+
+// CreateIndex creates an empty versioned index for the provider, the series template applies to it
+func (s *SeriesSearchRepository) CreateIndex(ctx context.Context, provider string) (string, error) {
+	defer newSentrySpan(ctx, "SeriesSearchRepository.CreateIndex").Finish()
+
+	index := versionedIndex(provider, time.Now())
+
+	req := opensearchapi.IndicesCreateRequest{
+		Index: index,
+	}
+
+	if err := s.perform(ctx, req, "IndicesCreateRequest.Do", nil); err != nil {
+		return "", err
+	}
+
+	return index, nil
+}
+
+// BulkIndex writes the series into the index in one _bulk request, documents are searchable after Count refreshes the index
+func (s *SeriesSearchRepository) BulkIndex(ctx context.Context, index string, series []internal.Series) error {
+	defer newSentrySpan(ctx, "SeriesSearchRepository.BulkIndex").Finish()
+
+	var buf bytes.Buffer
+
+	encoder := json.NewEncoder(&buf)
+
+	for i := range series {
+		action := map[string]interface{}{
+			"index": map[string]interface{}{"_id": series[i].Slug},
+		}
+
+		if err := encoder.Encode(action); err != nil {
+			return internal.WrapErrorf(err, internal.ErrUnknown, "json.NewEncoder.Encode")
+		}
+
+		if err := encoder.Encode(series[i]); err != nil {
+			return internal.WrapErrorf(err, internal.ErrUnknown, "json.NewEncoder.Encode")
+		}
+	}
+
+	return s.bulk(ctx, index, &buf)
+}
+
+// BulkDelete removes the series documents from the index in one _bulk request, missing documents count as deleted
+func (s *SeriesSearchRepository) BulkDelete(ctx context.Context, index string, slugs []string) error {
+	defer newSentrySpan(ctx, "SeriesSearchRepository.BulkDelete").Finish()
+
+	var buf bytes.Buffer
+
+	encoder := json.NewEncoder(&buf)
+
+	for _, slug := range slugs {
+		action := map[string]interface{}{
+			"delete": map[string]interface{}{"_id": slug},
+		}
+
+		if err := encoder.Encode(action); err != nil {
+			return internal.WrapErrorf(err, internal.ErrUnknown, "json.NewEncoder.Encode")
+		}
+	}
+
+	return s.bulk(ctx, index, &buf)
+}
+
+// bulk sends the actions in body as one _bulk request to the index and fails on the first failed document
+func (s *SeriesSearchRepository) bulk(ctx context.Context, index string, body io.Reader) error {
+	req := opensearchapi.BulkRequest{
+		Index: index,
+		Body:  body,
+	}
+
+	var result struct {
+		Errors bool `json:"errors"`
+		Items  []map[string]struct {
+			ID    string `json:"_id"`
+			Error struct {
+				Reason string `json:"reason"`
+			} `json:"error"`
+		} `json:"items"`
+	}
+
+	if err := s.perform(ctx, req, "BulkRequest.Do", &result); err != nil {
+		return err
+	}
+
+	if !result.Errors {
+		return nil
+	}
+
+	// a bulk request succeeds as a whole even when some documents fail
+	for _, item := range result.Items {
+		for _, op := range item {
+			if op.Error.Reason != "" {
+				return internal.NewErrorf(internal.ErrUnknown, "BulkRequest.Do %s: %s", op.ID, op.Error.Reason)
+			}
+		}
+	}
+
+	return internal.NewErrorf(internal.ErrUnknown, "BulkRequest.Do failed")
+}
+
+// Count refreshes the index and returns how many documents it holds
+func (s *SeriesSearchRepository) Count(ctx context.Context, index string) (int, error) {
+	defer newSentrySpan(ctx, "SeriesSearchRepository.Count").Finish()
+
+	refresh := opensearchapi.IndicesRefreshRequest{
+		Index: []string{index},
+	}
+
+	if err := s.perform(ctx, refresh, "IndicesRefreshRequest.Do", nil); err != nil {
+		return 0, err
+	}
+
+	req := opensearchapi.CountRequest{
+		Index: []string{index},
+	}
+
+	var result struct {
+		Count int `json:"count"`
+	}
+
+	if err := s.perform(ctx, req, "CountRequest.Do", &result); err != nil {
+		return 0, err
+	}
+
+	return result.Count, nil
+}
+
+// SwapAlias points the provider alias at the index in one atomic update and deletes the indices it pointed at before.
+// An index named like the alias predates versioned indices and is removed in the same update
+func (s *SeriesSearchRepository) SwapAlias(ctx context.Context, provider, index string) error {
+	defer newSentrySpan(ctx, "SeriesSearchRepository.SwapAlias").Finish()
+
+	alias := seriesIndex(provider)
+
+	previous, err := s.aliasIndices(ctx, alias)
+	if err != nil {
+		return err
+	}
+
+	actions := []interface{}{
+		map[string]interface{}{
+			"add": map[string]interface{}{"index": index, "alias": alias},
+		},
+	}
+
+	if previous == nil {
+		exists, err := s.indexExists(ctx, alias)
+		if err != nil {
+			return err
+		}
+
+		if exists {
+			actions = append(actions, map[string]interface{}{
+				"remove_index": map[string]interface{}{"index": alias},
+			})
+		}
+	}
+
+	var stale []string
+
+	for _, name := range previous {
+		if name == index {
+			continue
+		}
+
+		stale = append(stale, name)
+		actions = append(actions, map[string]interface{}{
+			"remove": map[string]interface{}{"index": name, "alias": alias},
+		})
+	}
+
+	var buf bytes.Buffer
+
+	if err := json.NewEncoder(&buf).Encode(map[string]interface{}{"actions": actions}); err != nil {
+		return internal.WrapErrorf(err, internal.ErrUnknown, "json.NewEncoder.Encode")
+	}
+
+	req := opensearchapi.IndicesUpdateAliasesRequest{
+		Body: &buf,
+	}
+
+	if err := s.perform(ctx, req, "IndicesUpdateAliasesRequest.Do", nil); err != nil {
+		return err
+	}
+
+	if len(stale) == 0 {
+		return nil
+	}
+
+	return s.DeleteIndex(ctx, stale...)
+}
+
+// DeleteIndex deletes the indices, indices that do not exist are skipped
+func (s *SeriesSearchRepository) DeleteIndex(ctx context.Context, indices ...string) error {
+	defer newSentrySpan(ctx, "SeriesSearchRepository.DeleteIndex").Finish()
+
+	ignoreUnavailable := true
+
+	req := opensearchapi.IndicesDeleteRequest{
+		Index:             indices,
+		IgnoreUnavailable: &ignoreUnavailable,
+	}
+
+	return s.perform(ctx, req, "IndicesDeleteRequest.Do", nil)
+}
+
+// aliasIndices returns the indices the alias points at, nil when the alias does not exist
+func (s *SeriesSearchRepository) aliasIndices(ctx context.Context, alias string) ([]string, error) {
+	req := opensearchapi.IndicesGetAliasRequest{
+		Name: []string{alias},
+	}
+
+	resp, err := req.Do(ctx, s.client)
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrUnknown, "IndicesGetAliasRequest.Do")
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		return nil, nil
+	}
+
+	if resp.IsError() {
+		return nil, internal.NewErrorf(internal.ErrUnknown, "IndicesGetAliasRequest.Do %d", resp.StatusCode)
+	}
+
+	var result map[string]interface{}
+
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrUnknown, "json.NewDecoder.Decode")
+	}
+
+	indices := make([]string, 0, len(result))
+	for name := range result {
+		indices = append(indices, name)
+	}
+
+	return indices, nil
+}
+
+func (s *SeriesSearchRepository) indexExists(ctx context.Context, index string) (bool, error) {
+	req := opensearchapi.IndicesExistsRequest{
+		Index: []string{index},
+	}
+
+	resp, err := req.Do(ctx, s.client)
+	if err != nil {
+		return false, internal.WrapErrorf(err, internal.ErrUnknown, "IndicesExistsRequest.Do")
+	}
+
+	defer resp.Body.Close()
+
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return true, nil
+	case http.StatusNotFound:
+		return false, nil
+	default:
+		return false, internal.NewErrorf(internal.ErrUnknown, "IndicesExistsRequest.Do %d", resp.StatusCode)
+	}
+}
+
+// perform runs the request and decodes the response into out, the response is discarded when out is nil
+func (s *SeriesSearchRepository) perform(ctx context.Context, req opensearchapi.Request, name string, out interface{}) error {
+	resp, err := req.Do(ctx, s.client)
+	if err != nil {
+		return internal.WrapErrorf(err, internal.ErrUnknown, name)
+	}
+
+	defer resp.Body.Close()
+
+	if resp.IsError() {
+		return internal.NewErrorf(internal.ErrUnknown, "%s %d", name, resp.StatusCode)
+	}
+
+	if out == nil {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		return nil
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return internal.WrapErrorf(err, internal.ErrUnknown, "json.NewDecoder.Decode")
+	}
+
+	return nil
+}
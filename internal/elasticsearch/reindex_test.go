@@ -0,0 +1,200 @@
+package elasticsearch
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"fourleaves.studio/manga-scraper/internal"
+)
+
+func TestSeriesSearchRepository_BulkIndex(t *testing.T) {
+	tests := []struct {
+		name     string
+		response string
+		wantErr  bool
+	}{
+		{"Indexed", `{"errors":false,"items":[{"index":{"_id":"a","status":201}},{"index":{"_id":"b","status":201}}]}`, false},
+		{"Failed document", `{"errors":true,"items":[{"index":{"_id":"a","status":201}},{"index":{"_id":"b","status":400,"error":{"reason":"mapper_parsing_exception"}}}]}`, true},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			var lines []string
+
+			repo := newTestRepository(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/series_asura_1/_bulk" {
+					t.Errorf("unexpected path %s", r.URL.Path)
+				}
+
+				scanner := bufio.NewScanner(r.Body)
+				for scanner.Scan() {
+					lines = append(lines, scanner.Text())
+				}
+
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(tc.response))
+			})
+
+			err := repo.BulkIndex(context.Background(), "series_asura_1", []internal.Series{
+				{Provider: "asura", Slug: "a", Title: "A"},
+				{Provider: "asura", Slug: "b", Title: "B"},
+			})
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("expected error: %v, got: %v", tc.wantErr, err)
+			}
+
+			if len(lines) != 4 || lines[0] != `{"index":{"_id":"a"}}` {
+				t.Errorf("unexpected bulk body %v", lines)
+			}
+		})
+	}
+}
+
+func TestSeriesSearchRepository_BulkDelete(t *testing.T) {
+	tests := []struct {
+		name     string
+		response string
+		wantErr  bool
+	}{
+		{"Deleted", `{"errors":false,"items":[{"delete":{"_id":"a","status":200,"result":"deleted"}},{"delete":{"_id":"b","status":404,"result":"not_found"}}]}`, false},
+		{"Failed document", `{"errors":true,"items":[{"delete":{"_id":"a","status":429,"error":{"reason":"es_rejected_execution_exception"}}}]}`, true},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			var lines []string
+
+			repo := newTestRepository(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/series_asura_1/_bulk" {
+					t.Errorf("unexpected path %s", r.URL.Path)
+				}
+
+				scanner := bufio.NewScanner(r.Body)
+				for scanner.Scan() {
+					lines = append(lines, scanner.Text())
+				}
+
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(tc.response))
+			})
+
+			err := repo.BulkDelete(context.Background(), "series_asura_1", []string{"a", "b"})
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("expected error: %v, got: %v", tc.wantErr, err)
+			}
+
+			if len(lines) != 2 || lines[0] != `{"delete":{"_id":"a"}}` {
+				t.Errorf("unexpected bulk body %v", lines)
+			}
+		})
+	}
+}
+
+func TestSeriesSearchRepository_SwapAlias(t *testing.T) {
+	tests := []struct {
+		name        string
+		aliases     string
+		legacy      bool
+		wantActions []string
+		wantDeleted string
+	}{
+		{
+			name:        "Previous version",
+			aliases:     `{"series_asura_1":{"aliases":{"series-asura":{}}}}`,
+			wantActions: []string{"add series_asura_2", "remove series_asura_1"},
+			wantDeleted: "/series_asura_1",
+		},
+		{
+			name:        "Legacy index",
+			legacy:      true,
+			wantActions: []string{"add series_asura_2", "remove_index series-asura"},
+		},
+		{
+			name:        "First index",
+			wantActions: []string{"add series_asura_2"},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			var (
+				mu      sync.Mutex
+				actions []string
+				deleted string
+			)
+
+			repo := newTestRepository(t, func(w http.ResponseWriter, r *http.Request) {
+				mu.Lock()
+				defer mu.Unlock()
+
+				w.Header().Set("Content-Type", "application/json")
+
+				switch {
+				case r.Method == http.MethodGet && r.URL.Path == "/_alias/series-asura":
+					if tc.aliases == "" {
+						w.WriteHeader(http.StatusNotFound)
+						_, _ = w.Write([]byte(`{}`))
+						return
+					}
+					_, _ = w.Write([]byte(tc.aliases))
+				case r.Method == http.MethodHead && r.URL.Path == "/series-asura":
+					if !tc.legacy {
+						w.WriteHeader(http.StatusNotFound)
+					}
+				case r.Method == http.MethodPost && r.URL.Path == "/_aliases":
+					body, _ := io.ReadAll(r.Body)
+
+					var update struct {
+						Actions []map[string]map[string]string `json:"actions"`
+					}
+					if err := json.Unmarshal(body, &update); err != nil {
+						t.Errorf("failed to decode actions: %v", err)
+					}
+
+					for _, action := range update.Actions {
+						for name, args := range action {
+							actions = append(actions, name+" "+args["index"])
+						}
+					}
+					_, _ = w.Write([]byte(`{"acknowledged":true}`))
+				case r.Method == http.MethodDelete:
+					deleted = r.URL.Path
+					_, _ = w.Write([]byte(`{"acknowledged":true}`))
+				default:
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+				}
+			})
+
+			if err := repo.SwapAlias(context.Background(), "asura", "series_asura_2"); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if !reflect.DeepEqual(actions, tc.wantActions) {
+				t.Errorf("expected actions %v, got %v", tc.wantActions, actions)
+			}
+
+			if deleted != tc.wantDeleted {
+				t.Errorf("expected deleted %q, got %q", tc.wantDeleted, deleted)
+			}
+		})
+	}
+}
+
+func TestVersionedIndex(t *testing.T) {
+	index := versionedIndex("asura", time.UnixMilli(1720000000000))
+
+	if index != "series_asura_1720000000000" || strings.HasPrefix(index, seriesIndexPrefix) {
+		t.Errorf("unexpected index %s", index)
+	}
+}
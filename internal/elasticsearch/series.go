@@ -4,8 +4,9 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
-	"fmt"
 	"io"
+	"net/http"
+	"strings"
 
 	"fourleaves.studio/manga-scraper/internal"
 	"github.com/getsentry/sentry-go"
@@ -33,7 +34,7 @@ func (s *SeriesSearchRepository) Index(ctx context.Context, series internal.Seri
 	}
 
 	req := opensearchapi.IndexRequest{
-		Index:      series.Provider,
+		Index:      seriesIndex(series.Provider),
 		Body:       &buf,
 		DocumentID: series.Slug,
 		Refresh:    "true",
@@ -55,11 +56,12 @@ func (s *SeriesSearchRepository) Index(ctx context.Context, series internal.Seri
 	return nil
 }
 
+// Delete removes the series document, a document that does not exist counts as deleted
 func (s *SeriesSearchRepository) Delete(ctx context.Context, provider, slug string) error {
 	defer newSentrySpan(ctx, "SeriesSearchRepository.Delete").Finish()
 
 	req := opensearchapi.DeleteRequest{
-		Index:      provider,
+		Index:      seriesIndex(provider),
 		DocumentID: slug,
 	}
 
@@ -70,6 +72,12 @@ func (s *SeriesSearchRepository) Delete(ctx context.Context, provider, slug stri
 
 	defer resp.Body.Close()
 
+	// the document or the whole index is already gone
+	if resp.StatusCode == http.StatusNotFound {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		return nil
+	}
+
 	if resp.IsError() {
 		return internal.NewErrorf(internal.ErrUnknown, "DeleteRequest.Do %d", resp.StatusCode)
 	}
@@ -79,67 +87,256 @@ func (s *SeriesSearchRepository) Delete(ctx context.Context, provider, slug stri
 	return nil
 }
 
-func (s *SeriesSearchRepository) Search(ctx context.Context, q string) ([]internal.Series, error) {
+// Search matches the query against the series of every provider, partly typed title words match through title.ngram.
+// A query without hits gets a spelling correction from the indexed titles instead of an error
+func (s *SeriesSearchRepository) Search(ctx context.Context, params internal.SearchSeriesParams) (internal.SeriesSearchResult, error) {
 	defer newSentrySpan(ctx, "SeriesSearchRepository.Search").Finish()
 
-	var buf bytes.Buffer
+	var hits struct {
+		Hits struct {
+			Total struct {
+				Value int64 `json:"value"`
+			} `json:"total"`
+			Hits []struct {
+				Source    internal.Series     `json:"_source"`
+				Highlight map[string][]string `json:"highlight"`
+			} `json:"hits"`
+		} `json:"hits"`
+		Suggest struct {
+			DidYouMean []termSuggestion `json:"did_you_mean"`
+		} `json:"suggest"`
+	}
+
+	if err := s.search(ctx, newSearchQuery(params), &hits); err != nil {
+		return internal.SeriesSearchResult{}, err
+	}
+
+	result := internal.SeriesSearchResult{
+		Hits:  make([]internal.SeriesSearchHit, len(hits.Hits.Hits)),
+		Total: int(hits.Hits.Total.Value),
+	}
+
+	for i, hit := range hits.Hits.Hits {
+		result.Hits[i] = internal.SeriesSearchHit{
+			Series:     hit.Source,
+			Highlights: newHighlights(hit.Highlight),
+		}
+	}
+
+	if result.Total == 0 {
+		result.DidYouMean = newDidYouMean(params.Query, hits.Suggest.DidYouMean)
+	}
+
+	return result, nil
+}
+
+// Suggest completes the query against the titles of every provider, tolerating a typo or two once a character is typed.
+// It reads the in-memory completion structure of title.suggest, fast enough to be called on every keystroke
+func (s *SeriesSearchRepository) Suggest(ctx context.Context, params internal.SuggestSeriesParams) ([]internal.SeriesSuggestion, error) {
+	defer newSentrySpan(ctx, "SeriesSearchRepository.Suggest").Finish()
 
 	query := map[string]interface{}{
-		"query": map[string]interface{}{
-			"multi_match": map[string]interface{}{
-				"query":  q,
-				"fields": []string{"title", "synopsis", "genres"},
+		"_source": []string{"provider", "slug", "title", "coverURL"},
+		"suggest": map[string]interface{}{
+			"titles": map[string]interface{}{
+				"prefix": params.Query,
+				"completion": map[string]interface{}{
+					"field":           "title.suggest",
+					"size":            params.Size,
+					"skip_duplicates": true,
+					"fuzzy": map[string]interface{}{
+						"fuzziness":     "AUTO",
+						"prefix_length": 1,
+					},
+				},
 			},
 		},
 	}
 
+	var hits struct {
+		Suggest struct {
+			Titles []struct {
+				Options []struct {
+					Source internal.Series `json:"_source"`
+				} `json:"options"`
+			} `json:"titles"`
+		} `json:"suggest"`
+	}
+
+	if err := s.search(ctx, query, &hits); err != nil {
+		return nil, err
+	}
+
+	result := []internal.SeriesSuggestion{}
+
+	for _, entry := range hits.Suggest.Titles {
+		for _, option := range entry.Options {
+			result = append(result, internal.SeriesSuggestion{
+				Provider: option.Source.Provider,
+				Slug:     option.Source.Slug,
+				Title:    option.Source.Title,
+				CoverURL: option.Source.CoverURL,
+			})
+		}
+	}
+
+	return result, nil
+}
+
+// search runs the query against the series indices of every provider and decodes the response into out
+func (s *SeriesSearchRepository) search(ctx context.Context, query map[string]interface{}, out interface{}) error {
+	var buf bytes.Buffer
+
 	if err := json.NewEncoder(&buf).Encode(query); err != nil {
-		fmt.Println(err)
-		return nil, internal.WrapErrorf(err, internal.ErrUnknown, "json.NewEncoder.Encode")
+		return internal.WrapErrorf(err, internal.ErrUnknown, "json.NewEncoder.Encode")
 	}
 
+	allowNoIndices := true
+	ignoreUnavailable := true
+
 	req := opensearchapi.SearchRequest{
-		Index: []string{},
-		Body:  &buf,
+		Index:             []string{seriesIndexPrefix + "*"},
+		Body:              &buf,
+		AllowNoIndices:    &allowNoIndices,
+		IgnoreUnavailable: &ignoreUnavailable,
 	}
 
 	resp, err := req.Do(ctx, s.client)
 	if err != nil {
-		return nil, internal.WrapErrorf(err, internal.ErrUnknown, "SearchRequest.Do")
+		return internal.WrapErrorf(err, internal.ErrUnknown, "SearchRequest.Do")
 	}
 
 	defer resp.Body.Close()
 
 	if resp.IsError() {
-		return nil, internal.NewErrorf(internal.ErrUnknown, "SearchRequest.Do %d", resp.StatusCode)
+		return internal.NewErrorf(internal.ErrUnknown, "SearchRequest.Do %d", resp.StatusCode)
 	}
 
-	var hits struct {
-		Hits struct {
-			Total struct {
-				Value int64 `json:"value"`
-			} `json:"total"`
-			Hits []struct {
-				Source internal.Series `json:"_source"`
-			} `json:"hits"`
-		} `json:"hits"`
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return internal.WrapErrorf(err, internal.ErrUnknown, "json.NewDecoder.Decode")
 	}
 
-	if err := json.NewDecoder(resp.Body).Decode(&hits); err != nil {
-		return nil, internal.WrapErrorf(err, internal.ErrUnknown, "json.NewDecoder.Decode")
+	return nil
+}
+
+// termSuggestion is a word of the query with its closest indexed words, best first
+type termSuggestion struct {
+	Text    string `json:"text"`
+	Options []struct {
+		Text string `json:"text"`
+	} `json:"options"`
+}
+
+// newDidYouMean replaces every misspelled word of the query with its best correction,
+// empty when no word has a correction
+func newDidYouMean(query string, suggestions []termSuggestion) string {
+	corrected := false
+	words := make([]string, 0, len(suggestions))
+
+	for _, suggestion := range suggestions {
+		if len(suggestion.Options) == 0 {
+			words = append(words, suggestion.Text)
+			continue
+		}
+
+		words = append(words, suggestion.Options[0].Text)
+		corrected = true
 	}
 
-	if len(hits.Hits.Hits) == 0 {
-		return nil, internal.NewErrorf(internal.ErrNotFound, "no results found")
+	if !corrected {
+		return ""
 	}
 
-	result := make([]internal.Series, len(hits.Hits.Hits))
+	didYouMean := strings.Join(words, " ")
+	if strings.EqualFold(didYouMean, strings.TrimSpace(query)) {
+		return ""
+	}
 
-	for i, hit := range hits.Hits.Hits {
-		result[i] = hit.Source
+	return didYouMean
+}
+
+func newSearchQuery(params internal.SearchSeriesParams) map[string]interface{} {
+	filters := []interface{}{}
+
+	if params.Provider != "" {
+		filters = append(filters, map[string]interface{}{
+			"term": map[string]interface{}{"provider": params.Provider},
+		})
 	}
 
-	return result, nil
+	// genres are normalized by the mapping, so the terms match regardless of case
+	for _, genre := range params.Genres {
+		filters = append(filters, map[string]interface{}{
+			"term": map[string]interface{}{"genres": genre},
+		})
+	}
+
+	if params.Status != "" {
+		filters = append(filters, map[string]interface{}{
+			"term": map[string]interface{}{"status": string(params.Status)},
+		})
+	}
+
+	return map[string]interface{}{
+		"from":             params.From,
+		"size":             params.Size,
+		"track_total_hits": true,
+		"query": map[string]interface{}{
+			"bool": map[string]interface{}{
+				"must": map[string]interface{}{
+					"multi_match": map[string]interface{}{
+						"query":    params.Query,
+						"type":     "best_fields",
+						"operator": "and",
+						"fields":   []string{"title^3", "title.ngram^2", "synopsis", "genres", "author", "artist"},
+					},
+				},
+				"filter": filters,
+			},
+		},
+		"suggest": map[string]interface{}{
+			"did_you_mean": map[string]interface{}{
+				"text": params.Query,
+				"term": map[string]interface{}{
+					"field":        "title",
+					"suggest_mode": "missing",
+					"sort":         "frequency",
+				},
+			},
+		},
+		"highlight": map[string]interface{}{
+			"pre_tags":  []string{"<em>"},
+			"post_tags": []string{"</em>"},
+			"fields": map[string]interface{}{
+				"title":       map[string]interface{}{"number_of_fragments": 0},
+				"title.ngram": map[string]interface{}{"number_of_fragments": 0},
+				"synopsis":    map[string]interface{}{"fragment_size": 150, "number_of_fragments": 2},
+			},
+		},
+	}
+}
+
+// newHighlights keys the snippets by the series field, title.ngram only fills in when title itself did not match
+func newHighlights(highlight map[string][]string) map[string][]string {
+	if len(highlight) == 0 {
+		return nil
+	}
+
+	result := make(map[string][]string, len(highlight))
+
+	for field, snippets := range highlight {
+		if field == "title.ngram" {
+			continue
+		}
+
+		result[field] = snippets
+	}
+
+	if _, ok := result["title"]; !ok && len(highlight["title.ngram"]) > 0 {
+		result["title"] = highlight["title.ngram"]
+	}
+
+	return result
 }
 
 func newSentrySpan(ctx context.Context, operation string) *sentry.Span {
@@ -0,0 +1,194 @@
+package elasticsearch
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"fourleaves.studio/manga-scraper/internal"
+	"github.com/opensearch-project/opensearch-go/v2"
+)
+
+func newTestRepository(t *testing.T, handler http.HandlerFunc) *SeriesSearchRepository {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	client, err := opensearch.NewClient(opensearch.Config{Addresses: []string{server.URL}})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	return NewSeriesSearchRepository(client)
+}
+
+func TestSeriesSearchRepository_Search(t *testing.T) {
+	var body map[string]interface{}
+
+	repo := newTestRepository(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/series-*/_search" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("failed to decode body: %v", err)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"hits":{"total":{"value":42},"hits":[{
+			"_source":{"provider":"asura","slug":"solo-leveling","title":"Solo Leveling"},
+			"highlight":{"title.ngram":["<em>Solo</em> <em>Leveling</em>"],"synopsis":["a <em>solo</em> hunter"]}
+		}]}}`))
+	})
+
+	result, err := repo.Search(context.Background(), internal.SearchSeriesParams{
+		Query:    "solo lev",
+		Provider: "asura",
+		Genres:   []string{"Action"},
+		Status:   internal.OngoingSeriesStatus,
+		From:     20,
+		Size:     10,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result.Total != 42 || len(result.Hits) != 1 || result.Hits[0].Slug != "solo-leveling" {
+		t.Errorf("unexpected result %+v", result)
+	}
+
+	want := map[string][]string{
+		"title":    {"<em>Solo</em> <em>Leveling</em>"},
+		"synopsis": {"a <em>solo</em> hunter"},
+	}
+	if !reflect.DeepEqual(result.Hits[0].Highlights, want) {
+		t.Errorf("expected highlights %v, got %v", want, result.Hits[0].Highlights)
+	}
+
+	if body["from"] != float64(20) || body["size"] != float64(10) {
+		t.Errorf("unexpected paging from %v size %v", body["from"], body["size"])
+	}
+
+	filters := body["query"].(map[string]interface{})["bool"].(map[string]interface{})["filter"].([]interface{})
+	if len(filters) != 3 {
+		t.Errorf("expected 3 filters, got %v", filters)
+	}
+}
+
+func TestSeriesSearchRepository_Search_DidYouMean(t *testing.T) {
+	repo := newTestRepository(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"hits":{"total":{"value":0},"hits":[]},"suggest":{"did_you_mean":[
+			{"text":"slo","options":[{"text":"solo"}]},
+			{"text":"leveling","options":[]}
+		]}}`))
+	})
+
+	result, err := repo.Search(context.Background(), internal.SearchSeriesParams{Query: "slo leveling", Size: 10})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(result.Hits) != 0 || result.DidYouMean != "solo leveling" {
+		t.Errorf("unexpected result %+v", result)
+	}
+}
+
+func TestSeriesSearchRepository_Suggest(t *testing.T) {
+	var body map[string]interface{}
+
+	repo := newTestRepository(t, func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("failed to decode body: %v", err)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"suggest":{"titles":[{"text":"sol","options":[
+			{"text":"Solo Leveling","_source":{"provider":"asura","slug":"solo-leveling","title":"Solo Leveling","coverURL":"https://example.com/1.jpg"}},
+			{"text":"Solo Max-Level Newbie","_source":{"provider":"flame","slug":"solo-max-level-newbie","title":"Solo Max-Level Newbie"}}
+		]}]}}`))
+	})
+
+	result, err := repo.Suggest(context.Background(), internal.SuggestSeriesParams{Query: "sol", Size: 5})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []internal.SeriesSuggestion{
+		{Provider: "asura", Slug: "solo-leveling", Title: "Solo Leveling", CoverURL: "https://example.com/1.jpg"},
+		{Provider: "flame", Slug: "solo-max-level-newbie", Title: "Solo Max-Level Newbie"},
+	}
+	if !reflect.DeepEqual(result, want) {
+		t.Errorf("expected %v, got %v", want, result)
+	}
+
+	completion := body["suggest"].(map[string]interface{})["titles"].(map[string]interface{})["completion"].(map[string]interface{})
+	if completion["size"] != float64(5) || completion["fuzzy"] == nil {
+		t.Errorf("unexpected completion %v", completion)
+	}
+}
+
+func TestNewDidYouMean(t *testing.T) {
+	tests := []struct {
+		name        string
+		query       string
+		suggestions string
+		want        string
+	}{
+		{"Corrected", "slo levling", `[{"text":"slo","options":[{"text":"solo"}]},{"text":"levling","options":[{"text":"leveling"}]}]`, "solo leveling"},
+		{"Nothing to correct", "solo", `[{"text":"solo","options":[]}]`, ""},
+		{"No suggestions", "solo", `[]`, ""},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var suggestions []termSuggestion
+			if err := json.Unmarshal([]byte(tc.suggestions), &suggestions); err != nil {
+				t.Fatalf("failed to decode suggestions: %v", err)
+			}
+
+			if got := newDidYouMean(tc.query, suggestions); got != tc.want {
+				t.Errorf("expected %q, got %q", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestSeriesSearchRepository_Delete(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		wantErr bool
+	}{
+		{"Deleted", http.StatusOK, false},
+		{"Missing document", http.StatusNotFound, false},
+		{"Unavailable", http.StatusServiceUnavailable, true},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			repo := newTestRepository(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodDelete || r.URL.Path != "/series-asura/_doc/solo-leveling" {
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+				}
+
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tc.status)
+				_, _ = w.Write([]byte(`{}`))
+			})
+
+			err := repo.Delete(context.Background(), "asura", "solo-leveling")
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("expected error: %v, got: %v", tc.wantErr, err)
+			}
+		})
+	}
+}
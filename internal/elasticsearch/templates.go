@@ -0,0 +1,120 @@
+package elasticsearch
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+
+	"fourleaves.studio/manga-scraper/internal"
+	opensearchapi "github.com/opensearch-project/opensearch-go/v2/opensearchapi"
+)
+
+// seriesIndexPrefix prefixes the series index alias of every provider, searches read through the aliases.
+// Indices named after the provider alone predate the template, a reindex of the provider refills the new ones
+const seriesIndexPrefix = "series-"
+
+func seriesIndex(provider string) string {
+	return seriesIndexPrefix + provider
+}
+
+// seriesIndexTemplate folds accents and case for romanized titles, title.ngram is indexed with edge n-grams
+// so a partly typed word like "solo lev" still matches "Solo Leveling", title.suggest backs the autocompletion
+var seriesIndexTemplate = map[string]interface{}{
+	"index_patterns": []string{seriesIndexPrefix + "*", versionedIndexPrefix + "*"},
+	"template": map[string]interface{}{
+		"settings": map[string]interface{}{
+			"analysis": map[string]interface{}{
+				"filter": map[string]interface{}{
+					"title_edge_ngram": map[string]interface{}{
+						"type":     "edge_ngram",
+						"min_gram": 2,
+						"max_gram": 15,
+					},
+				},
+				"analyzer": map[string]interface{}{
+					"folding": map[string]interface{}{
+						"type":      "custom",
+						"tokenizer": "standard",
+						"filter":    []string{"lowercase", "asciifolding"},
+					},
+					"folding_edge_ngram": map[string]interface{}{
+						"type":      "custom",
+						"tokenizer": "standard",
+						"filter":    []string{"lowercase", "asciifolding", "title_edge_ngram"},
+					},
+				},
+				"normalizer": map[string]interface{}{
+					"folding": map[string]interface{}{
+						"type":   "custom",
+						"filter": []string{"lowercase", "asciifolding"},
+					},
+				},
+			},
+		},
+		"mappings": map[string]interface{}{
+			"properties": map[string]interface{}{
+				"provider": map[string]interface{}{"type": "keyword"},
+				"slug":     map[string]interface{}{"type": "keyword"},
+				"title": map[string]interface{}{
+					"type":     "text",
+					"analyzer": "folding",
+					"fields": map[string]interface{}{
+						"ngram": map[string]interface{}{
+							"type":            "text",
+							"analyzer":        "folding_edge_ngram",
+							"search_analyzer": "folding",
+						},
+						"keyword": map[string]interface{}{"type": "keyword"},
+						"suggest": map[string]interface{}{
+							"type":     "completion",
+							"analyzer": "folding",
+						},
+					},
+				},
+				"synopsis":        map[string]interface{}{"type": "text", "analyzer": "folding"},
+				"genres":          map[string]interface{}{"type": "keyword", "normalizer": "folding"},
+				"status":          map[string]interface{}{"type": "keyword"},
+				"type":            map[string]interface{}{"type": "keyword"},
+				"author":          map[string]interface{}{"type": "text", "analyzer": "folding"},
+				"artist":          map[string]interface{}{"type": "text", "analyzer": "folding"},
+				"releaseYear":     map[string]interface{}{"type": "integer"},
+				"chaptersCount":   map[string]interface{}{"type": "integer"},
+				"latestChapter":   map[string]interface{}{"type": "keyword"},
+				"latestChapterAt": map[string]interface{}{"type": "date"},
+				"updatedAt":       map[string]interface{}{"type": "date"},
+			},
+		},
+	},
+}
+
+// PutTemplates creates or updates the series index template, it only applies to indices created afterwards
+func (s *SeriesSearchRepository) PutTemplates(ctx context.Context) error {
+	defer newSentrySpan(ctx, "SeriesSearchRepository.PutTemplates").Finish()
+
+	var buf bytes.Buffer
+
+	if err := json.NewEncoder(&buf).Encode(seriesIndexTemplate); err != nil {
+		return internal.WrapErrorf(err, internal.ErrUnknown, "json.NewEncoder.Encode")
+	}
+
+	req := opensearchapi.IndicesPutIndexTemplateRequest{
+		Name: "series",
+		Body: &buf,
+	}
+
+	resp, err := req.Do(ctx, s.client)
+	if err != nil {
+		return internal.WrapErrorf(err, internal.ErrUnknown, "IndicesPutIndexTemplateRequest.Do")
+	}
+
+	defer resp.Body.Close()
+
+	if resp.IsError() {
+		return internal.NewErrorf(internal.ErrUnknown, "IndicesPutIndexTemplateRequest.Do %d", resp.StatusCode)
+	}
+
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	return nil
+}
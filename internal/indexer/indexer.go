@@ -0,0 +1,334 @@
+package indexer
+
+import (
+	"context"
+	"errors"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"go.uber.org/zap"
+
+	"fourleaves.studio/manga-scraper/internal"
+	"fourleaves.studio/manga-scraper/internal/backoff"
+)
+
+type ChangeRepository interface {
+	FindDue(ctx context.Context, settled time.Time, limit int) ([]internal.SeriesChange, error)
+	Claim(ctx context.Context, change internal.SeriesChange, until time.Time) (bool, error)
+	Complete(ctx context.Context, change internal.SeriesChange) (bool, error)
+	Retry(ctx context.Context, params internal.RetrySeriesChangeParams) error
+}
+
+type SeriesRepository interface {
+	Find(ctx context.Context, params internal.FindSeriesParams) (internal.Series, error)
+}
+
+type ReindexJobRepository interface {
+	FindActive(ctx context.Context, provider string, since time.Time) (internal.ReindexJob, error)
+}
+
+type SeriesSearchRepository interface {
+	Index(ctx context.Context, series internal.Series) error
+	Delete(ctx context.Context, provider, slug string) error
+}
+
+// RetryPolicy controls how long a change waits after a failed attempt,
+// changes are retried until the index accepts them
+type RetryPolicy struct {
+	BaseDelay time.Duration
+	MaxDelay  time.Duration
+}
+
+// Backoff returns the delay after the given failed attempt, doubling from BaseDelay up to MaxDelay
+func (p RetryPolicy) Backoff(attempt int) time.Duration {
+	return backoff.Exponential(p.BaseDelay, p.MaxDelay, attempt)
+}
+
+// Worker copies the series recorded in the change table into the search index,
+// a series that no longer exists in MySQL is deleted from the index
+type Worker struct {
+	changes      ChangeRepository
+	series       SeriesRepository
+	jobs         ReindexJobRepository
+	search       SeriesSearchRepository
+	logger       *zap.Logger
+	retry        RetryPolicy
+	pollInterval time.Duration
+	doneC        chan struct{}
+	closeC       chan struct{}
+}
+
+func NewWorker(
+	changes ChangeRepository,
+	series SeriesRepository,
+	jobs ReindexJobRepository,
+	search SeriesSearchRepository,
+	logger *zap.Logger,
+	retry RetryPolicy,
+	pollInterval time.Duration,
+) *Worker {
+	return &Worker{
+		changes:      changes,
+		series:       series,
+		jobs:         jobs,
+		search:       search,
+		logger:       logger,
+		retry:        retry,
+		pollInterval: pollInterval,
+		doneC:        make(chan struct{}),
+		closeC:       make(chan struct{}),
+	}
+}
+
+const (
+	// changeBatch is how many due changes are loaded per poll
+	changeBatch = 100
+	// changeSettleDelay is how long a change rests after it was recorded, so a burst of writes to the same series
+	// is indexed once
+	changeSettleDelay = 5 * time.Second
+	// reindexDeferDelay is how long a change of a provider being reindexed waits before it is applied again
+	reindexDeferDelay = 30 * time.Second
+	// claimTimeout keeps other workers off a change while it is being indexed
+	claimTimeout = time.Minute
+)
+
+func (w *Worker) StartServer() (<-chan error, error) {
+	errC := make(chan error, 1)
+
+	ctx, stop := signal.NotifyContext(context.Background(),
+		os.Interrupt,
+		syscall.SIGTERM,
+		syscall.SIGQUIT,
+	)
+
+	go func() {
+		<-ctx.Done()
+
+		w.logger.Info("Shutdown signal received")
+
+		ctxTimeout, cancel := context.WithTimeout(context.Background(), claimTimeout)
+
+		defer func() {
+			_ = w.logger.Sync()
+
+			stop()
+			cancel()
+			close(errC)
+		}()
+
+		if err := w.Shutdown(ctxTimeout); err != nil {
+			errC <- err
+		}
+
+		w.logger.Info("Shutdown completed")
+	}()
+
+	go func() {
+		w.logger.Info("Listening and serving")
+
+		if err := w.ListenAndServe(); err != nil {
+			errC <- err
+		}
+	}()
+
+	return errC, nil
+}
+
+func (w *Worker) ListenAndServe() error {
+	go func() {
+		w.poll()
+
+		w.logger.Info("No more changes to index. Exiting.")
+
+		w.doneC <- struct{}{}
+	}()
+
+	return nil
+}
+
+func (w *Worker) Shutdown(ctx context.Context) error {
+	w.logger.Info("Shutting down server")
+
+	close(w.closeC)
+
+	select {
+	case <-ctx.Done():
+		return internal.WrapErrorf(ctx.Err(), internal.ErrUnknown, "Context done")
+	case <-w.doneC:
+		return nil
+	}
+}
+
+// poll indexes the due changes every pollInterval, until a batch comes back short
+func (w *Worker) poll() {
+	ticker := time.NewTicker(w.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-w.closeC:
+			return
+		case <-ticker.C:
+			for w.indexDue() == changeBatch {
+				select {
+				case <-w.closeC:
+					return
+				default:
+				}
+			}
+		}
+	}
+}
+
+func (w *Worker) indexDue() int {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	changes, err := w.changes.FindDue(ctx, time.Now().Add(-changeSettleDelay), changeBatch)
+	if err != nil {
+		w.logger.Error("failed to find due series changes", zap.Error(err))
+		return 0
+	}
+
+	for _, change := range changes {
+		w.attempt(change)
+	}
+
+	return len(changes)
+}
+
+// attempt claims the change and indexes it
+func (w *Worker) attempt(change internal.SeriesChange) {
+	ctx, cancel := context.WithTimeout(context.Background(), claimTimeout)
+	defer cancel()
+
+	claimed, err := w.changes.Claim(ctx, change, time.Now().Add(claimTimeout))
+	if err != nil {
+		w.logger.Error("failed to claim series change", zap.String("id", change.ID), zap.Error(err))
+		return
+	}
+
+	if !claimed {
+		return
+	}
+
+	w.apply(ctx, change)
+}
+
+// apply brings the search document of the series in line with its row and records the outcome on the change.
+// While a reindex job holds the provider the change stays pending, the index the job is building may have
+// been written before the change and only takes the change once the alias points at it
+func (w *Worker) apply(ctx context.Context, change internal.SeriesChange) {
+	deleted, err := w.index(ctx, change)
+	if err != nil {
+		attempts := change.Attempts + 1
+
+		if err := w.changes.Retry(ctx, internal.RetrySeriesChangeParams{
+			ID:            change.ID,
+			Revision:      change.Revision,
+			Attempts:      attempts,
+			Error:         err.Error(),
+			NextAttemptAt: time.Now().Add(w.retry.Backoff(attempts)),
+		}); err != nil {
+			w.logger.Error("failed to retry series change", zap.String("id", change.ID), zap.Error(err))
+		}
+
+		w.logger.Error("failed to index series change",
+			zap.String("provider", change.Provider),
+			zap.String("series", change.Slug),
+			zap.Int("attempts", attempts),
+			zap.Error(err),
+		)
+
+		return
+	}
+
+	// a change left claimed after a failed lookup is picked up again once the claim runs out
+	job, reindexing, err := w.reindexing(ctx, change.Provider)
+	if err != nil {
+		w.logger.Error("failed to find active reindex job", zap.String("provider", change.Provider), zap.Error(err))
+		return
+	}
+
+	if reindexing {
+		if err := w.changes.Retry(ctx, internal.RetrySeriesChangeParams{
+			ID:            change.ID,
+			Revision:      change.Revision,
+			Attempts:      change.Attempts,
+			NextAttemptAt: time.Now().Add(reindexDeferDelay),
+		}); err != nil {
+			w.logger.Error("failed to defer series change", zap.String("id", change.ID), zap.Error(err))
+			return
+		}
+
+		w.logger.Info("Series indexed, change kept for reindex job",
+			zap.String("provider", change.Provider),
+			zap.String("series", change.Slug),
+			zap.String("job", job.ID),
+		)
+
+		return
+	}
+
+	completed, err := w.changes.Complete(ctx, change)
+	if err != nil {
+		w.logger.Error("failed to complete series change", zap.String("id", change.ID), zap.Error(err))
+		return
+	}
+
+	w.logger.Info("Series indexed",
+		zap.String("provider", change.Provider),
+		zap.String("series", change.Slug),
+		zap.String("event", change.Event),
+		zap.Bool("deleted", deleted),
+		zap.Bool("completed", completed),
+	)
+}
+
+// reindexing returns the reindex job holding the provider, if any
+func (w *Worker) reindexing(ctx context.Context, provider string) (internal.ReindexJob, bool, error) {
+	job, err := w.jobs.FindActive(ctx, provider, time.Now().Add(-internal.ReindexStaleAfter))
+	if err != nil {
+		if isNotFound(err) {
+			return internal.ReindexJob{}, false, nil
+		}
+
+		return internal.ReindexJob{}, false, err
+	}
+
+	return job, true, nil
+}
+
+// index reads the series from MySQL rather than trusting the event, so changes applied late or twice still
+// leave the index matching the row. It reports whether the document was deleted
+func (w *Worker) index(ctx context.Context, change internal.SeriesChange) (bool, error) {
+	series, err := w.series.Find(ctx, internal.FindSeriesParams{
+		Provider: change.Provider,
+		Slug:     change.Slug,
+	})
+	if err != nil {
+		if !isNotFound(err) {
+			return false, err
+		}
+
+		if err := w.search.Delete(ctx, change.Provider, change.Slug); err != nil {
+			return false, err
+		}
+
+		return true, nil
+	}
+
+	if err := w.search.Index(ctx, series); err != nil {
+		return false, err
+	}
+
+	return false, nil
+}
+
+func isNotFound(err error) bool {
+	var ierr *internal.Error
+
+	return errors.As(err, &ierr) && ierr.Code() == internal.ErrNotFound
+}
@@ -0,0 +1,162 @@
+package indexer
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+
+	"fourleaves.studio/manga-scraper/internal"
+)
+
+type fakeChanges struct {
+	ChangeRepository
+	retried   *internal.RetrySeriesChangeParams
+	completed bool
+}
+
+func (f *fakeChanges) Complete(_ context.Context, _ internal.SeriesChange) (bool, error) {
+	f.completed = true
+	return true, nil
+}
+
+func (f *fakeChanges) Retry(_ context.Context, params internal.RetrySeriesChangeParams) error {
+	f.retried = &params
+	return nil
+}
+
+type fakeSeries struct {
+	err error
+}
+
+func (f *fakeSeries) Find(_ context.Context, params internal.FindSeriesParams) (internal.Series, error) {
+	if f.err != nil {
+		return internal.Series{}, f.err
+	}
+
+	return internal.Series{Provider: params.Provider, Slug: params.Slug}, nil
+}
+
+type fakeJobs struct {
+	active bool
+}
+
+func (f *fakeJobs) FindActive(_ context.Context, provider string, _ time.Time) (internal.ReindexJob, error) {
+	if !f.active {
+		return internal.ReindexJob{}, internal.NewErrorf(internal.ErrNotFound, "no active reindex job")
+	}
+
+	return internal.ReindexJob{ID: "1", Provider: provider, Status: internal.RunningReindexStatus}, nil
+}
+
+type fakeSearch struct {
+	err     error
+	indexed string
+	deleted string
+}
+
+func (f *fakeSearch) Index(_ context.Context, series internal.Series) error {
+	f.indexed = series.Slug
+	return f.err
+}
+
+func (f *fakeSearch) Delete(_ context.Context, _, slug string) error {
+	f.deleted = slug
+	return f.err
+}
+
+func TestWorker_Apply(t *testing.T) {
+	policy := RetryPolicy{BaseDelay: time.Minute, MaxDelay: time.Hour}
+
+	tests := []struct {
+		name          string
+		findErr       error
+		searchErr     error
+		reindexing    bool
+		wantIndexed   bool
+		wantDeleted   bool
+		wantCompleted bool
+	}{
+		{"Indexed", nil, nil, false, true, false, true},
+		{"Deleted", internal.NewErrorf(internal.ErrNotFound, "series not found"), nil, false, false, true, true},
+		{"Search failed", nil, fmt.Errorf("unavailable"), false, true, false, false},
+		{"Find failed", internal.NewErrorf(internal.ErrUnknown, "failed to find series"), nil, false, false, false, false},
+		{"Reindexing", nil, nil, true, true, false, false},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			changes := &fakeChanges{}
+			search := &fakeSearch{err: tc.searchErr}
+			w := NewWorker(changes, &fakeSeries{err: tc.findErr}, &fakeJobs{active: tc.reindexing}, search, zap.NewNop(), policy, time.Second)
+
+			before := time.Now()
+
+			w.apply(context.Background(), internal.SeriesChange{ID: "1", Provider: "asura", Slug: "solo-leveling", Revision: 2, Attempts: 1})
+
+			if (search.indexed != "") != tc.wantIndexed || (search.deleted != "") != tc.wantDeleted {
+				t.Errorf("unexpected search calls, indexed %q deleted %q", search.indexed, search.deleted)
+			}
+
+			if changes.completed != tc.wantCompleted {
+				t.Errorf("expected completed %v, got %v", tc.wantCompleted, changes.completed)
+			}
+
+			if tc.wantCompleted {
+				if changes.retried != nil {
+					t.Errorf("unexpected retry %+v", changes.retried)
+				}
+
+				return
+			}
+
+			if changes.retried == nil {
+				t.Fatal("expected a retry")
+			}
+
+			// a change deferred for a reindex job keeps its attempts and is applied again shortly
+			if tc.reindexing {
+				if changes.retried.Attempts != 1 || changes.retried.NextAttemptAt.After(before.Add(time.Minute)) {
+					t.Errorf("unexpected deferral %+v", changes.retried)
+				}
+
+				return
+			}
+
+			if changes.retried.Attempts != 2 || changes.retried.Revision != 2 || changes.retried.NextAttemptAt.Before(before.Add(2*time.Minute)) {
+				t.Errorf("unexpected retry %+v", changes.retried)
+			}
+		})
+	}
+}
+
+func TestRetryPolicy_Backoff(t *testing.T) {
+	policy := RetryPolicy{BaseDelay: 10 * time.Second, MaxDelay: time.Minute}
+
+	tests := []struct {
+		name    string
+		attempt int
+		want    time.Duration
+	}{
+		{"None", 0, 0},
+		{"First", 1, 10 * time.Second},
+		{"Doubled", 3, 40 * time.Second},
+		{"Capped", 10, time.Minute},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := policy.Backoff(tc.attempt); got != tc.want {
+				t.Errorf("expected %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
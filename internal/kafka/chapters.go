@@ -0,0 +1,52 @@
+package kafka
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+
+	"fourleaves.studio/manga-scraper/internal"
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+)
+
+const ChapterReleasedTopic = "chapter-released"
+
+type ChapterMessageBroker struct {
+	producer *kafka.Producer
+}
+
+type ChapterEvent struct {
+	Type  string
+	Value internal.ChapterRelease
+}
+
+func NewChapterMessageBroker(producer *kafka.Producer) *ChapterMessageBroker {
+	return &ChapterMessageBroker{
+		producer: producer,
+	}
+}
+
+// Released publishes a chapter.released event, keyed by series so the events of one series stay in order
+func (c *ChapterMessageBroker) Released(ctx context.Context, params internal.ChapterRelease) error {
+	topic := ChapterReleasedTopic
+
+	defer newSentrySpan(ctx, "ChapterMessageBroker.Released", topic).Finish()
+
+	var b bytes.Buffer
+
+	if err := json.NewEncoder(&b).Encode(ChapterEvent{
+		Type:  internal.ChapterReleasedEvent,
+		Value: params,
+	}); err != nil {
+		return internal.WrapErrorf(err, internal.ErrUnknown, "json.Encode")
+	}
+
+	return produce(ctx, c.producer, &kafka.Message{
+		TopicPartition: kafka.TopicPartition{
+			Topic:     &topic,
+			Partition: kafka.PartitionAny,
+		},
+		Key:   []byte(params.Provider + "/" + params.Series),
+		Value: b.Bytes(),
+	})
+}
@@ -4,19 +4,31 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"time"
 
 	"fourleaves.studio/manga-scraper/internal"
 	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
 	"github.com/getsentry/sentry-go"
 )
 
+// deliveryTimeout bounds how long a publish waits for the broker to acknowledge the message
+const deliveryTimeout = 30 * time.Second
+
+const (
+	ScrapeRequestTopic           = "scrape-request"
+	ScrapeRequestRetryTopic      = "scrape-request-retry"
+	ScrapeRequestDeadLetterTopic = "scrape-request-dlq"
+)
+
 type ScraperMessageBroker struct {
 	producer *kafka.Producer
 }
 
 type event struct {
-	Type  string
-	Value internal.ScrapeRequest
+	Type      string
+	Value     internal.ScrapeRequest
+	Attempt   int
+	NotBefore time.Time
 }
 
 func NewScraperMessageBroker(producer *kafka.Producer) *ScraperMessageBroker {
@@ -26,43 +38,86 @@ func NewScraperMessageBroker(producer *kafka.Producer) *ScraperMessageBroker {
 }
 
 func (s *ScraperMessageBroker) Created(ctx context.Context, params internal.ScrapeRequest) error {
-	return s.publish(ctx, "ScraperMessageBroker.Create", "scrape-request", string(params.Type), params)
+	return s.publish(ctx, "ScraperMessageBroker.Create", ScrapeRequestTopic, event{
+		Type:  string(params.Type),
+		Value: params,
+	})
 }
 
-func (s *ScraperMessageBroker) publish(ctx context.Context, spanName, topic, message string, params internal.ScrapeRequest) error {
-	defer newSentrySpan(ctx, spanName).Finish()
+// Retry publishes the request to the retry topic, the worker holds it back until notBefore
+func (s *ScraperMessageBroker) Retry(ctx context.Context, params internal.ScrapeRequest, attempt int, notBefore time.Time) error {
+	return s.publish(ctx, "ScraperMessageBroker.Retry", ScrapeRequestRetryTopic, event{
+		Type:      string(params.Type),
+		Value:     params,
+		Attempt:   attempt,
+		NotBefore: notBefore,
+	})
+}
 
-	var b bytes.Buffer
+// DeadLettered publishes a request that exhausted its retry attempts to the dead-letter topic
+func (s *ScraperMessageBroker) DeadLettered(ctx context.Context, params internal.ScrapeRequest, attempt int) error {
+	return s.publish(ctx, "ScraperMessageBroker.DeadLettered", ScrapeRequestDeadLetterTopic, event{
+		Type:    string(params.Type),
+		Value:   params,
+		Attempt: attempt,
+	})
+}
 
-	evt := event{
-		Type:  message,
-		Value: params,
-	}
+func (s *ScraperMessageBroker) publish(ctx context.Context, spanName, topic string, evt event) error {
+	defer newSentrySpan(ctx, spanName, topic).Finish()
+
+	var b bytes.Buffer
 
 	if err := json.NewEncoder(&b).Encode(evt); err != nil {
 		return internal.WrapErrorf(err, internal.ErrUnknown, "json.Encode")
 	}
 
-	if err := s.producer.Produce(&kafka.Message{
+	return produce(ctx, s.producer, &kafka.Message{
 		TopicPartition: kafka.TopicPartition{
 			Topic:     &topic,
 			Partition: kafka.PartitionAny,
 		},
-		Key:   []byte(params.ID),
+		Key:   []byte(evt.Value.ID),
 		Value: b.Bytes(),
-	}, nil); err != nil {
-		return internal.WrapErrorf(err, internal.ErrUnknown, "product.Producer")
+	})
+}
+
+// produce sends the message and waits for its delivery report, so a message is only taken as sent
+// once the broker stored it and the caller can commit or mark its work done
+func produce(ctx context.Context, producer *kafka.Producer, msg *kafka.Message) error {
+	ctx, cancel := context.WithTimeout(ctx, deliveryTimeout)
+	defer cancel()
+
+	// buffered, so a report arriving after the timeout does not block the producer
+	delivery := make(chan kafka.Event, 1)
+
+	if err := producer.Produce(msg, delivery); err != nil {
+		return internal.WrapErrorf(err, internal.ErrUnknown, "producer.Produce")
 	}
 
-	return nil
+	select {
+	case e := <-delivery:
+		m, ok := e.(*kafka.Message)
+		if !ok {
+			return internal.NewErrorf(internal.ErrUnknown, "unexpected delivery report %v", e)
+		}
+
+		if m.TopicPartition.Error != nil {
+			return internal.WrapErrorf(m.TopicPartition.Error, internal.ErrUnknown, "producer.Delivery")
+		}
+
+		return nil
+	case <-ctx.Done():
+		return internal.WrapErrorf(ctx.Err(), internal.ErrUnknown, "producer.Delivery")
+	}
 }
 
-func newSentrySpan(ctx context.Context, operation string) *sentry.Span {
+func newSentrySpan(ctx context.Context, operation, topic string) *sentry.Span {
 	span := sentry.StartSpan(ctx, operation)
 	span.Name = "fourleaves.studio/manga-scraper/internal/kafka"
 
 	span.SetTag("messaging.system", "kafka")
-	span.SetTag("messaging.destination", "scrape-request")
+	span.SetTag("messaging.destination", topic)
 
 	return span
 }
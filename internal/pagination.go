@@ -0,0 +1,105 @@
+package internal
+
+import (
+	"encoding/base64"
+	"encoding/json"
+)
+
+// Cursor is the position of a keyset page, clients get it as an opaque string.
+// Series are keyed by slug, chapters by number and slug since numbers are not unique.
+// Sorted series lists across providers are keyed by the sort value, provider and slug
+type Cursor struct {
+	Slug     string  `json:"s"`
+	Number   float64 `json:"n,omitempty"`
+	Provider string  `json:"p,omitempty"`
+	Value    string  `json:"v,omitempty"`
+	// Before pages backwards, to the items right before the cursor
+	Before bool `json:"b,omitempty"`
+}
+
+// SeriesPage is a page of a provider series list with the cursors of the pages around it
+type SeriesPage struct {
+	Series     []Series
+	PrevCursor string
+	NextCursor string
+	Total      int
+}
+
+// ChapterPage is a page of a series chapter list with the cursors of the pages around it
+type ChapterPage struct {
+	Chapters   []Chapter
+	PrevCursor string
+	NextCursor string
+	Total      int
+}
+
+func (c Cursor) Encode() string {
+	data, _ := json.Marshal(c)
+
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodeCursor parses a cursor returned by Encode, an empty string is the first page
+func DecodeCursor(s string) (*Cursor, error) {
+	if s == "" {
+		return nil, nil
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, WrapErrorf(err, ErrInvalidInput, "invalid cursor")
+	}
+
+	var cursor Cursor
+
+	if err := json.Unmarshal(data, &cursor); err != nil || cursor.Slug == "" {
+		return nil, NewErrorf(ErrInvalidInput, "invalid cursor")
+	}
+
+	return &cursor, nil
+}
+
+// Ascending reports whether the page is read in ascending key order, i.e. from the keys greater than the cursor.
+// Pages before the cursor are read against the requested order and reversed back by KeysetPage
+func (c *Cursor) Ascending(order SortOrder) bool {
+	if c != nil && c.Before {
+		return order == DESC
+	}
+
+	return order != DESC
+}
+
+// KeysetPage trims the items fetched with one extra item to size and returns the cursors of the surrounding pages.
+// cursor is nil on the first page, hasPrev tells a forward page there are items before it, e.g. when paging by offset
+func KeysetPage[T any](items []T, size int, cursor *Cursor, hasPrev bool, key func(T) Cursor) ([]T, string, string) {
+	more := len(items) > size
+	if more {
+		items = items[:size]
+	}
+
+	if len(items) == 0 {
+		return items, "", ""
+	}
+
+	before := cursor != nil && cursor.Before
+
+	if before {
+		for i, j := 0, len(items)-1; i < j; i, j = i+1, j-1 {
+			items[i], items[j] = items[j], items[i]
+		}
+	}
+
+	var prev, next string
+
+	if (before && more) || (!before && (cursor != nil || hasPrev)) {
+		first := key(items[0])
+		first.Before = true
+		prev = first.Encode()
+	}
+
+	if before || more {
+		next = key(items[len(items)-1]).Encode()
+	}
+
+	return items, prev, next
+}
@@ -0,0 +1,112 @@
+package internal
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDecodeCursor(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       string
+		want    *Cursor
+		wantErr bool
+	}{
+		{"Empty", "", nil, false},
+		{"RoundTrip", Cursor{Slug: "solo-leveling", Number: 12.5, Before: true}.Encode(), &Cursor{Slug: "solo-leveling", Number: 12.5, Before: true}, false},
+		{"NotBase64", "not a cursor!", nil, true},
+		{"NotJSON", "bm90IGpzb24", nil, true},
+		{"NoSlug", Cursor{Number: 1}.Encode(), nil, true},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := DecodeCursor(tc.s)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("expected error: %v, got: %v", tc.wantErr, err)
+			}
+
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("expected %+v, got %+v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestCursor_Ascending(t *testing.T) {
+	tests := []struct {
+		name   string
+		cursor *Cursor
+		order  SortOrder
+		want   bool
+	}{
+		{"FirstPageAsc", nil, ASC, true},
+		{"FirstPageDesc", nil, DESC, false},
+		{"AfterAsc", &Cursor{Slug: "a"}, ASC, true},
+		{"AfterDesc", &Cursor{Slug: "a"}, DESC, false},
+		{"BeforeAsc", &Cursor{Slug: "a", Before: true}, ASC, false},
+		{"BeforeDesc", &Cursor{Slug: "a", Before: true}, DESC, true},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tc.cursor.Ascending(tc.order); got != tc.want {
+				t.Errorf("expected %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestKeysetPage(t *testing.T) {
+	key := func(s string) Cursor { return Cursor{Slug: s} }
+	before := func(s string) string { return Cursor{Slug: s, Before: true}.Encode() }
+	after := func(s string) string { return Cursor{Slug: s}.Encode() }
+
+	tests := []struct {
+		name     string
+		items    []string
+		cursor   *Cursor
+		hasPrev  bool
+		want     []string
+		wantPrev string
+		wantNext string
+	}{
+		{"FirstPage", []string{"a", "b", "c"}, nil, false, []string{"a", "b"}, "", after("b")},
+		{"OnlyPage", []string{"a", "b"}, nil, false, []string{"a", "b"}, "", ""},
+		{"MiddlePage", []string{"c", "d", "e"}, &Cursor{Slug: "b"}, false, []string{"c", "d"}, before("c"), after("d")},
+		{"LastPage", []string{"e"}, &Cursor{Slug: "d"}, false, []string{"e"}, before("e"), ""},
+		{"OffsetPage", []string{"c", "d"}, nil, true, []string{"c", "d"}, before("c"), ""},
+		{"BackwardPage", []string{"d", "c", "b"}, &Cursor{Slug: "e", Before: true}, false, []string{"c", "d"}, before("c"), after("d")},
+		{"BackwardToFirst", []string{"b", "a"}, &Cursor{Slug: "c", Before: true}, false, []string{"a", "b"}, "", after("b")},
+		{"Empty", nil, &Cursor{Slug: "z"}, false, []string{}, "", ""},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, prev, next := KeysetPage(tc.items, 2, tc.cursor, tc.hasPrev, key)
+
+			if len(got) != 0 || len(tc.want) != 0 {
+				if !reflect.DeepEqual(got, tc.want) {
+					t.Errorf("expected items %v, got %v", tc.want, got)
+				}
+			}
+
+			if prev != tc.wantPrev {
+				t.Errorf("expected prev cursor %q, got %q", tc.wantPrev, prev)
+			}
+
+			if next != tc.wantNext {
+				t.Errorf("expected next cursor %q, got %q", tc.wantNext, next)
+			}
+		})
+	}
+}
@@ -1,24 +1,197 @@
 package internal
 
+import (
+	"strings"
+
+	"github.com/andybalholm/cascadia"
+)
+
 type Provider struct {
-	Slug     string `json:"slug"`
-	Name     string `json:"name"`
-	IsActive bool   `json:"isActive"`
-	BaseURL  string `json:"baseURL"`
-	ListURL  string `json:"listURL"`
+	Slug       string              `json:"slug"`
+	Name       string              `json:"name"`
+	IsActive   bool                `json:"isActive"`
+	BaseURL    string              `json:"baseURL"`
+	ListURL    string              `json:"listURL"`
+	Selectors  *ProviderSelectors  `json:"selectors,omitempty"`
+	FetchModes *ProviderFetchModes `json:"fetchModes,omitempty"`
+	RateLimit  *ProviderRateLimit  `json:"rateLimit,omitempty"`
 }
 
 type ProviderBC struct {
 	Provider Breadcrumb `json:"provider"`
 }
 
+// ProviderSelectors holds the CSS selectors used by the generic MangaThemesia scraper
+// Empty fields fall back to the theme defaults
+type ProviderSelectors struct {
+	SeriesListContainer   string `json:"seriesListContainer,omitempty"`
+	SeriesListLink        string `json:"seriesListLink,omitempty"`
+	Thumbnail             string `json:"thumbnail,omitempty"`
+	ThumbnailFallbackAttr string `json:"thumbnailFallbackAttr,omitempty"`
+	Synopsis              string `json:"synopsis,omitempty"`
+	SynopsisFallback      string `json:"synopsisFallback,omitempty"`
+	Genres                string `json:"genres,omitempty"`
+	SeriesInfo            string `json:"seriesInfo,omitempty"`
+	SeriesInfoValue       string `json:"seriesInfoValue,omitempty"`
+	ChapterListContainer  string `json:"chapterListContainer,omitempty"`
+	ChapterListLink       string `json:"chapterListLink,omitempty"`
+	ChapterTitle          string `json:"chapterTitle,omitempty"`
+	ChapterNumber         string `json:"chapterNumber,omitempty"`
+	ChapterNumberAttr     string `json:"chapterNumberAttr,omitempty"`
+	ChapterFullTitle      string `json:"chapterFullTitle,omitempty"`
+	Shortlink             string `json:"shortlink,omitempty"`
+	ReaderScript          string `json:"readerScript,omitempty"`
+}
+
+// IsZero reports whether no selector is set, an empty set clears the selectors of a provider
+func (s *ProviderSelectors) IsZero() bool {
+	return s == nil || *s == ProviderSelectors{}
+}
+
+// Validate checks that every set selector parses as CSS and every set attribute is a single name
+func (s *ProviderSelectors) Validate() error {
+	selectors := []struct {
+		name, value string
+	}{
+		{"seriesListContainer", s.SeriesListContainer},
+		{"seriesListLink", s.SeriesListLink},
+		{"thumbnail", s.Thumbnail},
+		{"synopsis", s.Synopsis},
+		{"synopsisFallback", s.SynopsisFallback},
+		{"genres", s.Genres},
+		{"seriesInfo", s.SeriesInfo},
+		{"seriesInfoValue", s.SeriesInfoValue},
+		{"chapterListContainer", s.ChapterListContainer},
+		{"chapterListLink", s.ChapterListLink},
+		{"chapterTitle", s.ChapterTitle},
+		{"chapterNumber", s.ChapterNumber},
+		{"chapterFullTitle", s.ChapterFullTitle},
+		{"shortlink", s.Shortlink},
+	}
+
+	for _, sel := range selectors {
+		if sel.value == "" {
+			continue
+		}
+
+		if strings.TrimSpace(sel.value) == "" {
+			return NewErrorf(ErrInvalidInput, "selector %s must not be blank", sel.name)
+		}
+
+		if _, err := cascadia.ParseGroup(sel.value); err != nil {
+			return WrapErrorf(err, ErrInvalidInput, "invalid selector %s", sel.name)
+		}
+	}
+
+	for _, attr := range []struct {
+		name, value string
+	}{
+		{"thumbnailFallbackAttr", s.ThumbnailFallbackAttr},
+		{"chapterNumberAttr", s.ChapterNumberAttr},
+	} {
+		if attr.value != "" && strings.ContainsAny(attr.value, " \t\n\"'<>=/") {
+			return NewErrorf(ErrInvalidInput, "invalid attribute %s", attr.name)
+		}
+	}
+
+	if s.ReaderScript != "" && strings.TrimSpace(s.ReaderScript) == "" {
+		return NewErrorf(ErrInvalidInput, "selector readerScript must not be blank")
+	}
+
+	return nil
+}
+
+// FetchMode selects how a provider page is downloaded before it is parsed
+type FetchMode string
+
+const (
+	// BrowserFetchMode renders the page in the headless browser
+	BrowserFetchMode FetchMode = "BROWSER"
+	// StaticFetchMode downloads the page over plain HTTP and falls back to the browser when parsing fails
+	StaticFetchMode FetchMode = "STATIC"
+)
+
+// ProviderFetchModes holds the fetch mode of each scrape request type
+// Empty fields fall back to the browser
+type ProviderFetchModes struct {
+	SeriesList    FetchMode `json:"seriesList,omitempty"`
+	SeriesDetail  FetchMode `json:"seriesDetail,omitempty"`
+	ChapterList   FetchMode `json:"chapterList,omitempty"`
+	ChapterDetail FetchMode `json:"chapterDetail,omitempty"`
+}
+
+// For returns the fetch mode configured for the given request type
+func (m *ProviderFetchModes) For(requestType ScrapeRequestType) FetchMode {
+	if m == nil {
+		return BrowserFetchMode
+	}
+
+	var mode FetchMode
+
+	switch requestType {
+	case SeriesListRequestType:
+		mode = m.SeriesList
+	case SeriesDetailRequestType:
+		mode = m.SeriesDetail
+	case ChapterListRequestType:
+		mode = m.ChapterList
+	case ChapterDetailRequestType:
+		mode = m.ChapterDetail
+	}
+
+	if mode == "" {
+		return BrowserFetchMode
+	}
+
+	return mode
+}
+
+// Validate checks that every configured fetch mode is supported
+func (m *ProviderFetchModes) Validate() error {
+	for _, mode := range []FetchMode{m.SeriesList, m.SeriesDetail, m.ChapterList, m.ChapterDetail} {
+		switch mode {
+		case "", BrowserFetchMode, StaticFetchMode:
+		default:
+			return NewErrorf(ErrInvalidInput, "invalid fetch mode %s", mode)
+		}
+	}
+
+	return nil
+}
+
+// ProviderRateLimit controls how hard the scraper worker hits a provider
+// Zero fields disable the corresponding limit
+type ProviderRateLimit struct {
+	RequestsPerMinute float64 `json:"requestsPerMinute,omitempty"`
+	Burst             int     `json:"burst,omitempty"`
+	MaxInFlight       int     `json:"maxInFlight,omitempty"`
+	JitterMinMs       int     `json:"jitterMinMs,omitempty"`
+	JitterMaxMs       int     `json:"jitterMaxMs,omitempty"`
+}
+
+// Validate checks that the limits are not negative and the jitter range is ordered
+func (r *ProviderRateLimit) Validate() error {
+	if r.RequestsPerMinute < 0 || r.Burst < 0 || r.MaxInFlight < 0 || r.JitterMinMs < 0 || r.JitterMaxMs < 0 {
+		return NewErrorf(ErrInvalidInput, "rate limit values must not be negative")
+	}
+
+	if r.JitterMaxMs < r.JitterMinMs {
+		return NewErrorf(ErrInvalidInput, "jitter max must not be lower than jitter min")
+	}
+
+	return nil
+}
+
 type ProviderParams struct {
-	Slug     string
-	Name     string
-	Scheme   string
-	Host     string
-	ListPath string
-	IsActive *bool
+	Slug       string
+	Name       string
+	Scheme     string
+	Host       string
+	ListPath   string
+	IsActive   *bool
+	Selectors  *ProviderSelectors
+	FetchModes *ProviderFetchModes
+	RateLimit  *ProviderRateLimit
 }
 
 func (p *ProviderParams) Validate() error {
@@ -42,6 +215,24 @@ func (p *ProviderParams) Validate() error {
 		return NewErrorf(ErrInvalidInput, "list path is required")
 	}
 
+	if p.Selectors != nil {
+		if err := p.Selectors.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if p.FetchModes != nil {
+		if err := p.FetchModes.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if p.RateLimit != nil {
+		if err := p.RateLimit.Validate(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -24,6 +24,12 @@ func TestCreateProviderParams_Validate_Invalid(t *testing.T) {
 		{"InvalidScheme", func(p *ProviderParams) { p.Scheme = "" }},
 		{"InvalidHost", func(p *ProviderParams) { p.Host = "" }},
 		{"InvalidListPath", func(p *ProviderParams) { p.ListPath = "" }},
+		{"BlankSelector", func(p *ProviderParams) { p.Selectors = &ProviderSelectors{Genres: "  "} }},
+		{"InvalidSelector", func(p *ProviderParams) { p.Selectors = &ProviderSelectors{Thumbnail: "div.thumb >"} }},
+		{"InvalidSelectorAttribute", func(p *ProviderParams) { p.Selectors = &ProviderSelectors{ChapterNumberAttr: "data num"} }},
+		{"InvalidFetchMode", func(p *ProviderParams) { p.FetchModes = &ProviderFetchModes{ChapterList: "CURL"} }},
+		{"NegativeRateLimit", func(p *ProviderParams) { p.RateLimit = &ProviderRateLimit{RequestsPerMinute: -1} }},
+		{"InvalidJitterRange", func(p *ProviderParams) { p.RateLimit = &ProviderRateLimit{JitterMinMs: 500, JitterMaxMs: 100} }},
 	}
 
 	for _, tc := range tests {
@@ -46,3 +52,84 @@ func TestCreateProviderParams_Validate_Invalid(t *testing.T) {
 		})
 	}
 }
+
+func TestProviderSelectors_Validate(t *testing.T) {
+	defaults := ProviderSelectors{
+		SeriesInfo:            "div.tsinfo div.imptdt",
+		SeriesInfoValue:       "i, a",
+		SynopsisFallback:      `div.entry-content div[class^="contents"] div`,
+		Shortlink:             "link[rel='shortlink']",
+		ThumbnailFallbackAttr: "data-lazy-src",
+		ReaderScript:          "ts_reader.run",
+	}
+
+	cases := []struct {
+		name      string
+		selectors ProviderSelectors
+	}{
+		{"empty", ProviderSelectors{}},
+		{"set", defaults},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if err := tc.selectors.Validate(); err != nil {
+				t.Errorf("expected no error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestProviderSelectors_IsZero(t *testing.T) {
+	cases := []struct {
+		name      string
+		selectors *ProviderSelectors
+		want      bool
+	}{
+		{"nil", nil, true},
+		{"empty", &ProviderSelectors{}, true},
+		{"set", &ProviderSelectors{Thumbnail: ".thumb img"}, false},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tc.selectors.IsZero()
+			if got != tc.want {
+				t.Errorf("Expected %v but got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestProviderFetchModes_For(t *testing.T) {
+	modes := &ProviderFetchModes{ChapterDetail: StaticFetchMode}
+
+	cases := []struct {
+		name        string
+		modes       *ProviderFetchModes
+		requestType ScrapeRequestType
+		want        FetchMode
+	}{
+		{"configured", modes, ChapterDetailRequestType, StaticFetchMode},
+		{"empty", modes, SeriesListRequestType, BrowserFetchMode},
+		{"nil", nil, ChapterDetailRequestType, BrowserFetchMode},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tc.modes.For(tc.requestType)
+			if got != tc.want {
+				t.Errorf("Expected %v but got %v", tc.want, got)
+			}
+		})
+	}
+}
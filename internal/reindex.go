@@ -0,0 +1,46 @@
+package internal
+
+import "time"
+
+const (
+	PendingReindexStatus   ReindexJobStatus = "PENDING"
+	RunningReindexStatus   ReindexJobStatus = "RUNNING"
+	CompletedReindexStatus ReindexJobStatus = "COMPLETED"
+	FailedReindexStatus    ReindexJobStatus = "FAILED"
+)
+
+type ReindexJobStatus string
+
+// ReindexStaleAfter is how long an unfinished job can go without progress before it counts as stopped,
+// another job may start for its provider and the indexer stops holding back the provider's changes
+const ReindexStaleAfter = 15 * time.Minute
+
+// ReindexJob rebuilds the search index of a provider into a new versioned index,
+// searches keep reading the previous index until the alias is swapped
+type ReindexJob struct {
+	ID       string           `json:"id"`
+	Provider string           `json:"provider"`
+	Status   ReindexJobStatus `json:"status"`
+	// Index is the versioned index being built, set once the job is running
+	Index     string    `json:"index,omitempty"`
+	Total     int       `json:"total"`
+	Indexed   int       `json:"indexed"`
+	Message   string    `json:"message,omitempty"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// UpdateReindexJobParams reports the progress of a job, every field is written
+type UpdateReindexJobParams struct {
+	ID      string
+	Status  ReindexJobStatus
+	Index   string
+	Total   int
+	Indexed int
+	Message string
+}
+
+// Done reports whether the job stopped, either completed or failed
+func (j ReindexJob) Done() bool {
+	return j.Status == CompletedReindexStatus || j.Status == FailedReindexStatus
+}
@@ -0,0 +1,214 @@
+package middlewares
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"fourleaves.studio/manga-scraper/internal"
+	v1Handler "fourleaves.studio/manga-scraper/internal/rest/v1"
+	"github.com/getsentry/sentry-go"
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	HeaderAPIKey = "X-API-Key"
+
+	apiKeyContextKey = "apiKey"
+)
+
+// APIKeyFromContext returns the API key the request was authenticated with, if any
+func APIKeyFromContext(c echo.Context) (internal.APIKey, bool) {
+	key, ok := c.Get(apiKeyContextKey).(internal.APIKey)
+	return key, ok
+}
+
+// WithAPIKey authenticates the requests sending an API key, counts them against the limits of the key
+// and rejects them once the key is over its rate limit or daily quota. Requests without a key pass through, KeyScope limits them per client IP.
+func (m *Middleware) WithAPIKey(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		secret := c.Request().Header.Get(HeaderAPIKey)
+		if secret == "" {
+			return next(c)
+		}
+
+		span := sentry.StartSpan(c.Request().Context(), "WithAPIKey")
+		span.Name = "WithAPIKey"
+		defer span.Finish()
+
+		key, err := m.apiKeys.Authenticate(span.Context(), secret)
+		if err != nil {
+			if !isNotFound(err) {
+				c.Logger().Errorj(map[string]interface{}{
+					"_source": "middlewares.WithAPIKey",
+					"error":   err.Error(),
+				})
+
+				span.Status = sentry.SpanStatusInternalError
+				return c.JSON(http.StatusInternalServerError, v1Handler.Response{
+					Error:   true,
+					Message: "Internal Server Error",
+				})
+			}
+
+			span.Status = sentry.SpanStatusUnauthenticated
+			return c.JSON(http.StatusUnauthorized, v1Handler.Response{
+				Error:   true,
+				Message: "Unauthorized",
+				Detail:  "Invalid API key",
+			})
+		}
+
+		c.Set(apiKeyContextKey, key)
+
+		usage, err := m.apiKeys.RecordUsage(span.Context(), key.ID)
+		if err != nil {
+			// a counter outage should not take the keys down with it, the request goes through uncounted
+			c.Logger().Warnj(map[string]interface{}{
+				"_source": "middlewares.WithAPIKey",
+				"api_key": key.ID,
+				"error":   err.Error(),
+			})
+
+			span.Status = sentry.SpanStatusOK
+			return next(c)
+		}
+
+		setRateLimitHeaders(c, key.RateLimit, usage)
+
+		if until, ok := key.Throttled(usage); ok {
+			span.Status = sentry.SpanStatusResourceExhausted
+			return tooManyRequests(c, until, "API key is over its rate limit or daily quota")
+		}
+
+		span.Status = sentry.SpanStatusOK
+		return next(c)
+	}
+}
+
+// KeyScope rejects the API keys without the scope, requests without a key are held to the anonymous limits of their client IP
+func (m *Middleware) KeyScope(scope internal.APIKeyScope) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			key, ok := APIKeyFromContext(c)
+			if !ok {
+				return m.anonymousLimit(next)(c)
+			}
+
+			if !key.HasScope(scope) {
+				return forbiddenScope(c, scope)
+			}
+
+			return next(c)
+		}
+	}
+}
+
+// anonymousLimit counts the requests without a key per client IP against the anonymous rate limit and daily quota
+func (m *Middleware) anonymousLimit(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		limits := internal.APIKey{RateLimit: m.config.AnonymousRateLimit, DailyQuota: m.config.AnonymousDailyQuota}
+		if limits.RateLimit == 0 && limits.DailyQuota == 0 {
+			return next(c)
+		}
+
+		span := sentry.StartSpan(c.Request().Context(), "anonymousLimit")
+		span.Name = "anonymousLimit"
+		defer span.Finish()
+
+		usage, err := m.apiKeys.RecordUsage(span.Context(), anonymousUsageID(c))
+		if err != nil {
+			c.Logger().Warnj(map[string]interface{}{
+				"_source": "middlewares.anonymousLimit",
+				"ip":      c.RealIP(),
+				"error":   err.Error(),
+			})
+
+			span.Status = sentry.SpanStatusOK
+			return next(c)
+		}
+
+		setRateLimitHeaders(c, limits.RateLimit, usage)
+
+		if until, ok := limits.Throttled(usage); ok {
+			span.Status = sentry.SpanStatusResourceExhausted
+			return tooManyRequests(c, until, "Requests without an API key are over the anonymous rate limit or daily quota")
+		}
+
+		span.Status = sentry.SpanStatusOK
+		return next(c)
+	}
+}
+
+// anonymousUsageID is the usage counter shared by the requests without a key from one client IP
+func anonymousUsageID(c echo.Context) string {
+	return "anonymous:" + c.RealIP()
+}
+
+func setRateLimitHeaders(c echo.Context, rateLimit int, usage internal.APIKeyUsage) {
+	if rateLimit <= 0 {
+		return
+	}
+
+	remaining := rateLimit - usage.Minute
+	if remaining < 0 {
+		remaining = 0
+	}
+
+	c.Response().Header().Set("X-RateLimit-Limit", strconv.Itoa(rateLimit))
+	c.Response().Header().Set("X-RateLimit-Remaining", strconv.Itoa(remaining))
+	c.Response().Header().Set("X-RateLimit-Reset", strconv.FormatInt(usage.MinuteResetAt.Unix(), 10))
+}
+
+func tooManyRequests(c echo.Context, until time.Time, detail string) error {
+	retryAfter := int(time.Until(until).Seconds()) + 1
+	c.Response().Header().Set(echo.HeaderRetryAfter, strconv.Itoa(retryAfter))
+
+	return c.JSON(http.StatusTooManyRequests, v1Handler.Response{
+		Error:   true,
+		Message: "Too Many Requests",
+		Detail:  detail,
+	})
+}
+
+// RequireScope only lets through API keys with the scope, requests without a key need an admin session
+func (m *Middleware) RequireScope(scope internal.APIKeyScope) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			key, ok := APIKeyFromContext(c)
+			if !ok {
+				return m.isAdminSession(next)(c)
+			}
+
+			if !key.HasScope(scope) {
+				return forbiddenScope(c, scope)
+			}
+
+			return next(c)
+		}
+	}
+}
+
+func forbiddenScope(c echo.Context, scope internal.APIKeyScope) error {
+	return c.JSON(http.StatusForbidden, v1Handler.Response{
+		Error:   true,
+		Message: "Forbidden",
+		Detail:  "API key requires the " + string(scope) + " scope",
+	})
+}
+
+// isNotFound reports whether the innermost coded error of the chain is a not found error,
+// the layers above the store wrap every error as unknown
+func isNotFound(err error) bool {
+	var ierr *internal.Error
+
+	found := false
+
+	for errors.As(err, &ierr) {
+		found = ierr.Code() == internal.ErrNotFound
+		err = ierr.Unwrap()
+	}
+
+	return found
+}
@@ -0,0 +1,291 @@
+package middlewares
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"fourleaves.studio/manga-scraper/internal"
+	"fourleaves.studio/manga-scraper/internal/config"
+	"github.com/labstack/echo/v4"
+)
+
+type fakeAPIKeys struct {
+	keys     map[string]internal.APIKey
+	authErr  error
+	usage    internal.APIKeyUsage
+	usageErr error
+	usageID  string
+}
+
+func (f *fakeAPIKeys) Authenticate(_ context.Context, secret string) (internal.APIKey, error) {
+	if f.authErr != nil {
+		return internal.APIKey{}, f.authErr
+	}
+
+	key, ok := f.keys[secret]
+	if !ok {
+		return internal.APIKey{}, internal.WrapErrorf(
+			internal.NewErrorf(internal.ErrNotFound, "api key not found"), internal.ErrUnknown, "repo.FindByHash",
+		)
+	}
+
+	return key, nil
+}
+
+func (f *fakeAPIKeys) RecordUsage(_ context.Context, id string) (internal.APIKeyUsage, error) {
+	f.usageID = id
+	return f.usage, f.usageErr
+}
+
+// newTestRouter serves GET / behind WithAPIKey and the given middlewares, answering 200 when the request gets through
+func newTestRouter(apiKeys APIKeyService, middlewares ...func(m *Middleware) echo.MiddlewareFunc) *echo.Echo {
+	return newTestRouterWithConfig(&config.Config{}, apiKeys, middlewares...)
+}
+
+func newTestRouterWithConfig(cfg *config.Config, apiKeys APIKeyService, middlewares ...func(m *Middleware) echo.MiddlewareFunc) *echo.Echo {
+	m := NewMiddleware(cfg, apiKeys)
+
+	router := echo.New()
+	router.Use(m.WithAPIKey)
+
+	var chain []echo.MiddlewareFunc
+	for _, mw := range middlewares {
+		chain = append(chain, mw(m))
+	}
+
+	router.GET("/", func(c echo.Context) error {
+		return c.NoContent(http.StatusOK)
+	}, chain...)
+
+	return router
+}
+
+func serve(router *echo.Echo, secret string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	if secret != "" {
+		req.Header.Set(HeaderAPIKey, secret)
+	}
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+
+	return rec
+}
+
+func TestMiddleware_WithAPIKey(t *testing.T) {
+	minuteResetAt := time.Now().Add(30 * time.Second)
+
+	tests := []struct {
+		name       string
+		apiKeys    *fakeAPIKeys
+		secret     string
+		wantStatus int
+		wantLimit  string
+	}{
+		{
+			name:       "No key",
+			apiKeys:    &fakeAPIKeys{},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "Unknown key",
+			apiKeys:    &fakeAPIKeys{},
+			secret:     "msk_unknown",
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "Lookup failure",
+			apiKeys:    &fakeAPIKeys{authErr: internal.WrapErrorf(fmt.Errorf("connection refused"), internal.ErrUnknown, "repo.FindByHash")},
+			secret:     "msk_secret",
+			wantStatus: http.StatusInternalServerError,
+		},
+		{
+			name: "Within its limits",
+			apiKeys: &fakeAPIKeys{
+				keys:  map[string]internal.APIKey{"msk_secret": {ID: "1", RateLimit: 10}},
+				usage: internal.APIKeyUsage{Minute: 3, MinuteResetAt: minuteResetAt},
+			},
+			secret:     "msk_secret",
+			wantStatus: http.StatusOK,
+			wantLimit:  "10",
+		},
+		{
+			name: "Over its rate limit",
+			apiKeys: &fakeAPIKeys{
+				keys:  map[string]internal.APIKey{"msk_secret": {ID: "1", RateLimit: 10}},
+				usage: internal.APIKeyUsage{Minute: 11, MinuteResetAt: minuteResetAt},
+			},
+			secret:     "msk_secret",
+			wantStatus: http.StatusTooManyRequests,
+			wantLimit:  "10",
+		},
+		{
+			name: "Usage counter failure",
+			apiKeys: &fakeAPIKeys{
+				keys:     map[string]internal.APIKey{"msk_secret": {ID: "1", RateLimit: 10}},
+				usageErr: fmt.Errorf("connection refused"),
+			},
+			secret:     "msk_secret",
+			wantStatus: http.StatusOK,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			rec := serve(newTestRouter(tc.apiKeys), tc.secret)
+
+			if rec.Code != tc.wantStatus {
+				t.Errorf("expected status %d, got %d", tc.wantStatus, rec.Code)
+			}
+
+			if got := rec.Header().Get("X-RateLimit-Limit"); got != tc.wantLimit {
+				t.Errorf("expected X-RateLimit-Limit %q, got %q", tc.wantLimit, got)
+			}
+
+			if tc.wantStatus == http.StatusTooManyRequests && rec.Header().Get(echo.HeaderRetryAfter) == "" {
+				t.Error("expected a Retry-After header")
+			}
+		})
+	}
+}
+
+func TestMiddleware_KeyScope(t *testing.T) {
+	apiKeys := &fakeAPIKeys{
+		keys: map[string]internal.APIKey{
+			"msk_read":   {ID: "1", Scopes: []internal.APIKeyScope{internal.ReadAPIKeyScope}},
+			"msk_scrape": {ID: "2", Scopes: []internal.APIKeyScope{internal.ScrapeAPIKeyScope}},
+			"msk_admin":  {ID: "3", Scopes: []internal.APIKeyScope{internal.AdminAPIKeyScope}},
+		},
+	}
+
+	router := newTestRouter(apiKeys, func(m *Middleware) echo.MiddlewareFunc {
+		return m.KeyScope(internal.ReadAPIKeyScope)
+	})
+
+	tests := []struct {
+		name       string
+		secret     string
+		wantStatus int
+	}{
+		{"No key", "", http.StatusOK},
+		{"Key with the scope", "msk_read", http.StatusOK},
+		{"Admin key", "msk_admin", http.StatusOK},
+		{"Key without the scope", "msk_scrape", http.StatusForbidden},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if rec := serve(router, tc.secret); rec.Code != tc.wantStatus {
+				t.Errorf("expected status %d, got %d", tc.wantStatus, rec.Code)
+			}
+		})
+	}
+}
+
+func TestMiddleware_KeyScope_Anonymous(t *testing.T) {
+	minuteResetAt := time.Now().Add(30 * time.Second)
+	cfg := &config.Config{AnonymousRateLimit: 10, AnonymousDailyQuota: 100}
+
+	tests := []struct {
+		name       string
+		apiKeys    *fakeAPIKeys
+		wantStatus int
+		wantLimit  string
+	}{
+		{
+			name:       "Within the anonymous limits",
+			apiKeys:    &fakeAPIKeys{usage: internal.APIKeyUsage{Minute: 3, Day: 3, MinuteResetAt: minuteResetAt}},
+			wantStatus: http.StatusOK,
+			wantLimit:  "10",
+		},
+		{
+			name:       "Over the anonymous rate limit",
+			apiKeys:    &fakeAPIKeys{usage: internal.APIKeyUsage{Minute: 11, Day: 11, MinuteResetAt: minuteResetAt}},
+			wantStatus: http.StatusTooManyRequests,
+			wantLimit:  "10",
+		},
+		{
+			name:       "Over the anonymous daily quota",
+			apiKeys:    &fakeAPIKeys{usage: internal.APIKeyUsage{Minute: 1, Day: 101, DayResetAt: time.Now().Add(time.Hour)}},
+			wantStatus: http.StatusTooManyRequests,
+			wantLimit:  "10",
+		},
+		{
+			name:       "Usage counter failure",
+			apiKeys:    &fakeAPIKeys{usageErr: fmt.Errorf("connection refused")},
+			wantStatus: http.StatusOK,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			router := newTestRouterWithConfig(cfg, tc.apiKeys, func(m *Middleware) echo.MiddlewareFunc {
+				return m.KeyScope(internal.ReadAPIKeyScope)
+			})
+
+			rec := serve(router, "")
+
+			if rec.Code != tc.wantStatus {
+				t.Errorf("expected status %d, got %d", tc.wantStatus, rec.Code)
+			}
+
+			if got := rec.Header().Get("X-RateLimit-Limit"); got != tc.wantLimit {
+				t.Errorf("expected X-RateLimit-Limit %q, got %q", tc.wantLimit, got)
+			}
+
+			if tc.apiKeys.usageID != "anonymous:192.0.2.1" {
+				t.Errorf("expected the usage of the client IP, got %q", tc.apiKeys.usageID)
+			}
+		})
+	}
+}
+
+func TestMiddleware_RequireScope(t *testing.T) {
+	apiKeys := &fakeAPIKeys{
+		keys: map[string]internal.APIKey{
+			"msk_read":   {ID: "1", Scopes: []internal.APIKeyScope{internal.ReadAPIKeyScope}},
+			"msk_scrape": {ID: "2", Scopes: []internal.APIKeyScope{internal.ScrapeAPIKeyScope}},
+			"msk_admin":  {ID: "3", Scopes: []internal.APIKeyScope{internal.AdminAPIKeyScope}},
+		},
+	}
+
+	router := newTestRouter(apiKeys, func(m *Middleware) echo.MiddlewareFunc {
+		return m.RequireScope(internal.ScrapeAPIKeyScope)
+	})
+
+	tests := []struct {
+		name       string
+		secret     string
+		wantStatus int
+	}{
+		// without a key the request needs an admin session
+		{"No key", "", http.StatusUnauthorized},
+		{"Key with the scope", "msk_scrape", http.StatusOK},
+		{"Admin key", "msk_admin", http.StatusOK},
+		{"Key without the scope", "msk_read", http.StatusForbidden},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if rec := serve(router, tc.secret); rec.Code != tc.wantStatus {
+				t.Errorf("expected status %d, got %d", tc.wantStatus, rec.Code)
+			}
+		})
+	}
+}
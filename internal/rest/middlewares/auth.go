@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"strings"
 
+	"fourleaves.studio/manga-scraper/internal"
 	v1Handler "fourleaves.studio/manga-scraper/internal/rest/v1"
 	"github.com/clerk/clerk-sdk-go/v2/jwt"
 	"github.com/getsentry/sentry-go"
@@ -56,7 +57,12 @@ func (m *Middleware) WithHeaderAuth(next echo.HandlerFunc) echo.HandlerFunc {
 	}
 }
 
+// IsAdmin lets through admin sessions and API keys with the admin scope
 func (m *Middleware) IsAdmin(next echo.HandlerFunc) echo.HandlerFunc {
+	return m.RequireScope(internal.AdminAPIKeyScope)(next)
+}
+
+func (m *Middleware) isAdminSession(next echo.HandlerFunc) echo.HandlerFunc {
 	return func(c echo.Context) error {
 		span := sentry.StartSpan(c.Request().Context(), "IsAdmin")
 		span.Name = "IsAdmin"
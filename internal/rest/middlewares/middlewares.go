@@ -1,13 +1,25 @@
 package middlewares
 
-import "fourleaves.studio/manga-scraper/internal/config"
+import (
+	"context"
+
+	"fourleaves.studio/manga-scraper/internal"
+	"fourleaves.studio/manga-scraper/internal/config"
+)
+
+type APIKeyService interface {
+	Authenticate(ctx context.Context, secret string) (internal.APIKey, error)
+	RecordUsage(ctx context.Context, id string) (internal.APIKeyUsage, error)
+}
 
 type Middleware struct {
-	config *config.Config
+	config  *config.Config
+	apiKeys APIKeyService
 }
 
-func NewMiddleware(config *config.Config) *Middleware {
+func NewMiddleware(config *config.Config, apiKeys APIKeyService) *Middleware {
 	return &Middleware{
-		config: config,
+		config:  config,
+		apiKeys: apiKeys,
 	}
 }
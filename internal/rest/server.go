@@ -2,12 +2,14 @@ package server
 
 import (
 	"context"
+	"expvar"
 	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
 	"time"
 
+	"fourleaves.studio/manga-scraper/internal"
 	"fourleaves.studio/manga-scraper/internal/config"
 	"fourleaves.studio/manga-scraper/internal/database/prisma"
 	"fourleaves.studio/manga-scraper/internal/database/redis"
@@ -16,10 +18,13 @@ import (
 	kafkaDomain "fourleaves.studio/manga-scraper/internal/kafka"
 	"fourleaves.studio/manga-scraper/internal/rest/middlewares"
 	v1Handler "fourleaves.studio/manga-scraper/internal/rest/v1"
+	apiKeyHandler "fourleaves.studio/manga-scraper/internal/rest/v1/apikeys"
 	chapterHandler "fourleaves.studio/manga-scraper/internal/rest/v1/chapters"
 	providersHandler "fourleaves.studio/manga-scraper/internal/rest/v1/providers"
 	scraperHandler "fourleaves.studio/manga-scraper/internal/rest/v1/scrapers"
 	seriesHandler "fourleaves.studio/manga-scraper/internal/rest/v1/series"
+	webhookHandler "fourleaves.studio/manga-scraper/internal/rest/v1/webhooks"
+	workHandler "fourleaves.studio/manga-scraper/internal/rest/v1/works"
 	"fourleaves.studio/manga-scraper/internal/service"
 	"github.com/clerk/clerk-sdk-go/v2"
 
@@ -43,10 +48,16 @@ type RESTServer struct {
 
 func NewRESTServer(config *config.Config, dbClient *prisma.PrismaClient, esClient *opensearch.Client, kafkaClient *kafka.Producer) *RESTServer {
 	router := echo.New()
+	// Only trust X-Forwarded-For from private proxies, the anonymous limits are counted per client IP
+	router.IPExtractor = echo.ExtractIPFromXFFHeader()
 	router.Use(middleware.Logger())
 	router.Use(middleware.Recover())
 
-	mid := middlewares.NewMiddleware(config)
+	apiKeyRepo := redis.NewAPIKeyCache(config.RedisURL, prisma.NewAPIKeyRepo(dbClient), time.Minute, router.Logger)
+	apiKeyUsage := redis.NewAPIKeyUsageCounter(config.RedisURL)
+	apiKeyService := service.NewAPIKeyService(apiKeyRepo, apiKeyUsage)
+
+	mid := middlewares.NewMiddleware(config, apiKeyService)
 
 	switch config.ENV {
 	case "development":
@@ -64,36 +75,61 @@ func NewRESTServer(config *config.Config, dbClient *prisma.PrismaClient, esClien
 	router.Use(
 		middleware.CORSWithConfig(middleware.CORSConfig{
 			AllowOrigins: []string{"*"},
-			AllowHeaders: []string{echo.HeaderOrigin, echo.HeaderContentType, echo.HeaderAccept, echo.HeaderAuthorization},
-			AllowMethods: []string{http.MethodGet, http.MethodHead, http.MethodPut, http.MethodPost, http.MethodOptions},
+			AllowHeaders: []string{echo.HeaderOrigin, echo.HeaderContentType, echo.HeaderAccept, echo.HeaderAuthorization, middlewares.HeaderAPIKey},
+			AllowMethods: []string{http.MethodGet, http.MethodHead, http.MethodPut, http.MethodPost, http.MethodDelete, http.MethodOptions},
 		}),
 	)
 
+	router.Use(mid.WithAPIKey)
+
 	router.Validator = &middlewares.CustomValidator{Validator: validator.New()}
 
+	// API keys need the read scope for the public routes and requests without one get the anonymous limits,
+	// admin routes check their own scope
+	read := mid.KeyScope(internal.ReadAPIKeyScope)
+
 	providerRepo := prisma.NewProviderRepo(dbClient)
 	providerCache := redis.NewProviderCache(config.RedisURL, providerRepo, 30*time.Minute, router.Logger)
 	providerService := service.NewProviderService(providerCache)
-	providersHandler.NewProviderHandler(providerService).Register(router.Group("/api/v1/providers"), mid)
+	providersHandler.NewProviderHandler(providerService).Register(router.Group("/api/v1/providers", read), mid)
+
+	workRepo := prisma.NewWorkRepo(dbClient)
 
 	seriesRepo := prisma.NewSeriesRepo(dbClient)
 	seriesCache := redis.NewSeriesCache(config.RedisURL, seriesRepo, 30*time.Minute, router.Logger)
 	seriesSearch := elasticsearch.NewSeriesSearchRepository(esClient)
-	seriesService := service.NewSeriesService(seriesCache, seriesSearch, router.Logger)
-	seriesHandler.NewSeriesHandler(seriesService).Register(router.Group("/api/v1/series"), mid)
+	if err := seriesSearch.PutTemplates(context.Background()); err != nil {
+		router.Logger.Warnf("failed to put search index templates: %v", err)
+	}
+	seriesService := service.NewSeriesService(seriesCache, seriesSearch, workRepo, router.Logger)
+	reindexJobRepo := prisma.NewReindexJobRepo(dbClient)
+	reindexService := service.NewReindexService(reindexJobRepo, seriesRepo, prisma.NewSeriesChangeRepo(dbClient), providerRepo, seriesSearch)
+	seriesHandler.NewSeriesHandler(seriesService, reindexService).Register(router.Group("/api/v1/series", read), mid)
 
 	chapterRepo := prisma.NewChapterRepo(dbClient)
 	chapterCache := redis.NewChapterCache(config.RedisURL, chapterRepo, 30*time.Minute, router.Logger)
-	chapterService := service.NewChapterService(chapterCache)
-	chapterHandler.NewChapterHandler(chapterService).Register(router.Group("/api/v1/chapters"))
+	chapterService := service.NewChapterService(chapterCache, workRepo)
+	chapterHandler.NewChapterHandler(chapterService).Register(router.Group("/api/v1/chapters", read))
+
+	workService := service.NewWorkService(workRepo, chapterCache, config.WorkProviderPriority)
+	workHandler.NewWorkHandler(workService).Register(router.Group("/api/v1/works", read), mid)
 
 	scraperRepo := prisma.NewScraperRepo(dbClient)
 	scaperMessageBroker := kafkaDomain.NewScraperMessageBroker(kafkaClient)
 	scraperService := service.NewScraperService(scraperRepo, scaperMessageBroker, router.Logger)
 	scraperHandler.NewScraperHandler(scraperService, providerCache, seriesCache, chapterCache).Register(router.Group("/api/v1/scrapers"), mid)
 
+	webhookRepo := prisma.NewWebhookRepo(dbClient)
+	webhookService := service.NewWebhookService(webhookRepo)
+	webhookHandler.NewWebhookHandler(webhookService, providerCache, seriesCache).Register(router.Group("/api/v1/webhooks"), mid)
+
+	apiKeyHandler.NewAPIKeyHandler(apiKeyService).Register(router.Group("/api/v1/apikeys"), mid)
+
 	router.GET("/health", v1Handler.GetHealthCheck)
 
+	// expvar counters, including the cache hits and misses of every cached method
+	router.GET("/debug/vars", echo.WrapHandler(expvar.Handler()), mid.IsAdmin)
+
 	router.GET("/swagger/*", echoSwagger.WrapHandler)
 
 	return &RESTServer{
@@ -0,0 +1,70 @@
+package apikeys
+
+import (
+	"context"
+
+	"fourleaves.studio/manga-scraper/internal"
+	"fourleaves.studio/manga-scraper/internal/rest/middlewares"
+	"github.com/getsentry/sentry-go"
+	"github.com/labstack/echo/v4"
+)
+
+type APIKeyService interface {
+	Create(ctx context.Context, params internal.APIKeyParams) (internal.APIKey, error)
+	Find(ctx context.Context, id string) (internal.APIKey, error)
+	FindAll(ctx context.Context) ([]internal.APIKey, error)
+	Update(ctx context.Context, params internal.APIKeyParams) (internal.APIKey, error)
+	Rotate(ctx context.Context, id string) (internal.APIKey, error)
+	Revoke(ctx context.Context, id string) (internal.APIKey, error)
+	FindUsage(ctx context.Context, id string) (internal.APIKeyUsage, error)
+}
+
+type APIKeyHandler struct {
+	svc APIKeyService
+}
+
+func NewAPIKeyHandler(svc APIKeyService) *APIKeyHandler {
+	return &APIKeyHandler{
+		svc: svc,
+	}
+}
+
+func (h *APIKeyHandler) Register(g *echo.Group, mid *middlewares.Middleware) {
+	g.POST("", h.Create, mid.IsAdmin)
+	g.GET("", h.FindAll, mid.IsAdmin)
+	g.GET("/:id", h.Find, mid.IsAdmin)
+	g.PUT("/:id", h.Update, mid.IsAdmin)
+	g.POST("/:id/_rotate", h.Rotate, mid.IsAdmin)
+	g.POST("/:id/_revoke", h.Revoke, mid.IsAdmin)
+	g.GET("/:id/usage", h.FindUsage, mid.IsAdmin)
+}
+
+type CreateAPIKeyRequest struct {
+	Name       string   `json:"name" validate:"required" example:"partner"`
+	Scopes     []string `json:"scopes" validate:"required,min=1,dive,oneof=read scrape admin" example:"read,scrape"`
+	RateLimit  int      `json:"rate_limit" validate:"gte=0" example:"60"`
+	DailyQuota int      `json:"daily_quota" validate:"gte=0" example:"10000"`
+} // @name CreateAPIKeyRequest
+
+type UpdateAPIKeyRequest struct {
+	Name       string   `json:"name" validate:"required" example:"partner"`
+	Scopes     []string `json:"scopes" validate:"required,min=1,dive,oneof=read scrape admin" example:"read"`
+	RateLimit  int      `json:"rate_limit" validate:"gte=0" example:"120"`
+	DailyQuota int      `json:"daily_quota" validate:"gte=0" example:"50000"`
+} // @name UpdateAPIKeyRequest
+
+func toScopes(scopes []string) []internal.APIKeyScope {
+	result := make([]internal.APIKeyScope, 0, len(scopes))
+	for _, scope := range scopes {
+		result = append(result, internal.APIKeyScope(scope))
+	}
+
+	return result
+}
+
+func newSentrySpan(ctx context.Context, operation string) *sentry.Span {
+	span := sentry.StartSpan(ctx, operation)
+	span.Name = "fourleaves.studio/manga-scraper/internal/rest/v1/apikeys"
+
+	return span
+}
@@ -0,0 +1,37 @@
+package apikeys
+
+import (
+	"net/http"
+
+	v1Handler "fourleaves.studio/manga-scraper/internal/rest/v1"
+	"github.com/getsentry/sentry-go"
+	"github.com/labstack/echo/v4"
+)
+
+// @Summary		Get all API keys
+// @Description	Get all API keys, including the revoked ones
+// @Security		TokenAuth
+// @Security		APIKeyAuth
+// @Tags			apikeys
+// @Produce		json
+// @Success		200	{object}	ResponseV1
+// @Failure		401	{object}	ResponseV1
+// @Failure		403	{object}	ResponseV1
+// @Failure		500	{object}	ResponseV1
+// @Router			/api/v1/apikeys [get]
+func (h *APIKeyHandler) FindAll(c echo.Context) error {
+	span := newSentrySpan(c.Request().Context(), "v1.FindAll")
+	defer span.Finish()
+
+	keys, err := h.svc.FindAll(c.Request().Context())
+	if err != nil {
+		return v1Handler.RenderErrorResponse(c, "Failed to get API keys", err, span)
+	}
+
+	span.Status = sentry.SpanStatusOK
+	return c.JSON(http.StatusOK, v1Handler.Response{
+		Error:   false,
+		Message: "OK",
+		Data:    keys,
+	})
+}
@@ -0,0 +1,57 @@
+package apikeys
+
+import (
+	"net/http"
+
+	"fourleaves.studio/manga-scraper/internal"
+	v1Handler "fourleaves.studio/manga-scraper/internal/rest/v1"
+	"github.com/getsentry/sentry-go"
+	"github.com/labstack/echo/v4"
+)
+
+// @Summary		Create API key
+// @Description	Create an API key with scopes, a rate limit per minute and a daily quota, 0 is unlimited. The key is only returned here.
+// @Security		TokenAuth
+// @Security		APIKeyAuth
+// @Tags			apikeys
+// @Accept			json
+// @Produce		json
+// @Param			body	body		CreateAPIKeyRequest	true	"Request body"
+// @Success		201		{object}	ResponseV1
+// @Failure		400		{object}	ResponseV1
+// @Failure		401		{object}	ResponseV1
+// @Failure		403		{object}	ResponseV1
+// @Failure		500		{object}	ResponseV1
+// @Router			/api/v1/apikeys [post]
+func (h *APIKeyHandler) Create(c echo.Context) error {
+	span := newSentrySpan(c.Request().Context(), "v1.Create")
+	defer span.Finish()
+
+	var req CreateAPIKeyRequest
+	err := c.Bind(&req)
+	if err != nil {
+		return v1Handler.RenderErrorResponse(c, "Invalid request", internal.WrapErrorf(err, internal.ErrInvalidInput, "bind request"), span)
+	}
+
+	err = c.Validate(&req)
+	if err != nil {
+		return v1Handler.RenderErrorResponse(c, "Invalid request", internal.WrapErrorf(err, internal.ErrInvalidInput, "validate request"), span)
+	}
+
+	key, err := h.svc.Create(c.Request().Context(), internal.APIKeyParams{
+		Name:       req.Name,
+		Scopes:     toScopes(req.Scopes),
+		RateLimit:  req.RateLimit,
+		DailyQuota: req.DailyQuota,
+	})
+	if err != nil {
+		return v1Handler.RenderErrorResponse(c, "Failed to create API key", err, span)
+	}
+
+	span.Status = sentry.SpanStatusOK
+	return c.JSON(http.StatusCreated, v1Handler.Response{
+		Error:   false,
+		Message: "Created",
+		Data:    key,
+	})
+}
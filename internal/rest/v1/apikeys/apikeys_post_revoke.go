@@ -0,0 +1,39 @@
+package apikeys
+
+import (
+	"net/http"
+
+	v1Handler "fourleaves.studio/manga-scraper/internal/rest/v1"
+	"github.com/getsentry/sentry-go"
+	"github.com/labstack/echo/v4"
+)
+
+// @Summary		Revoke API key by ID
+// @Description	Revoke an API key for good, its requests are rejected right away
+// @Security		TokenAuth
+// @Security		APIKeyAuth
+// @Tags			apikeys
+// @Produce		json
+// @Param			id	path		string	true	"API key ID"	example(550e8400-e29b-41d4-a716-446655440000)
+// @Success		200	{object}	ResponseV1
+// @Failure		401	{object}	ResponseV1
+// @Failure		403	{object}	ResponseV1
+// @Failure		404	{object}	ResponseV1
+// @Failure		500	{object}	ResponseV1
+// @Router			/api/v1/apikeys/{id}/_revoke [post]
+func (h *APIKeyHandler) Revoke(c echo.Context) error {
+	span := newSentrySpan(c.Request().Context(), "v1.Revoke")
+	defer span.Finish()
+
+	key, err := h.svc.Revoke(c.Request().Context(), c.Param("id"))
+	if err != nil {
+		return v1Handler.RenderErrorResponse(c, "Failed to revoke API key", err, span)
+	}
+
+	span.Status = sentry.SpanStatusOK
+	return c.JSON(http.StatusOK, v1Handler.Response{
+		Error:   false,
+		Message: "OK",
+		Data:    key,
+	})
+}
@@ -0,0 +1,40 @@
+package apikeys
+
+import (
+	"net/http"
+
+	v1Handler "fourleaves.studio/manga-scraper/internal/rest/v1"
+	"github.com/getsentry/sentry-go"
+	"github.com/labstack/echo/v4"
+)
+
+// @Summary		Rotate API key by ID
+// @Description	Replace the key of an API key, the previous key stops working right away. The new key is only returned here.
+// @Security		TokenAuth
+// @Security		APIKeyAuth
+// @Tags			apikeys
+// @Produce		json
+// @Param			id	path		string	true	"API key ID"	example(550e8400-e29b-41d4-a716-446655440000)
+// @Success		200	{object}	ResponseV1
+// @Failure		400	{object}	ResponseV1
+// @Failure		401	{object}	ResponseV1
+// @Failure		403	{object}	ResponseV1
+// @Failure		404	{object}	ResponseV1
+// @Failure		500	{object}	ResponseV1
+// @Router			/api/v1/apikeys/{id}/_rotate [post]
+func (h *APIKeyHandler) Rotate(c echo.Context) error {
+	span := newSentrySpan(c.Request().Context(), "v1.Rotate")
+	defer span.Finish()
+
+	key, err := h.svc.Rotate(c.Request().Context(), c.Param("id"))
+	if err != nil {
+		return v1Handler.RenderErrorResponse(c, "Failed to rotate API key", err, span)
+	}
+
+	span.Status = sentry.SpanStatusOK
+	return c.JSON(http.StatusOK, v1Handler.Response{
+		Error:   false,
+		Message: "OK",
+		Data:    key,
+	})
+}
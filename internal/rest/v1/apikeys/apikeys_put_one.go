@@ -0,0 +1,60 @@
+package apikeys
+
+import (
+	"net/http"
+
+	"fourleaves.studio/manga-scraper/internal"
+	v1Handler "fourleaves.studio/manga-scraper/internal/rest/v1"
+	"github.com/getsentry/sentry-go"
+	"github.com/labstack/echo/v4"
+)
+
+// @Summary		Update API key by ID
+// @Description	Replace the name, scopes and limits of an API key, the key itself is kept
+// @Security		TokenAuth
+// @Security		APIKeyAuth
+// @Tags			apikeys
+// @Accept			json
+// @Produce		json
+// @Param			id		path		string				true	"API key ID"	example(550e8400-e29b-41d4-a716-446655440000)
+// @Param			body	body		UpdateAPIKeyRequest	true	"Request body"
+// @Success		200		{object}	ResponseV1
+// @Failure		400		{object}	ResponseV1
+// @Failure		401		{object}	ResponseV1
+// @Failure		403		{object}	ResponseV1
+// @Failure		404		{object}	ResponseV1
+// @Failure		500		{object}	ResponseV1
+// @Router			/api/v1/apikeys/{id} [put]
+func (h *APIKeyHandler) Update(c echo.Context) error {
+	span := newSentrySpan(c.Request().Context(), "v1.Update")
+	defer span.Finish()
+
+	var req UpdateAPIKeyRequest
+	err := c.Bind(&req)
+	if err != nil {
+		return v1Handler.RenderErrorResponse(c, "Invalid request", internal.WrapErrorf(err, internal.ErrInvalidInput, "bind request"), span)
+	}
+
+	err = c.Validate(&req)
+	if err != nil {
+		return v1Handler.RenderErrorResponse(c, "Invalid request", internal.WrapErrorf(err, internal.ErrInvalidInput, "validate request"), span)
+	}
+
+	key, err := h.svc.Update(c.Request().Context(), internal.APIKeyParams{
+		ID:         c.Param("id"),
+		Name:       req.Name,
+		Scopes:     toScopes(req.Scopes),
+		RateLimit:  req.RateLimit,
+		DailyQuota: req.DailyQuota,
+	})
+	if err != nil {
+		return v1Handler.RenderErrorResponse(c, "Failed to update API key", err, span)
+	}
+
+	span.Status = sentry.SpanStatusOK
+	return c.JSON(http.StatusOK, v1Handler.Response{
+		Error:   false,
+		Message: "OK",
+		Data:    key,
+	})
+}
@@ -0,0 +1,116 @@
+package v1
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/getsentry/sentry-go"
+	"github.com/labstack/echo/v4"
+)
+
+// Cache-Control policies of the public read routes, clients revalidate with the ETag once max-age runs out
+const (
+	// CacheControlStatic suits providers and breadcrumbs, which barely change once created
+	CacheControlStatic = "public, max-age=3600"
+	// CacheControlDetail suits a single series or chapter, rewritten when it is scraped again
+	CacheControlDetail = "public, max-age=300"
+	// CacheControlList suits lists and search results, which change with every scraped series or chapter
+	CacheControlList = "public, max-age=60"
+)
+
+// credentialHeaders are the request headers that identify the caller, a response to a request with one of them
+// is private and shared caches keep the responses apart by them
+var credentialHeaders = []string{"X-API-Key", echo.HeaderAuthorization}
+
+// RenderCachedResponse renders data as an OK response with a strong ETag of the body and the given Cache-Control,
+// Last-Modified is the newest of lastModified and left out without one. It answers 304 Not Modified without a body
+// when the If-None-Match or If-Modified-Since of the request still match.
+// A request with an API key or a session gets a private response, so a shared cache never serves it to another caller
+// Only single resources pass lastModified, a list also changes when a row leaves it and lists rely on the ETag
+func RenderCachedResponse(c echo.Context, data interface{}, cacheControl string, span *sentry.Span, lastModified ...time.Time) error {
+	body, err := json.Marshal(Response{
+		Error:   false,
+		Message: "OK",
+		Data:    data,
+	})
+	if err != nil {
+		return RenderErrorResponse(c, "Failed to render response", err, span)
+	}
+
+	etag := fmt.Sprintf(`"%x"`, sha256.Sum256(body))
+
+	var modified time.Time
+
+	for _, t := range lastModified {
+		if t.After(modified) {
+			modified = t
+		}
+	}
+
+	header := c.Response().Header()
+	header.Set(echo.HeaderCacheControl, withCredentials(c.Request(), cacheControl))
+	// added, the CORS middleware already varies on Origin
+	header.Add(echo.HeaderVary, strings.Join(credentialHeaders, ", "))
+	header.Set("ETag", etag)
+
+	if !modified.IsZero() {
+		header.Set(echo.HeaderLastModified, modified.UTC().Format(http.TimeFormat))
+	}
+
+	span.Status = sentry.SpanStatusOK
+
+	if notModified(c.Request(), etag, modified) {
+		return c.NoContent(http.StatusNotModified)
+	}
+
+	return c.JSONBlob(http.StatusOK, body)
+}
+
+// withCredentials turns a public Cache-Control private when the request carries credentials
+func withCredentials(r *http.Request, cacheControl string) string {
+	for _, h := range credentialHeaders {
+		if r.Header.Get(h) != "" {
+			return strings.Replace(cacheControl, "public", "private", 1)
+		}
+	}
+
+	return cacheControl
+}
+
+// notModified evaluates the conditional headers the way RFC 9110 orders them,
+// If-Modified-Since is ignored when the request has an If-None-Match
+func notModified(r *http.Request, etag string, lastModified time.Time) bool {
+	if inm := r.Header.Get("If-None-Match"); inm != "" {
+		return etagMatches(inm, etag)
+	}
+
+	ims := r.Header.Get(echo.HeaderIfModifiedSince)
+	if ims == "" || lastModified.IsZero() {
+		return false
+	}
+
+	since, err := http.ParseTime(ims)
+	if err != nil {
+		return false
+	}
+
+	// Last-Modified only carries seconds
+	return !lastModified.Truncate(time.Second).After(since)
+}
+
+// etagMatches compares the If-None-Match list against etag, weakly as GET requests do
+func etagMatches(header, etag string) bool {
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimSpace(candidate)
+
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == etag {
+			return true
+		}
+	}
+
+	return false
+}
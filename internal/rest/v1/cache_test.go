@@ -0,0 +1,148 @@
+package v1
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/getsentry/sentry-go"
+	"github.com/labstack/echo/v4"
+)
+
+func TestNotModified(t *testing.T) {
+	etag := `"abc"`
+	modified := time.Date(2024, 7, 1, 12, 0, 0, 500, time.UTC)
+
+	tests := []struct {
+		name         string
+		inm          string
+		ims          string
+		lastModified time.Time
+		want         bool
+	}{
+		{"No conditions", "", "", modified, false},
+		{"Matching ETag", `"abc"`, "", modified, true},
+		{"Other ETag", `"def"`, "", modified, false},
+		{"If-None-Match wins over a matching If-Modified-Since", `"def"`, modified.Format(http.TimeFormat), modified, false},
+		{"If-None-Match wins over an outdated If-Modified-Since", `"abc"`, modified.Add(-time.Hour).Format(http.TimeFormat), modified, true},
+		{"Modified since", "", modified.Add(-time.Second).Format(http.TimeFormat), modified, false},
+		{"Not modified since, within the same second", "", modified.Format(http.TimeFormat), modified, true},
+		{"Not modified since, later date", "", modified.Add(time.Hour).Format(http.TimeFormat), modified, true},
+		{"If-Modified-Since without Last-Modified", "", modified.Format(http.TimeFormat), time.Time{}, false},
+		{"Invalid If-Modified-Since", "", "yesterday", modified, false},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			if tc.inm != "" {
+				r.Header.Set("If-None-Match", tc.inm)
+			}
+			if tc.ims != "" {
+				r.Header.Set(echo.HeaderIfModifiedSince, tc.ims)
+			}
+
+			if got := notModified(r, etag, tc.lastModified); got != tc.want {
+				t.Errorf("expected %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestEtagMatches(t *testing.T) {
+	etag := `"abc"`
+
+	tests := []struct {
+		name   string
+		header string
+		want   bool
+	}{
+		{"Same", `"abc"`, true},
+		{"Different", `"def"`, false},
+		{"Weak", `W/"abc"`, true},
+		{"Weak different", `W/"def"`, false},
+		{"Any", `*`, true},
+		{"List", `"def", "abc"`, true},
+		{"List without spaces", `"def","ghi",W/"abc"`, true},
+		{"List without match", `"def", W/"ghi"`, false},
+		{"Unquoted", `abc`, false},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := etagMatches(tc.header, etag); got != tc.want {
+				t.Errorf("expected %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestRenderCachedResponse(t *testing.T) {
+	modified := time.Date(2024, 7, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name             string
+		lastModified     []time.Time
+		ims              string
+		header           string
+		wantStatus       int
+		wantLastModified string
+		wantCacheControl string
+	}{
+		{"Single resource", []time.Time{modified}, "", "", http.StatusOK, modified.Format(http.TimeFormat), CacheControlDetail},
+		{"Single resource not modified", []time.Time{modified}, modified.Format(http.TimeFormat), "", http.StatusNotModified, modified.Format(http.TimeFormat), CacheControlDetail},
+		{"List", nil, "", "", http.StatusOK, "", CacheControlDetail},
+		{"List ignores If-Modified-Since", nil, modified.Format(http.TimeFormat), "", http.StatusOK, "", CacheControlDetail},
+		{"API key", nil, "", "X-API-Key", http.StatusOK, "", "private, max-age=300"},
+		{"Session", nil, "", echo.HeaderAuthorization, http.StatusOK, "", "private, max-age=300"},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			if tc.ims != "" {
+				req.Header.Set(echo.HeaderIfModifiedSince, tc.ims)
+			}
+			if tc.header != "" {
+				req.Header.Set(tc.header, "secret")
+			}
+
+			rec := httptest.NewRecorder()
+			c := echo.New().NewContext(req, rec)
+
+			if err := RenderCachedResponse(c, "data", CacheControlDetail, sentry.StartSpan(req.Context(), "test"), tc.lastModified...); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if rec.Code != tc.wantStatus {
+				t.Errorf("expected status %d, got %d", tc.wantStatus, rec.Code)
+			}
+
+			if got := rec.Header().Get(echo.HeaderLastModified); got != tc.wantLastModified {
+				t.Errorf("expected Last-Modified %q, got %q", tc.wantLastModified, got)
+			}
+
+			if got := rec.Header().Get(echo.HeaderCacheControl); got != tc.wantCacheControl {
+				t.Errorf("expected Cache-Control %q, got %q", tc.wantCacheControl, got)
+			}
+
+			if got := rec.Header().Get(echo.HeaderVary); got != "X-API-Key, Authorization" {
+				t.Errorf("expected Vary on the credentials, got %q", got)
+			}
+
+			if rec.Header().Get("ETag") == "" {
+				t.Error("expected an ETag")
+			}
+		})
+	}
+}
@@ -16,7 +16,7 @@ type ChapterService interface {
 	Count(ctx context.Context, params internal.FindChapterParams) (int, error)
 	FindAll(ctx context.Context, params internal.FindChapterParams) ([]internal.Chapter, error)
 	FindListWithRel(ctx context.Context, params internal.FindChapterParams) (internal.ChapterList, error)
-	FindPaginated(ctx context.Context, params internal.FindChapterParams) ([]internal.Chapter, error)
+	FindPaginated(ctx context.Context, params internal.FindChapterParams) (internal.ChapterPage, error)
 	UpdateInit(ctx context.Context, params internal.UpdateInitChapterParams) (internal.Chapter, error)
 	Delete(ctx context.Context, params internal.FindChapterParams) error
 }
@@ -39,16 +39,20 @@ func (h *ChapterHandler) Register(g *echo.Group) {
 	g.GET("/:provider_slug/:series_slug/:chapter_slug/_bc", h.FindBC)
 }
 
+// PaginatedRequest pages by cursor, page is only used without a cursor and kept for older clients
 type PaginatedRequest struct {
-	Sort string `query:"sort" validate:"omitempty,oneof=asc desc" example:"asc"`
-	Page int    `query:"page" validate:"required,gt=0" example:"1"`
-	Size int    `query:"size" validate:"required,gt=0,lte=100" example:"10"`
+	Sort   string `query:"sort" validate:"omitempty,oneof=asc desc" example:"asc"`
+	Cursor string `query:"cursor" example:"eyJzIjoicmVpbmNhcm5hdG9yIn0"`
+	Page   int    `query:"page" validate:"omitempty,gt=0" example:"1"`
+	Size   int    `query:"size" validate:"required,gt=0,lte=100" example:"10"`
 }
 
 type PaginationData struct {
-	PrevPage int `json:"prevPage,omitempty"`
-	NextPage int `json:"nextPage,omitempty"`
-	Total    int `json:"total,omitempty"`
+	PrevPage   int    `json:"prevPage,omitempty"`
+	NextPage   int    `json:"nextPage,omitempty"`
+	PrevCursor string `json:"prevCursor,omitempty"`
+	NextCursor string `json:"nextCursor,omitempty"`
+	Total      int    `json:"total"`
 }
 
 type PaginatedResponse struct {
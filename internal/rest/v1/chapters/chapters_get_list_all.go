@@ -1,11 +1,8 @@
 package chapters
 
 import (
-	"net/http"
-
 	"fourleaves.studio/manga-scraper/internal"
 	v1Handler "fourleaves.studio/manga-scraper/internal/rest/v1"
-	"github.com/getsentry/sentry-go"
 	"github.com/labstack/echo/v4"
 )
 
@@ -17,6 +14,7 @@ import (
 // @Param			series_slug		path		string	true	"Series slug"	example(reincarnator)
 // @Param			sort			query		string	false	"Sort order"	enum(asc, desc)	default(asc)
 // @Success		200				{object}	ResponseV1
+// @Success		304				"Not Modified"
 // @Failure		404				{object}	ResponseV1
 // @Failure		500				{object}	ResponseV1
 // @Router			/api/v1/chapters/{provider_slug}/{series_slug}/_all [get]
@@ -39,10 +37,5 @@ func (h *ChapterHandler) FindAll(c echo.Context) error {
 		return v1Handler.RenderErrorResponse(c, "Failed to get chapters", err, span)
 	}
 
-	span.Status = sentry.SpanStatusOK
-	return c.JSON(http.StatusOK, v1Handler.Response{
-		Error:   false,
-		Message: "OK",
-		Data:    chaptersList,
-	})
+	return v1Handler.RenderCachedResponse(c, chaptersList, v1Handler.CacheControlList, span)
 }
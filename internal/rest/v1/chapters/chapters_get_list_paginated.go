@@ -1,11 +1,8 @@
 package chapters
 
 import (
-	"net/http"
-
 	"fourleaves.studio/manga-scraper/internal"
 	v1Handler "fourleaves.studio/manga-scraper/internal/rest/v1"
-	"github.com/getsentry/sentry-go"
 	"github.com/labstack/echo/v4"
 )
 
@@ -16,9 +13,11 @@ import (
 // @Param			provider_slug	path		string	true	"Provider slug"	example(asura)
 // @Param			series_slug		path		string	true	"Series slug"	example(reincarnator)
 // @Param			sort			query		string	false	"Sort order"	enum(asc, desc)	default(asc)
-// @Param			page			query		string	true	"Page"			example(10)
+// @Param			cursor			query		string	false	"Cursor from a previous page, takes precedence over page"
+// @Param			page			query		string	false	"Page, only used without a cursor"	example(10)
 // @Param			size			query		string	true	"Size"			example(100)
 // @Success		200				{object}	ResponseV1
+// @Success		304				"Not Modified"
 // @Failure		400				{object}	ResponseV1
 // @Failure		404				{object}	ResponseV1
 // @Failure		500				{object}	ResponseV1
@@ -47,38 +46,37 @@ func (h *ChapterHandler) FindPaginated(c echo.Context) error {
 		Order:    internal.NewSortOrder(req.Sort),
 		Page:     req.Page,
 		Size:     req.Size,
+		Cursor:   req.Cursor,
 	}
 
-	chapters, err := h.svc.FindPaginated(c.Request().Context(), params)
+	page, err := h.svc.FindPaginated(c.Request().Context(), params)
 	if err != nil {
 		return v1Handler.RenderErrorResponse(c, "Failed to get chapters", err, span)
 	}
 
-	var prevPage, nextPage, total int
+	var prevPage, nextPage int
 
-	if req.Page >= 2 {
-		prevPage = req.Page - 1
-	}
+	// page numbers are only returned to clients paging by offset
+	if req.Cursor == "" && req.Page > 0 {
+		if req.Page >= 2 {
+			prevPage = req.Page - 1
+		}
 
-	if len(chapters) == req.Size {
-		nextPage = req.Page + 1
+		if page.NextCursor != "" {
+			nextPage = req.Page + 1
+		}
 	}
 
-	total = len(chapters)
-
 	result := PaginatedResponse{
 		PaginationData: PaginationData{
-			PrevPage: prevPage,
-			NextPage: nextPage,
-			Total:    total,
+			PrevPage:   prevPage,
+			NextPage:   nextPage,
+			PrevCursor: page.PrevCursor,
+			NextCursor: page.NextCursor,
+			Total:      page.Total,
 		},
-		Chapters: chapters,
+		Chapters: page.Chapters,
 	}
 
-	span.Status = sentry.SpanStatusOK
-	return c.JSON(http.StatusOK, v1Handler.Response{
-		Error:   false,
-		Message: "OK",
-		Data:    result,
-	})
+	return v1Handler.RenderCachedResponse(c, result, v1Handler.CacheControlList, span)
 }
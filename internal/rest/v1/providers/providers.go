@@ -38,20 +38,26 @@ func (h *ProviderHandler) Register(g *echo.Group, mid *middlewares.Middleware) {
 }
 
 type CreateProviderRequest struct {
-	Slug     string `json:"slug" validate:"required" example:"asura"`
-	Name     string `json:"name" validate:"required" example:"Asura Scans"`
-	Scheme   string `json:"scheme" validate:"required" example:"https://"`
-	Host     string `json:"host" validate:"required" example:"asuratoon.com"`
-	ListPath string `json:"list_path" validate:"required" example:"/manga/list-mode/"`
-	IsActive *bool  `json:"is_active" validate:"required" example:"true"`
+	Slug       string                       `json:"slug" validate:"required" example:"asura"`
+	Name       string                       `json:"name" validate:"required" example:"Asura Scans"`
+	Scheme     string                       `json:"scheme" validate:"required" example:"https://"`
+	Host       string                       `json:"host" validate:"required" example:"asuratoon.com"`
+	ListPath   string                       `json:"list_path" validate:"required" example:"/manga/list-mode/"`
+	IsActive   *bool                        `json:"is_active" validate:"required" example:"true"`
+	Selectors  *internal.ProviderSelectors  `json:"selectors"`
+	FetchModes *internal.ProviderFetchModes `json:"fetch_modes"`
+	RateLimit  *internal.ProviderRateLimit  `json:"rate_limit"`
 } // @name CreateProviderRequest
 
 type UpdateProviderRequest struct {
-	Name     string `json:"name" validate:"required" example:"Asura Scans"`
-	Scheme   string `json:"scheme" validate:"required" example:"https://"`
-	Host     string `json:"host" validate:"required" example:"asuratoon.com"`
-	ListPath string `json:"list_path" validate:"required" example:"/manga/list-mode/"`
-	IsActive *bool  `json:"is_active" validate:"required" example:"true"`
+	Name       string                       `json:"name" validate:"required" example:"Asura Scans"`
+	Scheme     string                       `json:"scheme" validate:"required" example:"https://"`
+	Host       string                       `json:"host" validate:"required" example:"asuratoon.com"`
+	ListPath   string                       `json:"list_path" validate:"required" example:"/manga/list-mode/"`
+	IsActive   *bool                        `json:"is_active" validate:"required" example:"true"`
+	Selectors  *internal.ProviderSelectors  `json:"selectors"`
+	FetchModes *internal.ProviderFetchModes `json:"fetch_modes"`
+	RateLimit  *internal.ProviderRateLimit  `json:"rate_limit"`
 } // @name UpdateProviderRequest
 
 func newSentrySpan(ctx context.Context, operation string) *sentry.Span {
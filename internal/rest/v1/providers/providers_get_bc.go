@@ -1,10 +1,7 @@
 package providers
 
 import (
-	"net/http"
-
 	v1Handler "fourleaves.studio/manga-scraper/internal/rest/v1"
-	"github.com/getsentry/sentry-go"
 	"github.com/labstack/echo/v4"
 )
 
@@ -14,6 +11,7 @@ import (
 // @Produce		json
 // @Param			provider_slug	path		string	true	"Provider slug" example(asura)
 // @Success		200				{object}	ResponseV1
+// @Success		304				"Not Modified"
 // @Failure		404				{object}	ResponseV1
 // @Failure		500				{object}	ResponseV1
 // @Router			/api/v1/providers/{provider_slug}/_bc [get]
@@ -28,10 +26,5 @@ func (h *ProviderHandler) FindBC(c echo.Context) error {
 		return v1Handler.RenderErrorResponse(c, "Failed to get providers", err, span)
 	}
 
-	span.Status = sentry.SpanStatusOK
-	return c.JSON(http.StatusOK, v1Handler.Response{
-		Error:   false,
-		Message: "OK",
-		Data:    provider,
-	})
+	return v1Handler.RenderCachedResponse(c, provider, v1Handler.CacheControlStatic, span)
 }
@@ -1,11 +1,8 @@
 package providers
 
 import (
-	"net/http"
-
 	"fourleaves.studio/manga-scraper/internal"
 	v1Handler "fourleaves.studio/manga-scraper/internal/rest/v1"
-	"github.com/getsentry/sentry-go"
 	"github.com/labstack/echo/v4"
 )
 
@@ -14,6 +11,7 @@ import (
 // @Tags			providers
 // @Produce		json
 // @Success		200	{object}	ResponseV1
+// @Success		304	"Not Modified"
 // @Failure		404	{object}	ResponseV1
 // @Failure		500	{object}	ResponseV1
 // @Router			/api/v1/providers [get]
@@ -26,10 +24,5 @@ func (h *ProviderHandler) FindAll(c echo.Context) error {
 		return v1Handler.RenderErrorResponse(c, "Failed to get providers", err, span)
 	}
 
-	span.Status = sentry.SpanStatusOK
-	return c.JSON(http.StatusOK, v1Handler.Response{
-		Error:   false,
-		Message: "OK",
-		Data:    providers,
-	})
+	return v1Handler.RenderCachedResponse(c, providers, v1Handler.CacheControlStatic, span)
 }
@@ -13,6 +13,7 @@ import (
 // @Summary		Create provider
 // @Description	Create provider
 // @Security		TokenAuth
+// @Security		APIKeyAuth
 // @Tags			providers
 // @Accept			json
 // @Produce		json
@@ -11,8 +11,9 @@ import (
 )
 
 // @Summary		Update provider
-// @Description	Update provider
+// @Description	Update provider, selectors, fetch modes and rate limit are kept when omitted and an empty selectors object clears the selectors
 // @Security		TokenAuth
+// @Security		APIKeyAuth
 // @Tags			providers
 // @Accept			json
 // @Produce		json
@@ -43,12 +44,15 @@ func (h *ProviderHandler) Update(c echo.Context) error {
 	providerSlug := c.Param("provider_slug")
 
 	params := internal.ProviderParams{
-		Slug:     providerSlug,
-		Name:     req.Name,
-		Scheme:   req.Scheme,
-		Host:     req.Host,
-		ListPath: req.ListPath,
-		IsActive: req.IsActive,
+		Slug:       providerSlug,
+		Name:       req.Name,
+		Scheme:     req.Scheme,
+		Host:       req.Host,
+		ListPath:   req.ListPath,
+		IsActive:   req.IsActive,
+		Selectors:  req.Selectors,
+		FetchModes: req.FetchModes,
+		RateLimit:  req.RateLimit,
 	}
 
 	provider, err := h.svc.Update(c.Request().Context(), params)
@@ -12,9 +12,13 @@ import (
 type ScraperService interface {
 	Create(ctx context.Context, params internal.CreateScrapeRequestParams) (internal.ScrapeRequest, error)
 	Find(ctx context.Context, id string) (internal.ScrapeRequest, error)
-	FindPendings(ctx context.Context, params internal.FindScrapeRequestParams) ([]internal.ScrapeRequest, error)
+	FindPaginated(ctx context.Context, params internal.FindScrapeRequestParams) (internal.ScrapeRequestPage, error)
+	FindDeadLetters(ctx context.Context, params internal.FindScrapeRequestParams) (internal.ScrapeRequestPage, error)
 	Update(ctx context.Context, params internal.UpdateScrapeRequestParams) (internal.ScrapeRequest, error)
+	Cancel(ctx context.Context, id string) (internal.ScrapeRequest, error)
+	Retry(ctx context.Context, params internal.RetryScrapeRequestParams) (internal.RetryScrapeRequestResult, error)
 	Delete(ctx context.Context, id string) error
+	DeleteMany(ctx context.Context, params internal.DeleteScrapeRequestParams) (int, error)
 }
 
 type ProviderService interface {
@@ -51,11 +55,17 @@ func NewScraperHandler(
 }
 
 func (h *ScraperHandler) Register(g *echo.Group, mid *middlewares.Middleware) {
-	g.POST("", h.Create, mid.IsAdmin)
-	// g.GET("", h.FindPendings)
-	g.GET("/:id", h.Find, mid.IsAdmin)
+	scrape := mid.RequireScope(internal.ScrapeAPIKeyScope)
+
+	g.POST("", h.Create, scrape)
+	g.GET("", h.FindPaginated, scrape)
+	g.DELETE("", h.DeleteMany, mid.IsAdmin)
+	g.POST("/_retry", h.Retry, scrape)
+	g.GET("/_dead_letters", h.FindDeadLetters, scrape)
+	g.GET("/:id", h.Find, scrape)
 	// g.PUT("/:id", h.Update)
-	// g.DELETE("/:id", h.Delete)
+	g.DELETE("/:id", h.Delete, mid.IsAdmin)
+	g.POST("/:id/_cancel", h.Cancel, scrape)
 }
 
 type CreateScrapeRequest struct {
@@ -65,6 +75,55 @@ type CreateScrapeRequest struct {
 	Chapter  string `json:"chapter,omitempty" example:"reincarnator-chapter-1"`
 } // @name CreateScrapeRequest
 
+type PaginatedRequest struct {
+	Sort string `query:"sort" validate:"omitempty,oneof=asc desc" example:"desc"`
+	Page int    `query:"page" validate:"required,gt=0" example:"1"`
+	Size int    `query:"size" validate:"required,gt=0,lte=100" example:"10"`
+}
+
+type FindScrapeRequestsRequest struct {
+	Sort          string `query:"sort" validate:"omitempty,oneof=asc desc" example:"desc"`
+	Page          int    `query:"page" validate:"required,gt=0" example:"1"`
+	Size          int    `query:"size" validate:"required,gt=0,lte=100" example:"10"`
+	Type          string `query:"type" validate:"omitempty,oneof=SERIES_LIST SERIES_DETAIL CHAPTER_LIST CHAPTER_DETAIL" example:"CHAPTER_DETAIL"`
+	Provider      string `query:"provider" example:"asura"`
+	Series        string `query:"series" example:"reincarnator"`
+	Status        string `query:"status" validate:"omitempty,oneof=PENDING COMPLETED FAILED DEAD_LETTER CANCELLED" example:"FAILED"`
+	Error         string `query:"error" validate:"omitempty,oneof=true false" example:"true"`
+	CreatedAfter  string `query:"created_after" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00" example:"2024-07-01T00:00:00Z"`
+	CreatedBefore string `query:"created_before" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00" example:"2024-07-02T00:00:00Z"`
+}
+
+type RetryScrapeRequestsRequest struct {
+	Type          string `json:"type" validate:"omitempty,oneof=SERIES_LIST SERIES_DETAIL CHAPTER_LIST CHAPTER_DETAIL" example:"CHAPTER_DETAIL"`
+	Provider      string `json:"provider" example:"asura"`
+	Status        string `json:"status" validate:"omitempty,oneof=FAILED DEAD_LETTER" example:"FAILED"`
+	Message       string `json:"message" example:"page.Navigate"`
+	CreatedAfter  string `json:"created_after" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00" example:"2024-07-01T00:00:00Z"`
+	CreatedBefore string `json:"created_before" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00" example:"2024-07-02T00:00:00Z"`
+	Limit         int    `json:"limit" validate:"omitempty,gt=0,lte=1000" example:"100"`
+} // @name RetryScrapeRequestsRequest
+
+type DeleteScrapeRequestsRequest struct {
+	Status        string `query:"status" validate:"omitempty,oneof=COMPLETED FAILED DEAD_LETTER CANCELLED" example:"COMPLETED"`
+	CreatedBefore string `query:"created_before" validate:"required,datetime=2006-01-02T15:04:05Z07:00" example:"2024-07-01T00:00:00Z"`
+}
+
+type DeleteManyResponse struct {
+	Count int `json:"count"`
+}
+
+type PaginationData struct {
+	PrevPage int `json:"prevPage,omitempty"`
+	NextPage int `json:"nextPage,omitempty"`
+	Total    int `json:"total,omitempty"`
+}
+
+type PaginatedResponse struct {
+	PaginationData
+	Requests []internal.ScrapeRequest `json:"requests"`
+}
+
 func newSentrySpan(ctx context.Context, operation string) *sentry.Span {
 	span := sentry.StartSpan(ctx, operation)
 	span.Name = "fourleaves.studio/manga-scraper/internal/rest/v1/scrapers"
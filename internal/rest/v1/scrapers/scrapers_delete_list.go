@@ -0,0 +1,59 @@
+package scrapers
+
+import (
+	"net/http"
+	"time"
+
+	"fourleaves.studio/manga-scraper/internal"
+	v1Handler "fourleaves.studio/manga-scraper/internal/rest/v1"
+	"github.com/getsentry/sentry-go"
+	"github.com/labstack/echo/v4"
+)
+
+// @Summary		Bulk delete scrape requests
+// @Description	Delete every scrape request created before the given time, pending requests are never deleted
+// @Security		TokenAuth
+// @Security		APIKeyAuth
+// @Tags			scrapers
+// @Produce		json
+// @Param			status			query		string	false	"Request status"			example(COMPLETED)
+// @Param			created_before	query		string	true	"Created before (RFC3339)"	example(2024-07-01T00:00:00Z)
+// @Success		200				{object}	ResponseV1
+// @Failure		400				{object}	ResponseV1
+// @Failure		401				{object}	ResponseV1
+// @Failure		403				{object}	ResponseV1
+// @Failure		500				{object}	ResponseV1
+// @Router			/api/v1/scrapers [delete]
+func (h *ScraperHandler) DeleteMany(c echo.Context) error {
+	span := newSentrySpan(c.Request().Context(), "v1.DeleteMany")
+	defer span.Finish()
+
+	var req DeleteScrapeRequestsRequest
+	err := c.Bind(&req)
+	if err != nil {
+		return v1Handler.RenderErrorResponse(c, "Invalid request", internal.WrapErrorf(err, internal.ErrInvalidInput, "bind request"), span)
+	}
+
+	err = c.Validate(&req)
+	if err != nil {
+		return v1Handler.RenderErrorResponse(c, "Invalid request", internal.WrapErrorf(err, internal.ErrInvalidInput, "validate request"), span)
+	}
+
+	// the validator already checked the format
+	createdBefore, _ := time.Parse(time.RFC3339, req.CreatedBefore)
+
+	count, err := h.svc.DeleteMany(c.Request().Context(), internal.DeleteScrapeRequestParams{
+		Status:        internal.ScrapeRequestStatus(req.Status),
+		CreatedBefore: createdBefore,
+	})
+	if err != nil {
+		return v1Handler.RenderErrorResponse(c, "Failed to delete scrape requests", err, span)
+	}
+
+	span.Status = sentry.SpanStatusOK
+	return c.JSON(http.StatusOK, v1Handler.Response{
+		Error:   false,
+		Message: "OK",
+		Data:    DeleteManyResponse{Count: count},
+	})
+}
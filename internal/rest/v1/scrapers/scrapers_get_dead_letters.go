@@ -0,0 +1,76 @@
+package scrapers
+
+import (
+	"net/http"
+
+	"fourleaves.studio/manga-scraper/internal"
+	v1Handler "fourleaves.studio/manga-scraper/internal/rest/v1"
+	"github.com/getsentry/sentry-go"
+	"github.com/labstack/echo/v4"
+)
+
+// @Summary		Get dead-lettered scrape requests
+// @Description	Get paginated scrape requests that failed after every retry attempt
+// @Security		TokenAuth
+// @Security		APIKeyAuth
+// @Tags			scrapers
+// @Produce		json
+// @Param			sort	query		string	false	"Sort by creation time"	example(desc)
+// @Param			page	query		string	true	"Page"					example(1)
+// @Param			size	query		string	true	"Size"					example(10)
+// @Success		200		{object}	ResponseV1
+// @Failure		400		{object}	ResponseV1
+// @Failure		401		{object}	ResponseV1
+// @Failure		403		{object}	ResponseV1
+// @Failure		500		{object}	ResponseV1
+// @Router			/api/v1/scrapers/_dead_letters [get]
+func (h *ScraperHandler) FindDeadLetters(c echo.Context) error {
+	span := newSentrySpan(c.Request().Context(), "v1.FindDeadLetters")
+	defer span.Finish()
+
+	var req PaginatedRequest
+	err := c.Bind(&req)
+	if err != nil {
+		return v1Handler.RenderErrorResponse(c, "Invalid request", internal.WrapErrorf(err, internal.ErrInvalidInput, "bind request"), span)
+	}
+
+	err = c.Validate(&req)
+	if err != nil {
+		return v1Handler.RenderErrorResponse(c, "Invalid request", internal.WrapErrorf(err, internal.ErrInvalidInput, "validate request"), span)
+	}
+
+	page, err := h.svc.FindDeadLetters(c.Request().Context(), internal.FindScrapeRequestParams{
+		Order: internal.NewSortOrder(req.Sort),
+		Page:  req.Page,
+		Size:  req.Size,
+	})
+	if err != nil {
+		return v1Handler.RenderErrorResponse(c, "Failed to get dead-lettered scrape requests", err, span)
+	}
+
+	var prevPage, nextPage int
+
+	if req.Page >= 2 {
+		prevPage = req.Page - 1
+	}
+
+	if req.Page*req.Size < page.Total {
+		nextPage = req.Page + 1
+	}
+
+	result := PaginatedResponse{
+		PaginationData: PaginationData{
+			PrevPage: prevPage,
+			NextPage: nextPage,
+			Total:    page.Total,
+		},
+		Requests: page.Requests,
+	}
+
+	span.Status = sentry.SpanStatusOK
+	return c.JSON(http.StatusOK, v1Handler.Response{
+		Error:   false,
+		Message: "OK",
+		Data:    result,
+	})
+}
@@ -0,0 +1,104 @@
+package scrapers
+
+import (
+	"net/http"
+	"time"
+
+	"fourleaves.studio/manga-scraper/internal"
+	v1Handler "fourleaves.studio/manga-scraper/internal/rest/v1"
+	"github.com/getsentry/sentry-go"
+	"github.com/labstack/echo/v4"
+)
+
+// @Summary		Get paginated scrape requests
+// @Description	Get paginated scrape requests filtered by type, provider, series, status, error flag and creation time
+// @Security		TokenAuth
+// @Security		APIKeyAuth
+// @Tags			scrapers
+// @Produce		json
+// @Param			sort			query		string	false	"Sort by creation time"		example(desc)
+// @Param			page			query		string	true	"Page"						example(1)
+// @Param			size			query		string	true	"Size"						example(10)
+// @Param			type			query		string	false	"Request type"				example(CHAPTER_DETAIL)
+// @Param			provider		query		string	false	"Provider slug"				example(asura)
+// @Param			series			query		string	false	"Series slug"				example(reincarnator)
+// @Param			status			query		string	false	"Request status"			example(FAILED)
+// @Param			error			query		string	false	"Error flag"				example(true)
+// @Param			created_after	query		string	false	"Created at or after (RFC3339)"	example(2024-07-01T00:00:00Z)
+// @Param			created_before	query		string	false	"Created before (RFC3339)"		example(2024-07-02T00:00:00Z)
+// @Success		200				{object}	ResponseV1
+// @Failure		400				{object}	ResponseV1
+// @Failure		401				{object}	ResponseV1
+// @Failure		403				{object}	ResponseV1
+// @Failure		500				{object}	ResponseV1
+// @Router			/api/v1/scrapers [get]
+func (h *ScraperHandler) FindPaginated(c echo.Context) error {
+	span := newSentrySpan(c.Request().Context(), "v1.FindPaginated")
+	defer span.Finish()
+
+	var req FindScrapeRequestsRequest
+	err := c.Bind(&req)
+	if err != nil {
+		return v1Handler.RenderErrorResponse(c, "Invalid request", internal.WrapErrorf(err, internal.ErrInvalidInput, "bind request"), span)
+	}
+
+	err = c.Validate(&req)
+	if err != nil {
+		return v1Handler.RenderErrorResponse(c, "Invalid request", internal.WrapErrorf(err, internal.ErrInvalidInput, "validate request"), span)
+	}
+
+	params := internal.FindScrapeRequestParams{
+		Type:     internal.ScrapeRequestType(req.Type),
+		Provider: req.Provider,
+		Series:   req.Series,
+		Status:   internal.ScrapeRequestStatus(req.Status),
+		Order:    internal.NewSortOrder(req.Sort),
+		Page:     req.Page,
+		Size:     req.Size,
+	}
+
+	if req.Error != "" {
+		hasError := req.Error == "true"
+		params.Error = &hasError
+	}
+
+	// the validator already checked the format
+	if req.CreatedAfter != "" {
+		params.CreatedAfter, _ = time.Parse(time.RFC3339, req.CreatedAfter)
+	}
+
+	if req.CreatedBefore != "" {
+		params.CreatedBefore, _ = time.Parse(time.RFC3339, req.CreatedBefore)
+	}
+
+	page, err := h.svc.FindPaginated(c.Request().Context(), params)
+	if err != nil {
+		return v1Handler.RenderErrorResponse(c, "Failed to get scrape requests", err, span)
+	}
+
+	var prevPage, nextPage int
+
+	if req.Page >= 2 {
+		prevPage = req.Page - 1
+	}
+
+	if req.Page*req.Size < page.Total {
+		nextPage = req.Page + 1
+	}
+
+	result := PaginatedResponse{
+		PaginationData: PaginationData{
+			PrevPage: prevPage,
+			NextPage: nextPage,
+			Total:    page.Total,
+		},
+		Requests: page.Requests,
+	}
+
+	span.Status = sentry.SpanStatusOK
+	return c.JSON(http.StatusOK, v1Handler.Response{
+		Error:   false,
+		Message: "OK",
+		Data:    result,
+	})
+}
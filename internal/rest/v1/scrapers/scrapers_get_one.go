@@ -11,6 +11,7 @@ import (
 // @Summary		Get scrape request by ID
 // @Description	Get scrape request by ID
 // @Security		TokenAuth
+// @Security		APIKeyAuth
 // @Tags			scrapers
 // @Produce		json
 // @Param			id	path		string	true	"Request ID"	example(550e8400-e29b-41d4-a716-446655440000)
@@ -0,0 +1,42 @@
+package scrapers
+
+import (
+	"net/http"
+
+	v1Handler "fourleaves.studio/manga-scraper/internal/rest/v1"
+	"github.com/getsentry/sentry-go"
+	"github.com/labstack/echo/v4"
+)
+
+// @Summary		Cancel scrape request
+// @Description	Cancel a pending scrape request, the scraper worker skips it once it is consumed
+// @Security		TokenAuth
+// @Security		APIKeyAuth
+// @Tags			scrapers
+// @Produce		json
+// @Param			id	path		string	true	"Request ID"	example(550e8400-e29b-41d4-a716-446655440000)
+// @Success		200	{object}	ResponseV1
+// @Failure		400	{object}	ResponseV1
+// @Failure		401	{object}	ResponseV1
+// @Failure		403	{object}	ResponseV1
+// @Failure		404	{object}	ResponseV1
+// @Failure		500	{object}	ResponseV1
+// @Router			/api/v1/scrapers/{id}/_cancel [post]
+func (h *ScraperHandler) Cancel(c echo.Context) error {
+	span := newSentrySpan(c.Request().Context(), "v1.Cancel")
+	defer span.Finish()
+
+	id := c.Param("id")
+
+	receipt, err := h.svc.Cancel(c.Request().Context(), id)
+	if err != nil {
+		return v1Handler.RenderErrorResponse(c, "Failed to cancel scrape request", err, span)
+	}
+
+	span.Status = sentry.SpanStatusOK
+	return c.JSON(http.StatusOK, v1Handler.Response{
+		Error:   false,
+		Message: "OK",
+		Data:    receipt,
+	})
+}
@@ -13,6 +13,7 @@ import (
 // @Summary		Create scrape request
 // @Description	Create scrape request
 // @Security		TokenAuth
+// @Security		APIKeyAuth
 // @Tags			scrapers
 // @Accept			json
 // @Produce		json
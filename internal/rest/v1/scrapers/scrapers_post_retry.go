@@ -0,0 +1,78 @@
+package scrapers
+
+import (
+	"net/http"
+	"time"
+
+	"fourleaves.studio/manga-scraper/internal"
+	v1Handler "fourleaves.studio/manga-scraper/internal/rest/v1"
+	"github.com/getsentry/sentry-go"
+	"github.com/labstack/echo/v4"
+)
+
+// @Summary		Retry failed scrape requests
+// @Description	Publish matching failed scrape requests again, requests whose target is already pending are skipped
+// @Security		TokenAuth
+// @Security		APIKeyAuth
+// @Tags			scrapers
+// @Accept			json
+// @Produce		json
+// @Param			body	body		RetryScrapeRequestsRequest	true	"Request body"
+// @Success		202		{object}	ResponseV1
+// @Failure		400		{object}	ResponseV1
+// @Failure		401		{object}	ResponseV1
+// @Failure		403		{object}	ResponseV1
+// @Failure		500		{object}	ResponseV1
+// @Router			/api/v1/scrapers/_retry [post]
+func (h *ScraperHandler) Retry(c echo.Context) error {
+	span := newSentrySpan(c.Request().Context(), "v1.Retry")
+	defer span.Finish()
+
+	var req RetryScrapeRequestsRequest
+	err := c.Bind(&req)
+	if err != nil {
+		return v1Handler.RenderErrorResponse(c, "Invalid request", internal.WrapErrorf(err, internal.ErrInvalidInput, "bind request"), span)
+	}
+
+	err = c.Validate(&req)
+	if err != nil {
+		return v1Handler.RenderErrorResponse(c, "Invalid request", internal.WrapErrorf(err, internal.ErrInvalidInput, "validate request"), span)
+	}
+
+	params := internal.RetryScrapeRequestParams{
+		Type:     internal.ScrapeRequestType(req.Type),
+		Provider: req.Provider,
+		Status:   internal.ScrapeRequestStatus(req.Status),
+		Message:  req.Message,
+		Limit:    req.Limit,
+	}
+
+	if params.Status == "" {
+		params.Status = internal.FailedRequestStatus
+	}
+
+	if params.Limit == 0 {
+		params.Limit = internal.MaxRetryScrapeRequests
+	}
+
+	// the validator already checked the format
+	if req.CreatedAfter != "" {
+		params.CreatedAfter, _ = time.Parse(time.RFC3339, req.CreatedAfter)
+	}
+
+	if req.CreatedBefore != "" {
+		params.CreatedBefore, _ = time.Parse(time.RFC3339, req.CreatedBefore)
+	}
+
+	result, err := h.svc.Retry(c.Request().Context(), params)
+	if err != nil {
+		return v1Handler.RenderErrorResponse(c, "Failed to retry scrape requests", err, span)
+	}
+
+	span.Status = sentry.SpanStatusOK
+	return c.JSON(http.StatusAccepted, v1Handler.Response{
+		Error:   false,
+		Message: "Accepted",
+		Data:    result,
+	})
+}
@@ -0,0 +1,97 @@
+package scrapers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"fourleaves.studio/manga-scraper/internal"
+	"fourleaves.studio/manga-scraper/internal/config"
+	"fourleaves.studio/manga-scraper/internal/rest/middlewares"
+	"github.com/labstack/echo/v4"
+)
+
+type fakeAPIKeys struct {
+	keys map[string]internal.APIKey
+}
+
+func (f *fakeAPIKeys) Authenticate(_ context.Context, secret string) (internal.APIKey, error) {
+	key, ok := f.keys[secret]
+	if !ok {
+		return internal.APIKey{}, internal.NewErrorf(internal.ErrNotFound, "api key not found")
+	}
+
+	return key, nil
+}
+
+func (f *fakeAPIKeys) RecordUsage(_ context.Context, _ string) (internal.APIKeyUsage, error) {
+	return internal.APIKeyUsage{}, nil
+}
+
+type fakeScraperService struct {
+	ScraperService
+}
+
+func (f *fakeScraperService) Find(_ context.Context, id string) (internal.ScrapeRequest, error) {
+	return internal.ScrapeRequest{ID: id}, nil
+}
+
+func (f *fakeScraperService) Cancel(_ context.Context, id string) (internal.ScrapeRequest, error) {
+	return internal.ScrapeRequest{ID: id}, nil
+}
+
+func (f *fakeScraperService) Delete(_ context.Context, _ string) error {
+	return nil
+}
+
+func TestScraperHandler_Scopes(t *testing.T) {
+	mid := middlewares.NewMiddleware(&config.Config{}, &fakeAPIKeys{
+		keys: map[string]internal.APIKey{
+			"msk_read":   {ID: "1", Scopes: []internal.APIKeyScope{internal.ReadAPIKeyScope}},
+			"msk_scrape": {ID: "2", Scopes: []internal.APIKeyScope{internal.ScrapeAPIKeyScope}},
+			"msk_admin":  {ID: "3", Scopes: []internal.APIKeyScope{internal.AdminAPIKeyScope}},
+		},
+	})
+
+	router := echo.New()
+	router.Use(mid.WithAPIKey)
+
+	NewScraperHandler(&fakeScraperService{}, nil, nil, nil).Register(router.Group("/api/v1/scrapers"), mid)
+
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		secret     string
+		wantStatus int
+	}{
+		{"Find without a key", http.MethodGet, "/api/v1/scrapers/1", "", http.StatusUnauthorized},
+		{"Find with a read key", http.MethodGet, "/api/v1/scrapers/1", "msk_read", http.StatusForbidden},
+		{"Find with a scrape key", http.MethodGet, "/api/v1/scrapers/1", "msk_scrape", http.StatusOK},
+		{"Find with an admin key", http.MethodGet, "/api/v1/scrapers/1", "msk_admin", http.StatusOK},
+		{"Cancel with a read key", http.MethodPost, "/api/v1/scrapers/1/_cancel", "msk_read", http.StatusForbidden},
+		{"Cancel with a scrape key", http.MethodPost, "/api/v1/scrapers/1/_cancel", "msk_scrape", http.StatusOK},
+		{"Delete with a scrape key", http.MethodDelete, "/api/v1/scrapers/1", "msk_scrape", http.StatusForbidden},
+		{"Delete with an admin key", http.MethodDelete, "/api/v1/scrapers/1", "msk_admin", http.StatusOK},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest(tc.method, tc.path, nil)
+			if tc.secret != "" {
+				req.Header.Set(middlewares.HeaderAPIKey, tc.secret)
+			}
+
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			if rec.Code != tc.wantStatus {
+				t.Errorf("expected status %d, got %d", tc.wantStatus, rec.Code)
+			}
+		})
+	}
+}
@@ -2,6 +2,8 @@ package series
 
 import (
 	"context"
+	"strings"
+	"time"
 
 	"fourleaves.studio/manga-scraper/internal"
 	"fourleaves.studio/manga-scraper/internal/rest/middlewares"
@@ -11,48 +13,98 @@ import (
 
 type Service interface {
 	CreateInit(ctx context.Context, params internal.CreateInitSeriesParams) (internal.Series, error)
-	Search(ctx context.Context, q string) ([]internal.Series, error)
-	Index(ctx context.Context, series []internal.Series) error
+	Search(ctx context.Context, params internal.SearchSeriesParams) (internal.SeriesSearchResult, error)
+	Suggest(ctx context.Context, params internal.SuggestSeriesParams) ([]internal.SeriesSuggestion, error)
 	Find(ctx context.Context, params internal.FindSeriesParams) (internal.Series, error)
 	FindBC(ctx context.Context, params internal.FindSeriesParams) (internal.SeriesBC, error)
 	FindAll(ctx context.Context, params internal.FindSeriesParams) ([]internal.Series, error)
-	FindPaginated(ctx context.Context, params internal.FindSeriesParams) ([]internal.Series, error)
+	FindPaginated(ctx context.Context, params internal.FindSeriesParams) (internal.SeriesPage, error)
+	Browse(ctx context.Context, params internal.BrowseSeriesParams) (internal.SeriesPage, error)
 	UpdateInit(ctx context.Context, params internal.UpdateInitSeriesParams) (internal.Series, error)
 	UpdateLatest(ctx context.Context, params internal.UpdateLatestSeriesParams) (internal.Series, error)
+	SyncChapters(ctx context.Context, params internal.FindSeriesParams) (internal.Series, error)
 	Delete(ctx context.Context, params internal.FindSeriesParams) error
 }
 
+// ReindexService rebuilds the search index of a provider in the background
+type ReindexService interface {
+	Start(ctx context.Context, provider string) (internal.ReindexJob, error)
+	Find(ctx context.Context, id string) (internal.ReindexJob, error)
+}
+
 type Handler struct {
-	svc Service
+	svc     Service
+	reindex ReindexService
 }
 
-func NewSeriesHandler(svc Service) *Handler {
+func NewSeriesHandler(svc Service, reindex ReindexService) *Handler {
 	return &Handler{
-		svc: svc,
+		svc:     svc,
+		reindex: reindex,
 	}
 }
 
 func (h *Handler) Register(g *echo.Group, mid *middlewares.Middleware) {
 	g.GET("", h.Search)
+	g.GET("/_suggest", h.Suggest)
+	g.GET("/_reindex/:id", h.FindReindexJob, mid.IsAdmin)
 	g.PUT("/:provider_slug", h.Index, mid.IsAdmin)
 	g.GET("/:provider_slug", h.FindPaginated)
 	g.GET("/:provider_slug/_all", h.FindAll)
 	g.GET("/:provider_slug/:series_slug", h.Find)
 	g.GET("/:provider_slug/:series_slug/_bc", h.FindBC)
-	// g.PUT("/:provider_slug/:series_slug/_chc", h.PutSeriesChaptersCount /* , middlewares.IsAdmin(s.config.AdminSub) */)
-	// g.PUT("/:provider_slug/:series_slug/_lch", h.PutSeriesLastChapter /* , middlewares.IsAdmin(s.config.AdminSub) */)
+	g.PUT("/:provider_slug/:series_slug/_sync", h.SyncChapters, mid.IsAdmin)
+}
+
+// SearchRequest pages search hits by offset, hits are ranked by score and have no stable key for a cursor
+type SearchRequest struct {
+	Q        string   `query:"q" validate:"required" example:"solo lev"`
+	Provider string   `query:"provider" example:"asura"`
+	Genres   []string `query:"genres" example:"action,fantasy"`
+	Status   string   `query:"status" validate:"omitempty,oneof=ONGOING COMPLETED HIATUS DROPPED" example:"ONGOING"`
+	From     int      `query:"from" validate:"omitempty,gte=0" example:"0"`
+	Size     int      `query:"size" validate:"omitempty,gt=0,lte=100" example:"10"`
+}
+
+type SuggestRequest struct {
+	Q    string `query:"q" validate:"required" example:"solo lev"`
+	Size int    `query:"size" validate:"omitempty,gt=0,lte=20" example:"5"`
+}
+
+// SearchResponse is a page of search hits, DidYouMean corrects the spelling of a query without hits
+type SearchResponse struct {
+	From       int                        `json:"from"`
+	Size       int                        `json:"size"`
+	Total      int                        `json:"total"`
+	Hits       []internal.SeriesSearchHit `json:"hits"`
+	DidYouMean string                     `json:"didYouMean,omitempty"`
+}
+
+// BrowseRequest filters and sorts series lists, genres are repeated or comma separated
+type BrowseRequest struct {
+	Genres       []string `query:"genres" example:"action,fantasy"`
+	GenresMatch  string   `query:"genres_match" validate:"omitempty,oneof=any all" example:"any"`
+	Status       string   `query:"status" validate:"omitempty,oneof=ONGOING COMPLETED HIATUS DROPPED" example:"ONGOING"`
+	MinChapters  int      `query:"min_chapters" validate:"omitempty,gte=0" example:"10"`
+	UpdatedSince string   `query:"updated_since" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00" example:"2024-07-01T00:00:00Z"`
+	SortBy       string   `query:"sort_by" validate:"omitempty,oneof=slug title chapters updated latest" example:"latest"`
 }
 
+// PaginatedRequest pages by cursor, page is only used without a cursor and kept for older clients
 type PaginatedRequest struct {
-	Sort string `query:"sort" validate:"omitempty,oneof=asc desc" example:"asc"`
-	Page int    `query:"page" validate:"required,gt=0" example:"1"`
-	Size int    `query:"size" validate:"required,gt=0,lte=100" example:"10"`
+	BrowseRequest
+	Sort   string `query:"sort" validate:"omitempty,oneof=asc desc" example:"asc"`
+	Cursor string `query:"cursor" example:"eyJzIjoicmVpbmNhcm5hdG9yIn0"`
+	Page   int    `query:"page" validate:"omitempty,gt=0" example:"1"`
+	Size   int    `query:"size" validate:"required,gt=0,lte=100" example:"10"`
 }
 
 type PaginationData struct {
-	PrevPage int `json:"prevPage,omitempty"`
-	NextPage int `json:"nextPage,omitempty"`
-	Total    int `json:"total,omitempty"`
+	PrevPage   int    `json:"prevPage,omitempty"`
+	NextPage   int    `json:"nextPage,omitempty"`
+	PrevCursor string `json:"prevCursor,omitempty"`
+	NextCursor string `json:"nextCursor,omitempty"`
+	Total      int    `json:"total"`
 }
 
 type PaginatedResponse struct {
@@ -60,6 +112,40 @@ type PaginatedResponse struct {
 	Series []internal.Series `json:"series"`
 }
 
+// newBrowseSeriesParams returns the browse params of a validated request, an empty provider browses every provider
+func newBrowseSeriesParams(provider string, req PaginatedRequest) internal.BrowseSeriesParams {
+	// validated against the same layout
+	updatedSince, _ := time.Parse(time.RFC3339, req.UpdatedSince)
+
+	return internal.BrowseSeriesParams{
+		Provider:     provider,
+		Genres:       splitGenres(req.Genres),
+		GenresMatch:  internal.GenresMatch(req.GenresMatch),
+		Status:       internal.SeriesStatus(req.Status),
+		MinChapters:  req.MinChapters,
+		UpdatedSince: updatedSince,
+		Sort:         internal.SeriesSort(req.SortBy),
+		Order:        internal.NewSortOrder(req.Sort),
+		Cursor:       req.Cursor,
+		Size:         req.Size,
+	}
+}
+
+// splitGenres flattens genres given as repeated or comma separated query values
+func splitGenres(values []string) []string {
+	var genres []string
+
+	for _, value := range values {
+		for _, genre := range strings.Split(value, ",") {
+			if genre = strings.TrimSpace(genre); genre != "" {
+				genres = append(genres, genre)
+			}
+		}
+	}
+
+	return genres
+}
+
 func newSentrySpan(ctx context.Context, operation string) *sentry.Span {
 	span := sentry.StartSpan(ctx, operation)
 	span.Name = "fourleaves.studio/manga-scraper/internal/rest/v1/series"
@@ -1,11 +1,8 @@
 package series
 
 import (
-	"net/http"
-
 	"fourleaves.studio/manga-scraper/internal"
 	v1Handler "fourleaves.studio/manga-scraper/internal/rest/v1"
-	"github.com/getsentry/sentry-go"
 	"github.com/labstack/echo/v4"
 )
 
@@ -16,6 +13,7 @@ import (
 // @Param			provider_slug	path		string	true	"Provider slug"	example(asura)
 // @Param			sort			query		string	false	"Sort order"	enum(asc, desc)	default(asc)
 // @Success		200				{object}	ResponseV1
+// @Success		304				"Not Modified"
 // @Failure		404				{object}	ResponseV1
 // @Failure		500				{object}	ResponseV1
 // @Router			/api/v1/series/{provider_slug}/_all [get]
@@ -36,10 +34,5 @@ func (h *Handler) FindAll(c echo.Context) error {
 		return v1Handler.RenderErrorResponse(c, "Failed to get series", err, span)
 	}
 
-	span.Status = sentry.SpanStatusOK
-	return c.JSON(http.StatusOK, v1Handler.Response{
-		Error:   false,
-		Message: "OK",
-		Data:    seriesList,
-	})
+	return v1Handler.RenderCachedResponse(c, seriesList, v1Handler.CacheControlList, span)
 }
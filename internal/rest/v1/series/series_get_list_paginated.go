@@ -1,22 +1,28 @@
 package series
 
 import (
-	"net/http"
-
 	"fourleaves.studio/manga-scraper/internal"
 	v1Handler "fourleaves.studio/manga-scraper/internal/rest/v1"
-	"github.com/getsentry/sentry-go"
 	"github.com/labstack/echo/v4"
 )
 
 // @Summary		Get paginated series list
-// @Description	Get paginated series list
+// @Description	Get paginated series list, filtered and sorted lists are paged by cursor only
 // @Tags			series
 // @Produce		json
 // @Param			provider_slug	path		string	true	"Provider slug"	example(asura)
-// @Param			page			query		string	true	"Page"			example(10)
+// @Param			cursor			query		string	false	"Cursor from a previous page, takes precedence over page"
+// @Param			page			query		string	false	"Page, only used without a cursor and filters"	example(10)
+// @Param			genres			query		[]string	false	"Genres, repeated or comma separated"	collectionFormat(multi)
+// @Param			genres_match	query		string	false	"Match any or all genres"	Enums(any, all)
+// @Param			status			query		string	false	"Status"	Enums(ONGOING, COMPLETED, HIATUS, DROPPED)
+// @Param			min_chapters	query		int		false	"Minimum chapters count"	example(10)
+// @Param			updated_since	query		string	false	"Updated since, RFC 3339"	example(2024-07-01T00:00:00Z)
+// @Param			sort_by			query		string	false	"Sort by"	Enums(slug, title, chapters, updated, latest)
+// @Param			sort			query		string	false	"Sort order"	Enums(asc, desc)
 // @Param			size			query		string	true	"Size"			example(100)
 // @Success		200				{object}	ResponseV1
+// @Success		304				"Not Modified"
 // @Failure		400				{object}	ResponseV1
 // @Failure		404				{object}	ResponseV1
 // @Failure		500				{object}	ResponseV1
@@ -43,38 +49,45 @@ func (h *Handler) FindPaginated(c echo.Context) error {
 		Order:    internal.NewSortOrder(req.Sort),
 		Page:     req.Page,
 		Size:     req.Size,
+		Cursor:   req.Cursor,
 	}
 
-	series, err := h.svc.FindPaginated(c.Request().Context(), params)
+	var page internal.SeriesPage
+
+	// filtered or sorted lists are only paged by cursor
+	browse := newBrowseSeriesParams(providerSlug, req)
+	if browse.IsDefault() {
+		page, err = h.svc.FindPaginated(c.Request().Context(), params)
+	} else {
+		page, err = h.svc.Browse(c.Request().Context(), browse)
+	}
 	if err != nil {
 		return v1Handler.RenderErrorResponse(c, "Failed to get series", err, span)
 	}
 
-	var prevPage, nextPage, total int
+	var prevPage, nextPage int
 
-	if req.Page >= 2 {
-		prevPage = req.Page - 1
-	}
+	// page numbers are only returned to clients paging by offset
+	if browse.IsDefault() && req.Cursor == "" && req.Page > 0 {
+		if req.Page >= 2 {
+			prevPage = req.Page - 1
+		}
 
-	if len(series) == req.Size {
-		nextPage = req.Page + 1
+		if page.NextCursor != "" {
+			nextPage = req.Page + 1
+		}
 	}
 
-	total = len(series)
-
 	result := PaginatedResponse{
 		PaginationData: PaginationData{
-			PrevPage: prevPage,
-			NextPage: nextPage,
-			Total:    total,
+			PrevPage:   prevPage,
+			NextPage:   nextPage,
+			PrevCursor: page.PrevCursor,
+			NextCursor: page.NextCursor,
+			Total:      page.Total,
 		},
-		Series: series,
+		Series: page.Series,
 	}
 
-	span.Status = sentry.SpanStatusOK
-	return c.JSON(http.StatusOK, v1Handler.Response{
-		Error:   false,
-		Message: "OK",
-		Data:    result,
-	})
+	return v1Handler.RenderCachedResponse(c, result, v1Handler.CacheControlList, span)
 }
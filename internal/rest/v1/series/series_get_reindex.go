@@ -0,0 +1,39 @@
+package series
+
+import (
+	"net/http"
+
+	v1Handler "fourleaves.studio/manga-scraper/internal/rest/v1"
+	"github.com/getsentry/sentry-go"
+	"github.com/labstack/echo/v4"
+)
+
+// @Summary		Get reindex job
+// @Description	Get the status and progress of a search reindex job
+// @Security		TokenAuth
+// @Security		APIKeyAuth
+// @Tags			series
+// @Produce		json
+// @Param			id	path		string	true	"Job ID"
+// @Success		200	{object}	ResponseV1
+// @Failure		401	{object}	ResponseV1
+// @Failure		403	{object}	ResponseV1
+// @Failure		404	{object}	ResponseV1
+// @Failure		500	{object}	ResponseV1
+// @Router			/api/v1/series/_reindex/{id} [get]
+func (h *Handler) FindReindexJob(c echo.Context) error {
+	span := newSentrySpan(c.Request().Context(), "v1.FindReindexJob")
+	defer span.Finish()
+
+	job, err := h.reindex.Find(c.Request().Context(), c.Param("id"))
+	if err != nil {
+		return v1Handler.RenderErrorResponse(c, "Failed to get reindex job", err, span)
+	}
+
+	span.Status = sentry.SpanStatusOK
+	return c.JSON(http.StatusOK, v1Handler.Response{
+		Error:   false,
+		Message: "OK",
+		Data:    job,
+	})
+}
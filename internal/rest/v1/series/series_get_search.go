@@ -1,38 +1,106 @@
 package series
 
 import (
-	"net/http"
-
+	"fourleaves.studio/manga-scraper/internal"
 	v1Handler "fourleaves.studio/manga-scraper/internal/rest/v1"
 	"github.com/getsentry/sentry-go"
 	"github.com/labstack/echo/v4"
 )
 
 // @Summary		Get series search result
-// @Description	Get series search result
+// @Description	Get series search result, without a query the series of every provider are browsed by cursor
 // @Tags			series
 // @Produce		json
-// @Param			q	query		string	true	"Query"	example(warrior high school)
-// @Success		200	{object}	ResponseV1
-// @Failure		400	{object}	ResponseV1
-// @Failure		404	{object}	ResponseV1
-// @Failure		500	{object}	ResponseV1
+// @Param			q				query		string	false	"Query"	example(solo lev)
+// @Param			provider		query		string	false	"Provider slug, only used with a query"	example(asura)
+// @Param			from			query		int		false	"Offset of the hits, only used with a query"	example(0)
+// @Param			cursor			query		string	false	"Cursor from a previous page, only used without a query"
+// @Param			size			query		string	false	"Size, required without a query"	example(100)
+// @Param			genres			query		[]string	false	"Genres, repeated or comma separated"	collectionFormat(multi)
+// @Param			genres_match	query		string	false	"Match any or all genres, only used without a query"	Enums(any, all)
+// @Param			status			query		string	false	"Status"	Enums(ONGOING, COMPLETED, HIATUS, DROPPED)
+// @Param			min_chapters	query		int		false	"Minimum chapters count, only used without a query"	example(10)
+// @Param			updated_since	query		string	false	"Updated since, RFC 3339, only used without a query"	example(2024-07-01T00:00:00Z)
+// @Param			sort_by			query		string	false	"Sort by, only used without a query"	Enums(slug, title, chapters, updated, latest)
+// @Param			sort			query		string	false	"Sort order, only used without a query"	Enums(asc, desc)
+// @Success		200				{object}	ResponseV1
+// @Success		304				"Not Modified"
+// @Failure		400				{object}	ResponseV1
+// @Failure		404				{object}	ResponseV1
+// @Failure		500				{object}	ResponseV1
 // @Router			/api/v1/series [get]
 func (h *Handler) Search(c echo.Context) error {
 	span := newSentrySpan(c.Request().Context(), "v1.Search")
 	defer span.Finish()
 
-	q := c.QueryParam("q")
+	if c.QueryParam("q") == "" {
+		return h.browse(c, span)
+	}
 
-	result, err := h.svc.Search(c.Request().Context(), q)
+	var req SearchRequest
+	err := c.Bind(&req)
 	if err != nil {
-		return v1Handler.RenderErrorResponse(c, "Failed to search series", err, span)
+		return v1Handler.RenderErrorResponse(c, "Invalid request", internal.WrapErrorf(err, internal.ErrInvalidInput, "bind request"), span)
+	}
+
+	err = c.Validate(&req)
+	if err != nil {
+		return v1Handler.RenderErrorResponse(c, "Invalid request", internal.WrapErrorf(err, internal.ErrInvalidInput, "validate request"), span)
+	}
+
+	if req.Size == 0 {
+		req.Size = 10
 	}
 
-	span.Status = sentry.SpanStatusOK
-	return c.JSON(http.StatusOK, v1Handler.Response{
-		Error:   false,
-		Message: "OK",
-		Data:    result,
+	search, err := h.svc.Search(c.Request().Context(), internal.SearchSeriesParams{
+		Query:    req.Q,
+		Provider: req.Provider,
+		Genres:   splitGenres(req.Genres),
+		Status:   internal.SeriesStatus(req.Status),
+		From:     req.From,
+		Size:     req.Size,
 	})
+	if err != nil {
+		return v1Handler.RenderErrorResponse(c, "Failed to search series", err, span)
+	}
+
+	result := SearchResponse{
+		From:       req.From,
+		Size:       req.Size,
+		Total:      search.Total,
+		Hits:       search.Hits,
+		DidYouMean: search.DidYouMean,
+	}
+
+	return v1Handler.RenderCachedResponse(c, result, v1Handler.CacheControlList, span)
+}
+
+// browse serves the series of every provider for requests without a search query
+func (h *Handler) browse(c echo.Context, span *sentry.Span) error {
+	var req PaginatedRequest
+	err := c.Bind(&req)
+	if err != nil {
+		return v1Handler.RenderErrorResponse(c, "Invalid request", internal.WrapErrorf(err, internal.ErrInvalidInput, "bind request"), span)
+	}
+
+	err = c.Validate(&req)
+	if err != nil {
+		return v1Handler.RenderErrorResponse(c, "Invalid request", internal.WrapErrorf(err, internal.ErrInvalidInput, "validate request"), span)
+	}
+
+	page, err := h.svc.Browse(c.Request().Context(), newBrowseSeriesParams("", req))
+	if err != nil {
+		return v1Handler.RenderErrorResponse(c, "Failed to get series", err, span)
+	}
+
+	result := PaginatedResponse{
+		PaginationData: PaginationData{
+			PrevCursor: page.PrevCursor,
+			NextCursor: page.NextCursor,
+			Total:      page.Total,
+		},
+		Series: page.Series,
+	}
+
+	return v1Handler.RenderCachedResponse(c, result, v1Handler.CacheControlList, span)
 }
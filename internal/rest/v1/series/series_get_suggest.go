@@ -0,0 +1,48 @@
+package series
+
+import (
+	"fourleaves.studio/manga-scraper/internal"
+	v1Handler "fourleaves.studio/manga-scraper/internal/rest/v1"
+	"github.com/labstack/echo/v4"
+)
+
+// @Summary		Get series title suggestions
+// @Description	Get title completions across providers, tolerating typos, for search-as-you-type
+// @Tags			series
+// @Produce		json
+// @Param			q		query		string	true	"Partly typed title"	example(solo lev)
+// @Param			size	query		int		false	"Size, 5 when empty"	example(5)
+// @Success		200		{object}	ResponseV1
+// @Success		304		"Not Modified"
+// @Failure		400		{object}	ResponseV1
+// @Failure		500		{object}	ResponseV1
+// @Router			/api/v1/series/_suggest [get]
+func (h *Handler) Suggest(c echo.Context) error {
+	span := newSentrySpan(c.Request().Context(), "v1.Suggest")
+	defer span.Finish()
+
+	var req SuggestRequest
+	err := c.Bind(&req)
+	if err != nil {
+		return v1Handler.RenderErrorResponse(c, "Invalid request", internal.WrapErrorf(err, internal.ErrInvalidInput, "bind request"), span)
+	}
+
+	err = c.Validate(&req)
+	if err != nil {
+		return v1Handler.RenderErrorResponse(c, "Invalid request", internal.WrapErrorf(err, internal.ErrInvalidInput, "validate request"), span)
+	}
+
+	if req.Size == 0 {
+		req.Size = 5
+	}
+
+	result, err := h.svc.Suggest(c.Request().Context(), internal.SuggestSeriesParams{
+		Query: req.Q,
+		Size:  req.Size,
+	})
+	if err != nil {
+		return v1Handler.RenderErrorResponse(c, "Failed to suggest series", err, span)
+	}
+
+	return v1Handler.RenderCachedResponse(c, result, v1Handler.CacheControlList, span)
+}
@@ -0,0 +1,46 @@
+package series
+
+import (
+	"net/http"
+
+	"fourleaves.studio/manga-scraper/internal"
+	v1Handler "fourleaves.studio/manga-scraper/internal/rest/v1"
+	"github.com/getsentry/sentry-go"
+	"github.com/labstack/echo/v4"
+)
+
+// @Summary		Sync series chapters count and latest chapter
+// @Description	Recompute the chapters count and latest chapter of the series from its chapters and reindex it
+// @Security		TokenAuth
+// @Security		APIKeyAuth
+// @Tags			series
+// @Produce		json
+// @Param			provider_slug	path		string	true	"Provider slug"	example(asura)
+// @Param			series_slug		path		string	true	"Series slug"	example(reincarnator)
+// @Success		200				{object}	ResponseV1
+// @Failure		401				{object}	ResponseV1
+// @Failure		403				{object}	ResponseV1
+// @Failure		404				{object}	ResponseV1
+// @Failure		500				{object}	ResponseV1
+// @Router			/api/v1/series/{provider_slug}/{series_slug}/_sync [put]
+func (h *Handler) SyncChapters(c echo.Context) error {
+	span := newSentrySpan(c.Request().Context(), "v1.SyncChapters")
+	defer span.Finish()
+
+	params := internal.FindSeriesParams{
+		Provider: c.Param("provider_slug"),
+		Slug:     c.Param("series_slug"),
+	}
+
+	series, err := h.svc.SyncChapters(c.Request().Context(), params)
+	if err != nil {
+		return v1Handler.RenderErrorResponse(c, "Failed to sync series", err, span)
+	}
+
+	span.Status = sentry.SpanStatusOK
+	return c.JSON(http.StatusOK, v1Handler.Response{
+		Error:   false,
+		Message: "OK",
+		Data:    series,
+	})
+}
@@ -0,0 +1,115 @@
+package webhooks
+
+import (
+	"context"
+
+	"fourleaves.studio/manga-scraper/internal"
+	"fourleaves.studio/manga-scraper/internal/rest/middlewares"
+	"github.com/getsentry/sentry-go"
+	"github.com/labstack/echo/v4"
+)
+
+type WebhookService interface {
+	Create(ctx context.Context, params internal.WebhookSubscriptionParams) (internal.WebhookSubscription, error)
+	Find(ctx context.Context, id string) (internal.WebhookSubscription, error)
+	FindAll(ctx context.Context) ([]internal.WebhookSubscription, error)
+	Update(ctx context.Context, params internal.WebhookSubscriptionParams) (internal.WebhookSubscription, error)
+	Delete(ctx context.Context, id string) error
+	FindDeliveries(ctx context.Context, params internal.FindWebhookDeliveriesParams) ([]internal.WebhookDelivery, error)
+}
+
+type ProviderService interface {
+	Find(ctx context.Context, slug string) (internal.Provider, error)
+}
+
+type SeriesService interface {
+	Find(ctx context.Context, params internal.FindSeriesParams) (internal.Series, error)
+}
+
+type WebhookHandler struct {
+	svc      WebhookService
+	provider ProviderService
+	series   SeriesService
+}
+
+func NewWebhookHandler(
+	svc WebhookService,
+	provider ProviderService,
+	series SeriesService,
+) *WebhookHandler {
+	return &WebhookHandler{
+		svc:      svc,
+		provider: provider,
+		series:   series,
+	}
+}
+
+func (h *WebhookHandler) Register(g *echo.Group, mid *middlewares.Middleware) {
+	g.POST("", h.Create, mid.IsAdmin)
+	g.GET("", h.FindAll, mid.IsAdmin)
+	g.GET("/:id", h.Find, mid.IsAdmin)
+	g.PUT("/:id", h.Update, mid.IsAdmin)
+	g.DELETE("/:id", h.Delete, mid.IsAdmin)
+	g.GET("/:id/deliveries", h.FindDeliveries, mid.IsAdmin)
+}
+
+type CreateWebhookRequest struct {
+	URL      string `json:"url" validate:"required,url" example:"https://example.com/hooks/chapters"`
+	Secret   string `json:"secret,omitempty" validate:"omitempty,min=16" example:"a-long-random-shared-secret"`
+	Provider string `json:"provider,omitempty" example:"asura"`
+	Series   string `json:"series,omitempty" validate:"excluded_without=Provider" example:"reincarnator"`
+	IsActive *bool  `json:"is_active,omitempty" example:"true"`
+} // @name CreateWebhookRequest
+
+type UpdateWebhookRequest struct {
+	URL      string `json:"url" validate:"required,url" example:"https://example.com/hooks/chapters"`
+	Secret   string `json:"secret,omitempty" validate:"omitempty,min=16" example:"a-long-random-shared-secret"`
+	Provider string `json:"provider,omitempty" example:"asura"`
+	Series   string `json:"series,omitempty" validate:"excluded_without=Provider" example:"reincarnator"`
+	IsActive *bool  `json:"is_active" validate:"required" example:"true"`
+} // @name UpdateWebhookRequest
+
+type FindDeliveriesRequest struct {
+	Page   int    `query:"page" validate:"required,gt=0" example:"1"`
+	Size   int    `query:"size" validate:"required,gt=0,lte=100" example:"10"`
+	Status string `query:"status" validate:"omitempty,oneof=PENDING SUCCEEDED FAILED" example:"FAILED"`
+}
+
+type PaginationData struct {
+	PrevPage int `json:"prevPage,omitempty"`
+	NextPage int `json:"nextPage,omitempty"`
+	Total    int `json:"total,omitempty"`
+}
+
+type DeliveriesResponse struct {
+	PaginationData
+	Deliveries []internal.WebhookDelivery `json:"deliveries"`
+}
+
+// checkFilters makes sure the provider and series a subscription is filtered by exist
+func (h *WebhookHandler) checkFilters(ctx context.Context, provider, series string) (string, error) {
+	if provider == "" {
+		return "", nil
+	}
+
+	if _, err := h.provider.Find(ctx, provider); err != nil {
+		return "Failed to find provider", err
+	}
+
+	if series == "" {
+		return "", nil
+	}
+
+	if _, err := h.series.Find(ctx, internal.FindSeriesParams{Provider: provider, Slug: series}); err != nil {
+		return "Failed to find series", err
+	}
+
+	return "", nil
+}
+
+func newSentrySpan(ctx context.Context, operation string) *sentry.Span {
+	span := sentry.StartSpan(ctx, operation)
+	span.Name = "fourleaves.studio/manga-scraper/internal/rest/v1/webhooks"
+
+	return span
+}
@@ -0,0 +1,85 @@
+package webhooks
+
+import (
+	"net/http"
+
+	"fourleaves.studio/manga-scraper/internal"
+	v1Handler "fourleaves.studio/manga-scraper/internal/rest/v1"
+	"github.com/getsentry/sentry-go"
+	"github.com/labstack/echo/v4"
+)
+
+// @Summary		Get webhook deliveries
+// @Description	Get the delivery log of a webhook subscription, newest first
+// @Security		TokenAuth
+// @Security		APIKeyAuth
+// @Tags			webhooks
+// @Produce		json
+// @Param			id		path		string	true	"Subscription ID"	example(550e8400-e29b-41d4-a716-446655440000)
+// @Param			page	query		string	true	"Page"				example(1)
+// @Param			size	query		string	true	"Size"				example(10)
+// @Param			status	query		string	false	"Delivery status"	example(FAILED)
+// @Success		200		{object}	ResponseV1
+// @Failure		400		{object}	ResponseV1
+// @Failure		401		{object}	ResponseV1
+// @Failure		403		{object}	ResponseV1
+// @Failure		404		{object}	ResponseV1
+// @Failure		500		{object}	ResponseV1
+// @Router			/api/v1/webhooks/{id}/deliveries [get]
+func (h *WebhookHandler) FindDeliveries(c echo.Context) error {
+	span := newSentrySpan(c.Request().Context(), "v1.FindDeliveries")
+	defer span.Finish()
+
+	var req FindDeliveriesRequest
+	err := c.Bind(&req)
+	if err != nil {
+		return v1Handler.RenderErrorResponse(c, "Invalid request", internal.WrapErrorf(err, internal.ErrInvalidInput, "bind request"), span)
+	}
+
+	err = c.Validate(&req)
+	if err != nil {
+		return v1Handler.RenderErrorResponse(c, "Invalid request", internal.WrapErrorf(err, internal.ErrInvalidInput, "validate request"), span)
+	}
+
+	id := c.Param("id")
+
+	if _, err := h.svc.Find(c.Request().Context(), id); err != nil {
+		return v1Handler.RenderErrorResponse(c, "Failed to get webhook subscription", err, span)
+	}
+
+	deliveries, err := h.svc.FindDeliveries(c.Request().Context(), internal.FindWebhookDeliveriesParams{
+		SubscriptionID: id,
+		Status:         internal.WebhookDeliveryStatus(req.Status),
+		Page:           req.Page,
+		Size:           req.Size,
+	})
+	if err != nil {
+		return v1Handler.RenderErrorResponse(c, "Failed to get webhook deliveries", err, span)
+	}
+
+	var prevPage, nextPage int
+
+	if req.Page >= 2 {
+		prevPage = req.Page - 1
+	}
+
+	if len(deliveries) == req.Size {
+		nextPage = req.Page + 1
+	}
+
+	result := DeliveriesResponse{
+		PaginationData: PaginationData{
+			PrevPage: prevPage,
+			NextPage: nextPage,
+			Total:    len(deliveries),
+		},
+		Deliveries: deliveries,
+	}
+
+	span.Status = sentry.SpanStatusOK
+	return c.JSON(http.StatusOK, v1Handler.Response{
+		Error:   false,
+		Message: "OK",
+		Data:    result,
+	})
+}
@@ -0,0 +1,37 @@
+package webhooks
+
+import (
+	"net/http"
+
+	v1Handler "fourleaves.studio/manga-scraper/internal/rest/v1"
+	"github.com/getsentry/sentry-go"
+	"github.com/labstack/echo/v4"
+)
+
+// @Summary		Get all webhook subscriptions
+// @Description	Get all webhook subscriptions
+// @Security		TokenAuth
+// @Security		APIKeyAuth
+// @Tags			webhooks
+// @Produce		json
+// @Success		200	{object}	ResponseV1
+// @Failure		401	{object}	ResponseV1
+// @Failure		403	{object}	ResponseV1
+// @Failure		500	{object}	ResponseV1
+// @Router			/api/v1/webhooks [get]
+func (h *WebhookHandler) FindAll(c echo.Context) error {
+	span := newSentrySpan(c.Request().Context(), "v1.FindAll")
+	defer span.Finish()
+
+	subscriptions, err := h.svc.FindAll(c.Request().Context())
+	if err != nil {
+		return v1Handler.RenderErrorResponse(c, "Failed to get webhook subscriptions", err, span)
+	}
+
+	span.Status = sentry.SpanStatusOK
+	return c.JSON(http.StatusOK, v1Handler.Response{
+		Error:   false,
+		Message: "OK",
+		Data:    subscriptions,
+	})
+}
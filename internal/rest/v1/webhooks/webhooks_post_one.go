@@ -0,0 +1,63 @@
+package webhooks
+
+import (
+	"net/http"
+
+	"fourleaves.studio/manga-scraper/internal"
+	v1Handler "fourleaves.studio/manga-scraper/internal/rest/v1"
+	"github.com/getsentry/sentry-go"
+	"github.com/labstack/echo/v4"
+)
+
+// @Summary		Create webhook subscription
+// @Description	Subscribe a URL to chapter.released events, optionally filtered by provider or series. The signing secret is only returned here.
+// @Security		TokenAuth
+// @Security		APIKeyAuth
+// @Tags			webhooks
+// @Accept			json
+// @Produce		json
+// @Param			body	body		CreateWebhookRequest	true	"Request body"
+// @Success		201		{object}	ResponseV1
+// @Failure		400		{object}	ResponseV1
+// @Failure		401		{object}	ResponseV1
+// @Failure		403		{object}	ResponseV1
+// @Failure		404		{object}	ResponseV1
+// @Failure		500		{object}	ResponseV1
+// @Router			/api/v1/webhooks [post]
+func (h *WebhookHandler) Create(c echo.Context) error {
+	span := newSentrySpan(c.Request().Context(), "v1.Create")
+	defer span.Finish()
+
+	var req CreateWebhookRequest
+	err := c.Bind(&req)
+	if err != nil {
+		return v1Handler.RenderErrorResponse(c, "Invalid request", internal.WrapErrorf(err, internal.ErrInvalidInput, "bind request"), span)
+	}
+
+	err = c.Validate(&req)
+	if err != nil {
+		return v1Handler.RenderErrorResponse(c, "Invalid request", internal.WrapErrorf(err, internal.ErrInvalidInput, "validate request"), span)
+	}
+
+	if msg, err := h.checkFilters(c.Request().Context(), req.Provider, req.Series); err != nil {
+		return v1Handler.RenderErrorResponse(c, msg, err, span)
+	}
+
+	subscription, err := h.svc.Create(c.Request().Context(), internal.WebhookSubscriptionParams{
+		URL:      req.URL,
+		Secret:   req.Secret,
+		Provider: req.Provider,
+		Series:   req.Series,
+		IsActive: req.IsActive,
+	})
+	if err != nil {
+		return v1Handler.RenderErrorResponse(c, "Failed to create webhook subscription", err, span)
+	}
+
+	span.Status = sentry.SpanStatusOK
+	return c.JSON(http.StatusCreated, v1Handler.Response{
+		Error:   false,
+		Message: "Created",
+		Data:    subscription,
+	})
+}
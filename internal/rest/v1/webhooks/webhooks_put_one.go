@@ -0,0 +1,65 @@
+package webhooks
+
+import (
+	"net/http"
+
+	"fourleaves.studio/manga-scraper/internal"
+	v1Handler "fourleaves.studio/manga-scraper/internal/rest/v1"
+	"github.com/getsentry/sentry-go"
+	"github.com/labstack/echo/v4"
+)
+
+// @Summary		Update webhook subscription by ID
+// @Description	Replace the URL, filters and state of a webhook subscription, the secret is only rotated when a new one is given
+// @Security		TokenAuth
+// @Security		APIKeyAuth
+// @Tags			webhooks
+// @Accept			json
+// @Produce		json
+// @Param			id		path		string					true	"Subscription ID"	example(550e8400-e29b-41d4-a716-446655440000)
+// @Param			body	body		UpdateWebhookRequest	true	"Request body"
+// @Success		200		{object}	ResponseV1
+// @Failure		400		{object}	ResponseV1
+// @Failure		401		{object}	ResponseV1
+// @Failure		403		{object}	ResponseV1
+// @Failure		404		{object}	ResponseV1
+// @Failure		500		{object}	ResponseV1
+// @Router			/api/v1/webhooks/{id} [put]
+func (h *WebhookHandler) Update(c echo.Context) error {
+	span := newSentrySpan(c.Request().Context(), "v1.Update")
+	defer span.Finish()
+
+	var req UpdateWebhookRequest
+	err := c.Bind(&req)
+	if err != nil {
+		return v1Handler.RenderErrorResponse(c, "Invalid request", internal.WrapErrorf(err, internal.ErrInvalidInput, "bind request"), span)
+	}
+
+	err = c.Validate(&req)
+	if err != nil {
+		return v1Handler.RenderErrorResponse(c, "Invalid request", internal.WrapErrorf(err, internal.ErrInvalidInput, "validate request"), span)
+	}
+
+	if msg, err := h.checkFilters(c.Request().Context(), req.Provider, req.Series); err != nil {
+		return v1Handler.RenderErrorResponse(c, msg, err, span)
+	}
+
+	subscription, err := h.svc.Update(c.Request().Context(), internal.WebhookSubscriptionParams{
+		ID:       c.Param("id"),
+		URL:      req.URL,
+		Secret:   req.Secret,
+		Provider: req.Provider,
+		Series:   req.Series,
+		IsActive: req.IsActive,
+	})
+	if err != nil {
+		return v1Handler.RenderErrorResponse(c, "Failed to update webhook subscription", err, span)
+	}
+
+	span.Status = sentry.SpanStatusOK
+	return c.JSON(http.StatusOK, v1Handler.Response{
+		Error:   false,
+		Message: "OK",
+		Data:    subscription,
+	})
+}
@@ -0,0 +1,80 @@
+package works
+
+import (
+	"context"
+
+	"fourleaves.studio/manga-scraper/internal"
+	"fourleaves.studio/manga-scraper/internal/rest/middlewares"
+	"github.com/getsentry/sentry-go"
+	"github.com/labstack/echo/v4"
+)
+
+type WorkService interface {
+	Find(ctx context.Context, id string) (internal.Work, error)
+	FindChapters(ctx context.Context, params internal.FindWorkChaptersParams) ([]internal.Chapter, error)
+	Update(ctx context.Context, params internal.UpdateWorkParams) (internal.Work, error)
+	Split(ctx context.Context, params internal.WorkSeriesParams) (internal.Work, error)
+	Merge(ctx context.Context, params internal.MergeWorksParams) (internal.Work, error)
+	FindCandidates(ctx context.Context, params internal.FindWorkCandidatesParams) ([]internal.WorkCandidate, error)
+	ConfirmCandidate(ctx context.Context, id string) (internal.Work, error)
+	RejectCandidate(ctx context.Context, id string) (internal.WorkCandidate, error)
+}
+
+type WorkHandler struct {
+	svc WorkService
+}
+
+func NewWorkHandler(svc WorkService) *WorkHandler {
+	return &WorkHandler{
+		svc: svc,
+	}
+}
+
+func (h *WorkHandler) Register(g *echo.Group, mid *middlewares.Middleware) {
+	g.GET("/_candidates", h.FindCandidates, mid.IsAdmin)
+	g.POST("/_candidates/:id/_confirm", h.ConfirmCandidate, mid.IsAdmin)
+	g.POST("/_candidates/:id/_reject", h.RejectCandidate, mid.IsAdmin)
+	g.GET("/:id", h.Find)
+	g.GET("/:id/chapters", h.FindChapters)
+	g.PUT("/:id", h.Update, mid.IsAdmin)
+	g.POST("/:id/_merge", h.Merge, mid.IsAdmin)
+	g.POST("/:id/_split", h.Split, mid.IsAdmin)
+}
+
+type UpdateWorkRequest struct {
+	Title   string   `json:"title" validate:"required" example:"Solo Leveling"`
+	Aliases []string `json:"aliases" validate:"dive,required" example:"Only I Level Up,Na Honjaman Level Up"`
+} // @name UpdateWorkRequest
+
+type MergeWorkRequest struct {
+	WorkID string `json:"work_id" validate:"required" example:"550e8400-e29b-41d4-a716-446655440000"`
+} // @name MergeWorkRequest
+
+type SplitWorkRequest struct {
+	Provider string `json:"provider" validate:"required" example:"asura"`
+	Series   string `json:"series" validate:"required" example:"solo-leveling"`
+} // @name SplitWorkRequest
+
+type FindCandidatesRequest struct {
+	Page   int    `query:"page" validate:"required,gt=0" example:"1"`
+	Size   int    `query:"size" validate:"required,gt=0,lte=100" example:"10"`
+	Status string `query:"status" validate:"omitempty,oneof=PENDING REJECTED" example:"PENDING"`
+}
+
+type PaginationData struct {
+	PrevPage int `json:"prevPage,omitempty"`
+	NextPage int `json:"nextPage,omitempty"`
+	Total    int `json:"total,omitempty"`
+}
+
+type CandidatesResponse struct {
+	PaginationData
+	Candidates []internal.WorkCandidate `json:"candidates"`
+}
+
+func newSentrySpan(ctx context.Context, operation string) *sentry.Span {
+	span := sentry.StartSpan(ctx, operation)
+	span.Name = "fourleaves.studio/manga-scraper/internal/rest/v1/works"
+
+	return span
+}
@@ -0,0 +1,76 @@
+package works
+
+import (
+	"net/http"
+
+	"fourleaves.studio/manga-scraper/internal"
+	v1Handler "fourleaves.studio/manga-scraper/internal/rest/v1"
+	"github.com/getsentry/sentry-go"
+	"github.com/labstack/echo/v4"
+)
+
+// @Summary		Get work candidates
+// @Description	Get the series suggested for a work by title or alias matching, newest first
+// @Security		TokenAuth
+// @Security		APIKeyAuth
+// @Tags			works
+// @Produce		json
+// @Param			page	query		string	true	"Page"				example(1)
+// @Param			size	query		string	true	"Size"				example(10)
+// @Param			status	query		string	false	"Candidate status"	example(PENDING)
+// @Success		200		{object}	ResponseV1
+// @Failure		400		{object}	ResponseV1
+// @Failure		401		{object}	ResponseV1
+// @Failure		403		{object}	ResponseV1
+// @Failure		500		{object}	ResponseV1
+// @Router			/api/v1/works/_candidates [get]
+func (h *WorkHandler) FindCandidates(c echo.Context) error {
+	span := newSentrySpan(c.Request().Context(), "v1.FindCandidates")
+	defer span.Finish()
+
+	var req FindCandidatesRequest
+	err := c.Bind(&req)
+	if err != nil {
+		return v1Handler.RenderErrorResponse(c, "Invalid request", internal.WrapErrorf(err, internal.ErrInvalidInput, "bind request"), span)
+	}
+
+	err = c.Validate(&req)
+	if err != nil {
+		return v1Handler.RenderErrorResponse(c, "Invalid request", internal.WrapErrorf(err, internal.ErrInvalidInput, "validate request"), span)
+	}
+
+	candidates, err := h.svc.FindCandidates(c.Request().Context(), internal.FindWorkCandidatesParams{
+		Status: internal.WorkCandidateStatus(req.Status),
+		Page:   req.Page,
+		Size:   req.Size,
+	})
+	if err != nil {
+		return v1Handler.RenderErrorResponse(c, "Failed to get work candidates", err, span)
+	}
+
+	var prevPage, nextPage int
+
+	if req.Page >= 2 {
+		prevPage = req.Page - 1
+	}
+
+	if len(candidates) == req.Size {
+		nextPage = req.Page + 1
+	}
+
+	result := CandidatesResponse{
+		PaginationData: PaginationData{
+			PrevPage: prevPage,
+			NextPage: nextPage,
+			Total:    len(candidates),
+		},
+		Candidates: candidates,
+	}
+
+	span.Status = sentry.SpanStatusOK
+	return c.JSON(http.StatusOK, v1Handler.Response{
+		Error:   false,
+		Message: "OK",
+		Data:    result,
+	})
+}
@@ -0,0 +1,33 @@
+package works
+
+import (
+	"fourleaves.studio/manga-scraper/internal"
+	v1Handler "fourleaves.studio/manga-scraper/internal/rest/v1"
+	"github.com/labstack/echo/v4"
+)
+
+// @Summary		Get work chapter list
+// @Description	Get the chapters of every provider of a work merged by number, each chapter is served by the preferred provider that has its content and lists the other providers in sources
+// @Tags			works
+// @Produce		json
+// @Param			id		path		string	true	"Work ID"		example(550e8400-e29b-41d4-a716-446655440000)
+// @Param			sort	query		string	false	"Sort order"	enum(asc, desc)	default(asc)
+// @Success		200		{object}	ResponseV1
+// @Success		304		"Not Modified"
+// @Failure		404		{object}	ResponseV1
+// @Failure		500		{object}	ResponseV1
+// @Router			/api/v1/works/{id}/chapters [get]
+func (h *WorkHandler) FindChapters(c echo.Context) error {
+	span := newSentrySpan(c.Request().Context(), "v1.FindChapters")
+	defer span.Finish()
+
+	chapters, err := h.svc.FindChapters(c.Request().Context(), internal.FindWorkChaptersParams{
+		ID:    c.Param("id"),
+		Order: internal.NewSortOrder(c.QueryParam("sort")),
+	})
+	if err != nil {
+		return v1Handler.RenderErrorResponse(c, "Failed to get work chapters", err, span)
+	}
+
+	return v1Handler.RenderCachedResponse(c, chapters, v1Handler.CacheControlList, span)
+}
@@ -0,0 +1,28 @@
+package works
+
+import (
+	v1Handler "fourleaves.studio/manga-scraper/internal/rest/v1"
+	"github.com/labstack/echo/v4"
+)
+
+// @Summary		Get work by ID
+// @Description	Get a work with the series of every provider linked to it
+// @Tags			works
+// @Produce		json
+// @Param			id	path		string	true	"Work ID"	example(550e8400-e29b-41d4-a716-446655440000)
+// @Success		200	{object}	ResponseV1
+// @Success		304	"Not Modified"
+// @Failure		404	{object}	ResponseV1
+// @Failure		500	{object}	ResponseV1
+// @Router			/api/v1/works/{id} [get]
+func (h *WorkHandler) Find(c echo.Context) error {
+	span := newSentrySpan(c.Request().Context(), "v1.Find")
+	defer span.Finish()
+
+	work, err := h.svc.Find(c.Request().Context(), c.Param("id"))
+	if err != nil {
+		return v1Handler.RenderErrorResponse(c, "Failed to get work", err, span)
+	}
+
+	return v1Handler.RenderCachedResponse(c, work, v1Handler.CacheControlDetail, span, work.UpdatedAt)
+}
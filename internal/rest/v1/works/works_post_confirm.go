@@ -0,0 +1,39 @@
+package works
+
+import (
+	"net/http"
+
+	v1Handler "fourleaves.studio/manga-scraper/internal/rest/v1"
+	"github.com/getsentry/sentry-go"
+	"github.com/labstack/echo/v4"
+)
+
+// @Summary		Confirm work candidate
+// @Description	Link the candidate series into the candidate work, the series leaves its previous work
+// @Security		TokenAuth
+// @Security		APIKeyAuth
+// @Tags			works
+// @Produce		json
+// @Param			id	path		string	true	"Candidate ID"	example(550e8400-e29b-41d4-a716-446655440000)
+// @Success		200	{object}	ResponseV1
+// @Failure		401	{object}	ResponseV1
+// @Failure		403	{object}	ResponseV1
+// @Failure		404	{object}	ResponseV1
+// @Failure		500	{object}	ResponseV1
+// @Router			/api/v1/works/_candidates/{id}/_confirm [post]
+func (h *WorkHandler) ConfirmCandidate(c echo.Context) error {
+	span := newSentrySpan(c.Request().Context(), "v1.ConfirmCandidate")
+	defer span.Finish()
+
+	work, err := h.svc.ConfirmCandidate(c.Request().Context(), c.Param("id"))
+	if err != nil {
+		return v1Handler.RenderErrorResponse(c, "Failed to confirm work candidate", err, span)
+	}
+
+	span.Status = sentry.SpanStatusOK
+	return c.JSON(http.StatusOK, v1Handler.Response{
+		Error:   false,
+		Message: "OK",
+		Data:    work,
+	})
+}
@@ -0,0 +1,57 @@
+package works
+
+import (
+	"net/http"
+
+	"fourleaves.studio/manga-scraper/internal"
+	v1Handler "fourleaves.studio/manga-scraper/internal/rest/v1"
+	"github.com/getsentry/sentry-go"
+	"github.com/labstack/echo/v4"
+)
+
+// @Summary		Merge works
+// @Description	Move every series of the given work into this work and delete the given work, its aliases are kept
+// @Security		TokenAuth
+// @Security		APIKeyAuth
+// @Tags			works
+// @Accept			json
+// @Produce		json
+// @Param			id		path		string				true	"Target work ID"	example(550e8400-e29b-41d4-a716-446655440000)
+// @Param			body	body		MergeWorkRequest	true	"Request body"
+// @Success		200		{object}	ResponseV1
+// @Failure		400		{object}	ResponseV1
+// @Failure		401		{object}	ResponseV1
+// @Failure		403		{object}	ResponseV1
+// @Failure		404		{object}	ResponseV1
+// @Failure		500		{object}	ResponseV1
+// @Router			/api/v1/works/{id}/_merge [post]
+func (h *WorkHandler) Merge(c echo.Context) error {
+	span := newSentrySpan(c.Request().Context(), "v1.Merge")
+	defer span.Finish()
+
+	var req MergeWorkRequest
+	err := c.Bind(&req)
+	if err != nil {
+		return v1Handler.RenderErrorResponse(c, "Invalid request", internal.WrapErrorf(err, internal.ErrInvalidInput, "bind request"), span)
+	}
+
+	err = c.Validate(&req)
+	if err != nil {
+		return v1Handler.RenderErrorResponse(c, "Invalid request", internal.WrapErrorf(err, internal.ErrInvalidInput, "validate request"), span)
+	}
+
+	work, err := h.svc.Merge(c.Request().Context(), internal.MergeWorksParams{
+		TargetID: c.Param("id"),
+		SourceID: req.WorkID,
+	})
+	if err != nil {
+		return v1Handler.RenderErrorResponse(c, "Failed to merge works", err, span)
+	}
+
+	span.Status = sentry.SpanStatusOK
+	return c.JSON(http.StatusOK, v1Handler.Response{
+		Error:   false,
+		Message: "OK",
+		Data:    work,
+	})
+}
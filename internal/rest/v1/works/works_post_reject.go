@@ -0,0 +1,39 @@
+package works
+
+import (
+	"net/http"
+
+	v1Handler "fourleaves.studio/manga-scraper/internal/rest/v1"
+	"github.com/getsentry/sentry-go"
+	"github.com/labstack/echo/v4"
+)
+
+// @Summary		Reject work candidate
+// @Description	Reject the candidate, the series will not be suggested for the work again
+// @Security		TokenAuth
+// @Security		APIKeyAuth
+// @Tags			works
+// @Produce		json
+// @Param			id	path		string	true	"Candidate ID"	example(550e8400-e29b-41d4-a716-446655440000)
+// @Success		200	{object}	ResponseV1
+// @Failure		401	{object}	ResponseV1
+// @Failure		403	{object}	ResponseV1
+// @Failure		404	{object}	ResponseV1
+// @Failure		500	{object}	ResponseV1
+// @Router			/api/v1/works/_candidates/{id}/_reject [post]
+func (h *WorkHandler) RejectCandidate(c echo.Context) error {
+	span := newSentrySpan(c.Request().Context(), "v1.RejectCandidate")
+	defer span.Finish()
+
+	candidate, err := h.svc.RejectCandidate(c.Request().Context(), c.Param("id"))
+	if err != nil {
+		return v1Handler.RenderErrorResponse(c, "Failed to reject work candidate", err, span)
+	}
+
+	span.Status = sentry.SpanStatusOK
+	return c.JSON(http.StatusOK, v1Handler.Response{
+		Error:   false,
+		Message: "OK",
+		Data:    candidate,
+	})
+}
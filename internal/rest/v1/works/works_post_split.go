@@ -0,0 +1,58 @@
+package works
+
+import (
+	"net/http"
+
+	"fourleaves.studio/manga-scraper/internal"
+	v1Handler "fourleaves.studio/manga-scraper/internal/rest/v1"
+	"github.com/getsentry/sentry-go"
+	"github.com/labstack/echo/v4"
+)
+
+// @Summary		Split series from work
+// @Description	Move a wrongly linked series out of the work into a work of its own, it will not be suggested for this work again
+// @Security		TokenAuth
+// @Security		APIKeyAuth
+// @Tags			works
+// @Accept			json
+// @Produce		json
+// @Param			id		path		string				true	"Work ID"	example(550e8400-e29b-41d4-a716-446655440000)
+// @Param			body	body		SplitWorkRequest	true	"Request body"
+// @Success		200		{object}	ResponseV1
+// @Failure		400		{object}	ResponseV1
+// @Failure		401		{object}	ResponseV1
+// @Failure		403		{object}	ResponseV1
+// @Failure		404		{object}	ResponseV1
+// @Failure		500		{object}	ResponseV1
+// @Router			/api/v1/works/{id}/_split [post]
+func (h *WorkHandler) Split(c echo.Context) error {
+	span := newSentrySpan(c.Request().Context(), "v1.Split")
+	defer span.Finish()
+
+	var req SplitWorkRequest
+	err := c.Bind(&req)
+	if err != nil {
+		return v1Handler.RenderErrorResponse(c, "Invalid request", internal.WrapErrorf(err, internal.ErrInvalidInput, "bind request"), span)
+	}
+
+	err = c.Validate(&req)
+	if err != nil {
+		return v1Handler.RenderErrorResponse(c, "Invalid request", internal.WrapErrorf(err, internal.ErrInvalidInput, "validate request"), span)
+	}
+
+	work, err := h.svc.Split(c.Request().Context(), internal.WorkSeriesParams{
+		WorkID:   c.Param("id"),
+		Provider: req.Provider,
+		Series:   req.Series,
+	})
+	if err != nil {
+		return v1Handler.RenderErrorResponse(c, "Failed to split work", err, span)
+	}
+
+	span.Status = sentry.SpanStatusOK
+	return c.JSON(http.StatusOK, v1Handler.Response{
+		Error:   false,
+		Message: "OK",
+		Data:    work,
+	})
+}
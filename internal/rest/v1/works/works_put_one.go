@@ -0,0 +1,58 @@
+package works
+
+import (
+	"net/http"
+
+	"fourleaves.studio/manga-scraper/internal"
+	v1Handler "fourleaves.studio/manga-scraper/internal/rest/v1"
+	"github.com/getsentry/sentry-go"
+	"github.com/labstack/echo/v4"
+)
+
+// @Summary		Update work by ID
+// @Description	Replace the canonical title and aliases of a work, aliases are used to match new series to the work
+// @Security		TokenAuth
+// @Security		APIKeyAuth
+// @Tags			works
+// @Accept			json
+// @Produce		json
+// @Param			id		path		string				true	"Work ID"	example(550e8400-e29b-41d4-a716-446655440000)
+// @Param			body	body		UpdateWorkRequest	true	"Request body"
+// @Success		200		{object}	ResponseV1
+// @Failure		400		{object}	ResponseV1
+// @Failure		401		{object}	ResponseV1
+// @Failure		403		{object}	ResponseV1
+// @Failure		404		{object}	ResponseV1
+// @Failure		500		{object}	ResponseV1
+// @Router			/api/v1/works/{id} [put]
+func (h *WorkHandler) Update(c echo.Context) error {
+	span := newSentrySpan(c.Request().Context(), "v1.Update")
+	defer span.Finish()
+
+	var req UpdateWorkRequest
+	err := c.Bind(&req)
+	if err != nil {
+		return v1Handler.RenderErrorResponse(c, "Invalid request", internal.WrapErrorf(err, internal.ErrInvalidInput, "bind request"), span)
+	}
+
+	err = c.Validate(&req)
+	if err != nil {
+		return v1Handler.RenderErrorResponse(c, "Invalid request", internal.WrapErrorf(err, internal.ErrInvalidInput, "validate request"), span)
+	}
+
+	work, err := h.svc.Update(c.Request().Context(), internal.UpdateWorkParams{
+		ID:      c.Param("id"),
+		Title:   req.Title,
+		Aliases: req.Aliases,
+	})
+	if err != nil {
+		return v1Handler.RenderErrorResponse(c, "Failed to update work", err, span)
+	}
+
+	span.Status = sentry.SpanStatusOK
+	return c.JSON(http.StatusOK, v1Handler.Response{
+		Error:   false,
+		Message: "OK",
+		Data:    work,
+	})
+}
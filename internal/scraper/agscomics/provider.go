@@ -0,0 +1,18 @@
+package agscomics
+
+import (
+	"fourleaves.studio/manga-scraper/internal"
+	"fourleaves.studio/manga-scraper/internal/scraper/registry"
+)
+
+func init() {
+	registry.Register("agscomics", registry.Funcs{
+		SeriesList:    ScrapeSeriesList,
+		SeriesDetail:  ScrapeSeriesDetail,
+		ChapterList:   ScrapeChapterList,
+		ChapterDetail: ScrapeChapterDetail,
+		Selectors: &internal.ProviderSelectors{
+			SynopsisFallback: "div.additional-content p",
+		},
+	})
+}
@@ -0,0 +1,16 @@
+package asura
+
+import (
+	"fourleaves.studio/manga-scraper/internal"
+	"fourleaves.studio/manga-scraper/internal/scraper/registry"
+)
+
+func init() {
+	registry.Register("asura", registry.Funcs{
+		SeriesList:    ScrapeSeriesList,
+		SeriesDetail:  ScrapeSeriesDetail,
+		ChapterList:   ScrapeChapterList,
+		ChapterDetail: ScrapeChapterDetail,
+		Selectors:     &internal.ProviderSelectors{},
+	})
+}
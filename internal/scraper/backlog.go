@@ -0,0 +1,67 @@
+package scraper
+
+import "sync"
+
+// backlog counts the consumed requests that are waiting for a slot or running, in total and per provider
+// The per provider bound keeps a saturated provider from taking every place of the backlog
+type backlog struct {
+	mu          sync.Mutex
+	total       int
+	max         int
+	providers   map[string]int
+	maxProvider int
+}
+
+func newBacklog(concurrency Concurrency) *backlog {
+	max := concurrency.Workers * queuedPerWorker
+
+	maxProvider := max
+	if concurrency.PerProvider > 0 && concurrency.PerProvider*queuedPerWorker < max {
+		maxProvider = concurrency.PerProvider * queuedPerWorker
+	}
+
+	return &backlog{
+		max:         max,
+		providers:   make(map[string]int),
+		maxProvider: maxProvider,
+	}
+}
+
+// add takes a place for a request of the provider, it reports false when the backlog or the provider is full
+func (b *backlog) add(provider string) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if !b.hasRoomLocked(provider) {
+		return false
+	}
+
+	b.total++
+	b.providers[provider]++
+
+	return true
+}
+
+// done frees the place of a finished request
+func (b *backlog) done(provider string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.total--
+
+	if b.providers[provider]--; b.providers[provider] <= 0 {
+		delete(b.providers, provider)
+	}
+}
+
+// hasRoom reports whether a request of the provider would be taken
+func (b *backlog) hasRoom(provider string) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.hasRoomLocked(provider)
+}
+
+func (b *backlog) hasRoomLocked(provider string) bool {
+	return b.total < b.max && b.providers[provider] < b.maxProvider
+}
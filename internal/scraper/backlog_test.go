@@ -0,0 +1,52 @@
+package scraper
+
+import "testing"
+
+func TestBacklog(t *testing.T) {
+	// 2 workers hold up to 8 requests, a provider limited to 1 concurrent request up to 4
+	b := newBacklog(Concurrency{Workers: 2, PerProvider: 1})
+
+	for i := 0; i < 4; i++ {
+		if !b.add("asura") {
+			t.Fatalf("expected request %d of the provider to be taken", i+1)
+		}
+	}
+
+	if b.add("asura") {
+		t.Error("expected the saturated provider to be full")
+	}
+
+	if !b.hasRoom("flame") {
+		t.Error("expected room for the other providers")
+	}
+
+	for i := 0; i < 4; i++ {
+		if !b.add("flame") {
+			t.Fatalf("expected request %d of the other provider to be taken", i+1)
+		}
+	}
+
+	if b.hasRoom("luminous") {
+		t.Error("expected the backlog to be full")
+	}
+
+	b.done("asura")
+
+	if !b.hasRoom("luminous") || !b.add("asura") {
+		t.Error("expected a finished request to free its place")
+	}
+}
+
+func TestBacklog_UnlimitedProvider(t *testing.T) {
+	b := newBacklog(Concurrency{Workers: 1})
+
+	for i := 0; i < queuedPerWorker; i++ {
+		if !b.add("asura") {
+			t.Fatalf("expected request %d to be taken", i+1)
+		}
+	}
+
+	if b.hasRoom("flame") {
+		t.Error("expected the backlog to be full")
+	}
+}
@@ -0,0 +1,55 @@
+package browser
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-rod/rod"
+	"github.com/go-rod/rod/lib/utils"
+
+	"fourleaves.studio/manga-scraper/internal"
+	"fourleaves.studio/manga-scraper/internal/scraper/throttle"
+)
+
+// elementWait bounds how long a page query waits for an element that is not rendered yet
+const elementWait = 5 * time.Second
+
+// Navigate moves a page borrowed from the pool to url once the provider rate limit allows it
+// Scrapes must use it instead of page.Navigate, so every page load counts against the provider
+func Navigate(page *rod.Page, url string) error {
+	if err := throttle.Wait(page.GetContext()); err != nil {
+		return err
+	}
+
+	return load(page, url)
+}
+
+// load navigates the page to url and waits for the document, so the page queries only wait for scripts
+func load(page *rod.Page, url string) error {
+	if err := page.Navigate(url); err != nil {
+		return internal.WrapErrorf(err, internal.ErrUnknown, "page.Navigate")
+	}
+
+	if err := page.WaitLoad(); err != nil {
+		return internal.WrapErrorf(err, internal.ErrUnknown, "page.WaitLoad")
+	}
+
+	return nil
+}
+
+// newElementSleeper polls for a missing element until wait has passed, then fails with rod.ElementNotFoundError.
+// rod's default sleeper polls until the context is done, which turns a page whose layout changed into a timeout
+func newElementSleeper(wait time.Duration) func() utils.Sleeper {
+	return func() utils.Sleeper {
+		deadline := time.Now().Add(wait)
+		backoff := utils.BackoffSleeper(100*time.Millisecond, time.Second, nil)
+
+		return func(ctx context.Context) error {
+			if !time.Now().Before(deadline) {
+				return &rod.ElementNotFoundError{}
+			}
+
+			return backoff(ctx)
+		}
+	}
+}
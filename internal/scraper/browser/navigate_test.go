@@ -0,0 +1,45 @@
+package browser
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-rod/rod"
+)
+
+func TestNewElementSleeper(t *testing.T) {
+	ctx := context.Background()
+
+	sleeper := newElementSleeper(150 * time.Millisecond)()
+
+	start := time.Now()
+
+	var err error
+	for polls := 0; err == nil; polls++ {
+		if polls > 10 {
+			t.Fatal("expected the sleeper to give up")
+		}
+
+		err = sleeper(ctx)
+	}
+
+	var notFound *rod.ElementNotFoundError
+	if !errors.As(err, &notFound) {
+		t.Fatalf("expected a missing element, got %v", err)
+	}
+
+	if elapsed := time.Since(start); elapsed < 150*time.Millisecond {
+		t.Errorf("expected the sleeper to poll until the wait passed, gave up after %v", elapsed)
+	}
+}
+
+func TestNewElementSleeper_Canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if err := newElementSleeper(time.Minute)()(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected the context error, got %v", err)
+	}
+}
@@ -0,0 +1,312 @@
+package browser
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/go-rod/rod"
+	"github.com/go-rod/rod/lib/launcher"
+	"github.com/go-rod/rod/lib/proto"
+	"go.uber.org/zap"
+
+	"fourleaves.studio/manga-scraper/internal"
+	"fourleaves.studio/manga-scraper/internal/scraper/throttle"
+)
+
+// Pool keeps a single long-lived browser connection and hands out a bounded number of pages
+type Pool struct {
+	browserURL string
+	maxUses    int
+	logger     *zap.Logger
+	// dial connects a new browser, tests replace it with a fake CDP client
+	dial func() (*rod.Browser, error)
+
+	sem  chan struct{}
+	idle chan *page
+
+	mu      sync.Mutex
+	browser *rod.Browser
+	gen     uint64
+}
+
+type page struct {
+	*rod.Page
+	uses int
+	gen  uint64
+}
+
+// NewPool creates a pool allowing up to size concurrent pages
+// Pages are closed and replaced after maxUses borrows; zero means unlimited
+func NewPool(browserURL string, size, maxUses int, logger *zap.Logger) *Pool {
+	if size < 1 {
+		size = 1
+	}
+
+	p := &Pool{
+		browserURL: browserURL,
+		maxUses:    maxUses,
+		logger:     logger,
+		sem:        make(chan struct{}, size),
+		idle:       make(chan *page, size),
+	}
+	p.dial = p.launch
+
+	return p
+}
+
+// Page borrows a page from the pool and navigates it to url
+// The returned release function must be called once the page is no longer needed
+// Queries on the page give up on a missing element after elementWait with rod.ElementNotFoundError
+func (p *Pool) Page(ctx context.Context, url string) (*rod.Page, func(), error) {
+	// wait on the provider rate limit first so throttled scrapes do not hold on to pages
+	throttleRelease, err := throttle.Acquire(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	select {
+	case p.sem <- struct{}{}:
+	case <-ctx.Done():
+		throttleRelease()
+		return nil, nil, internal.WrapErrorf(ctx.Err(), internal.ErrUnknown, "pool.acquire")
+	}
+
+	pg, err := p.borrow()
+	if err != nil {
+		<-p.sem
+		throttleRelease()
+		return nil, nil, err
+	}
+
+	if err := load(pg.Context(ctx), url); err != nil {
+		p.discard(pg)
+		<-p.sem
+		throttleRelease()
+
+		// a dead connection surfaces here first, so make sure the next borrow starts fresh
+		if pingErr := p.Ping(context.Background()); pingErr != nil {
+			p.reconnect(pg.gen)
+		}
+
+		return nil, nil, err
+	}
+
+	var once sync.Once
+
+	release := func() {
+		once.Do(func() {
+			p.release(pg)
+			<-p.sem
+			throttleRelease()
+		})
+	}
+
+	return pg.Context(ctx).Sleeper(newElementSleeper(elementWait)), release, nil
+}
+
+// Ping checks that the browser connection is still alive
+func (p *Pool) Ping(ctx context.Context) error {
+	p.mu.Lock()
+	b := p.browser
+	p.mu.Unlock()
+
+	if b == nil {
+		return internal.NewErrorf(internal.ErrUnknown, "browser not connected")
+	}
+
+	if _, err := b.Context(ctx).Version(); err != nil {
+		return internal.WrapErrorf(err, internal.ErrUnknown, "browser.Version")
+	}
+
+	return nil
+}
+
+// StartHealthCheck pings the browser every interval and reconnects when it stops responding
+func (p *Pool) StartHealthCheck(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				p.mu.Lock()
+				connected, gen := p.browser != nil, p.gen
+				p.mu.Unlock()
+
+				if !connected {
+					continue
+				}
+
+				pingCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+				err := p.Ping(pingCtx)
+				cancel()
+
+				if err != nil {
+					p.logger.Warn("browser health check failed, reconnecting", zap.Error(err))
+					p.reconnect(gen)
+				}
+			}
+		}
+	}()
+}
+
+// Close closes every idle page and the browser connection
+func (p *Pool) Close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.drain()
+
+	if p.browser == nil {
+		return nil
+	}
+
+	err := p.browser.Close()
+	p.browser = nil
+	p.gen++
+
+	return err
+}
+
+func (p *Pool) borrow() (*page, error) {
+	for {
+		select {
+		case pg := <-p.idle:
+			if pg.gen == p.generation() {
+				return pg, nil
+			}
+
+			_ = pg.Close()
+		default:
+			return p.newPage()
+		}
+	}
+}
+
+func (p *Pool) newPage() (*page, error) {
+	b, gen, err := p.connect()
+	if err != nil {
+		return nil, err
+	}
+
+	pg, err := b.Page(proto.TargetCreateTarget{})
+	if err != nil {
+		p.reconnect(gen)
+		return nil, internal.WrapErrorf(err, internal.ErrUnknown, "browser.Page")
+	}
+
+	return &page{Page: pg, gen: gen}, nil
+}
+
+func (p *Pool) release(pg *page) {
+	pg.uses++
+
+	if pg.gen != p.generation() || (p.maxUses > 0 && pg.uses >= p.maxUses) {
+		p.discard(pg)
+		return
+	}
+
+	// leave the previous site so it stops running scripts while idle
+	if err := pg.Navigate("about:blank"); err != nil {
+		p.discard(pg)
+		return
+	}
+
+	select {
+	case p.idle <- pg:
+	default:
+		p.discard(pg)
+	}
+}
+
+func (p *Pool) discard(pg *page) {
+	if err := pg.Close(); err != nil {
+		p.logger.Debug("failed to close page", zap.Error(err))
+	}
+}
+
+func (p *Pool) connect() (*rod.Browser, uint64, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.browser != nil {
+		return p.browser, p.gen, nil
+	}
+
+	b, err := p.dial()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	p.browser = b
+	p.gen++
+
+	p.logger.Info("Connected to browser", zap.Uint64("generation", p.gen))
+
+	return p.browser, p.gen, nil
+}
+
+// launch connects to the managed browser launcher at browserURL
+func (p *Pool) launch() (*rod.Browser, error) {
+	l, err := launcher.NewManaged(p.browserURL)
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrUnknown, "launcher.NewManaged")
+	}
+
+	l.Leakless(true)
+	l.Headless(true)
+
+	lC, err := l.Client()
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrUnknown, "launcher.Client")
+	}
+
+	b := rod.New().Client(lC)
+	if err := b.Connect(); err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrUnknown, "browser.Connect")
+	}
+
+	return b, nil
+}
+
+// reconnect drops the browser of the given generation so the next borrow launches a new one
+func (p *Pool) reconnect(gen uint64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.browser == nil || p.gen != gen {
+		return
+	}
+
+	_ = p.browser.Close()
+	p.browser = nil
+	p.gen++
+
+	p.drain()
+}
+
+func (p *Pool) drain() {
+	for {
+		select {
+		case pg := <-p.idle:
+			_ = pg.Close()
+		default:
+			return
+		}
+	}
+}
+
+func (p *Pool) generation() uint64 {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.gen
+}
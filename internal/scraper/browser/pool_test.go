@@ -0,0 +1,207 @@
+package browser
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/go-rod/rod"
+	"github.com/go-rod/rod/lib/cdp"
+	"github.com/go-rod/rod/lib/proto"
+	"go.uber.org/zap/zaptest"
+)
+
+// fakeCDP answers the CDP calls the pool makes, without a browser
+// Once killed every call fails like a dropped connection
+type fakeCDP struct {
+	mu      sync.Mutex
+	dead    bool
+	targets int
+	closed  []string
+	events  chan *cdp.Event
+}
+
+func newFakeCDP() *fakeCDP {
+	return &fakeCDP{events: make(chan *cdp.Event, 16)}
+}
+
+func (f *fakeCDP) Event() <-chan *cdp.Event {
+	return f.events
+}
+
+func (f *fakeCDP) Call(_ context.Context, sessionID, method string, params interface{}) ([]byte, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.dead {
+		return nil, errors.New("websocket: close 1006 (abnormal closure)")
+	}
+
+	switch method {
+	case "Target.createTarget":
+		f.targets++
+		return json.Marshal(proto.TargetCreateTargetResult{TargetID: proto.TargetTargetID(fmt.Sprintf("target-%d", f.targets))})
+	case "Target.attachToTarget":
+		target := params.(proto.TargetAttachToTarget).TargetID
+		return json.Marshal(proto.TargetAttachToTargetResult{SessionID: proto.TargetSessionID(target)})
+	case "Page.close":
+		// a page closes once the browser reports its target destroyed, the session is named after the target
+		f.closed = append(f.closed, sessionID)
+		destroyed, _ := json.Marshal(proto.TargetTargetDestroyed{TargetID: proto.TargetTargetID(sessionID)})
+		f.events <- &cdp.Event{Method: "Target.targetDestroyed", Params: destroyed}
+		return []byte("{}"), nil
+	case "Browser.close":
+		f.dead = true
+		return []byte("{}"), nil
+	case "Browser.getVersion":
+		return json.Marshal(proto.BrowserGetVersionResult{Product: "fake"})
+	case "Page.navigate":
+		return json.Marshal(proto.PageNavigateResult{FrameID: proto.PageFrameID(sessionID)})
+	case "Runtime.evaluate", "Runtime.callFunctionOn":
+		return []byte(`{"result":{"type":"object","objectId":"object"}}`), nil
+	default:
+		return []byte("{}"), nil
+	}
+}
+
+func (f *fakeCDP) kill() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.dead = true
+}
+
+func (f *fakeCDP) closedTargets() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return append([]string(nil), f.closed...)
+}
+
+// newTestPool returns a pool of one page whose browsers are fakes, in the order they were dialed
+func newTestPool(t *testing.T) (*Pool, func() []*fakeCDP) {
+	t.Helper()
+
+	var (
+		mu       sync.Mutex
+		browsers []*fakeCDP
+	)
+
+	pool := NewPool("", 1, 0, zaptest.NewLogger(t))
+	pool.dial = func() (*rod.Browser, error) {
+		fake := newFakeCDP()
+
+		mu.Lock()
+		browsers = append(browsers, fake)
+		mu.Unlock()
+
+		b := rod.New().Client(fake)
+
+		return b, b.Connect()
+	}
+
+	return pool, func() []*fakeCDP {
+		mu.Lock()
+		defer mu.Unlock()
+
+		return append([]*fakeCDP(nil), browsers...)
+	}
+}
+
+func TestPool_ReconnectAfterBrowserDied(t *testing.T) {
+	ctx := context.Background()
+	pool, browsers := newTestPool(t)
+
+	_, release, err := pool.Page(ctx, "https://example.com/manga/")
+	if err != nil {
+		t.Fatalf("did not expect an error but got one: %v", err)
+	}
+
+	release()
+
+	browsers()[0].kill()
+
+	// the idle page of the dead browser fails to load and the browser is dropped
+	if _, _, err := pool.Page(ctx, "https://example.com/manga/"); err == nil {
+		t.Fatal("expected the dead browser to fail the page load")
+	}
+
+	_, release, err = pool.Page(ctx, "https://example.com/manga/")
+	if err != nil {
+		t.Fatalf("expected a page of a new browser, got %v", err)
+	}
+
+	release()
+
+	if got := len(browsers()); got != 2 {
+		t.Fatalf("expected the pool to dial a second browser, got %d browsers", got)
+	}
+
+	if err := pool.Ping(ctx); err != nil {
+		t.Errorf("expected the new browser to answer, got %v", err)
+	}
+}
+
+func TestPool_ReleaseAcrossGenerations(t *testing.T) {
+	ctx := context.Background()
+	pool, browsers := newTestPool(t)
+
+	_, release, err := pool.Page(ctx, "https://example.com/manga/")
+	if err != nil {
+		t.Fatalf("did not expect an error but got one: %v", err)
+	}
+
+	// the health check replaces the browser while the page is borrowed
+	first := browsers()[0]
+	pool.reconnect(pool.generation())
+
+	release()
+
+	if got := len(pool.idle); got != 0 {
+		t.Fatalf("expected the page of the old browser to be dropped, got %d idle pages", got)
+	}
+
+	_, release, err = pool.Page(ctx, "https://example.com/manga/")
+	if err != nil {
+		t.Fatalf("expected a page of a new browser, got %v", err)
+	}
+
+	release()
+
+	if got := len(browsers()); got != 2 {
+		t.Fatalf("expected the pool to dial a second browser, got %d browsers", got)
+	}
+
+	// the old page went down with its browser instead of going back to the pool
+	if closed := first.closedTargets(); len(closed) != 0 {
+		t.Errorf("expected no page close on the replaced browser, got %v", closed)
+	}
+
+	if got := len(pool.idle); got != 1 {
+		t.Errorf("expected the page of the new browser back in the pool, got %d idle pages", got)
+	}
+}
+
+func TestPool_DiscardAfterMaxUses(t *testing.T) {
+	ctx := context.Background()
+	pool, browsers := newTestPool(t)
+	pool.maxUses = 1
+
+	_, release, err := pool.Page(ctx, "https://example.com/manga/")
+	if err != nil {
+		t.Fatalf("did not expect an error but got one: %v", err)
+	}
+
+	release()
+
+	if closed := browsers()[0].closedTargets(); len(closed) != 1 || closed[0] != "target-1" {
+		t.Errorf("expected the used up page to be closed, got closed pages %v", closed)
+	}
+
+	if got := len(pool.idle); got != 0 {
+		t.Errorf("expected no idle page, got %d", got)
+	}
+}
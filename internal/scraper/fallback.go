@@ -0,0 +1,140 @@
+package scraper
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"fourleaves.studio/manga-scraper/internal"
+	"fourleaves.studio/manga-scraper/internal/scraper/browser"
+	"fourleaves.studio/manga-scraper/internal/scraper/registry"
+)
+
+// fallbackScraper tries the static scraper first and retries with the browser scraper when it fails
+// or when its result is missing the fields every page of the type has, which happens when a selector
+// matches the wrong element instead of nothing
+type fallbackScraper struct {
+	static  registry.ProviderScraper
+	browser registry.ProviderScraper
+	logger  *zap.Logger
+}
+
+func (f fallbackScraper) ScrapeSeriesList(ctx context.Context, pool *browser.Pool, url string, logger *zap.Logger) ([]internal.SeriesListResult, error) {
+	result, err := f.static.ScrapeSeriesList(ctx, pool, url, logger)
+	if err == nil {
+		err = validSeriesList(result)
+	}
+	if err == nil {
+		return result, nil
+	}
+
+	f.warn(internal.SeriesListRequestType, url, err)
+
+	return f.browser.ScrapeSeriesList(ctx, pool, url, logger)
+}
+
+func (f fallbackScraper) ScrapeSeriesDetail(ctx context.Context, pool *browser.Pool, url string, logger *zap.Logger) (internal.SeriesDetailResult, error) {
+	result, err := f.static.ScrapeSeriesDetail(ctx, pool, url, logger)
+	if err == nil {
+		err = validSeriesDetail(result)
+	}
+	if err == nil {
+		return result, nil
+	}
+
+	f.warn(internal.SeriesDetailRequestType, url, err)
+
+	return f.browser.ScrapeSeriesDetail(ctx, pool, url, logger)
+}
+
+func (f fallbackScraper) ScrapeChapterList(ctx context.Context, pool *browser.Pool, url string, logger *zap.Logger) ([]internal.ChapterListResult, error) {
+	result, err := f.static.ScrapeChapterList(ctx, pool, url, logger)
+	if err == nil {
+		err = validChapterList(result)
+	}
+	if err == nil {
+		return result, nil
+	}
+
+	f.warn(internal.ChapterListRequestType, url, err)
+
+	return f.browser.ScrapeChapterList(ctx, pool, url, logger)
+}
+
+func (f fallbackScraper) ScrapeChapterDetail(ctx context.Context, pool *browser.Pool, url string, logger *zap.Logger) (internal.ChapterDetailResult, error) {
+	result, err := f.static.ScrapeChapterDetail(ctx, pool, url, logger)
+	if err == nil {
+		err = validChapterDetail(result)
+	}
+	if err == nil {
+		return result, nil
+	}
+
+	f.warn(internal.ChapterDetailRequestType, url, err)
+
+	return f.browser.ScrapeChapterDetail(ctx, pool, url, logger)
+}
+
+func (f fallbackScraper) Supports(requestType internal.ScrapeRequestType) bool {
+	return f.browser.Supports(requestType)
+}
+
+func (f fallbackScraper) warn(requestType internal.ScrapeRequestType, url string, err error) {
+	f.logger.Warn(
+		"static scrape failed, falling back to browser",
+		zap.String("type", string(requestType)),
+		zap.String("url", url),
+		zap.Error(err),
+	)
+}
+
+func validSeriesList(result []internal.SeriesListResult) error {
+	if len(result) == 0 {
+		return fmt.Errorf("no series found")
+	}
+
+	for _, series := range result {
+		if series.Title == "" || series.Slug == "" {
+			return fmt.Errorf("series without title or slug: %+v", series)
+		}
+	}
+
+	return nil
+}
+
+func validSeriesDetail(result internal.SeriesDetailResult) error {
+	if result.ThumbnailURL == "" && result.Synopsis == "" {
+		return fmt.Errorf("series without thumbnail and synopsis")
+	}
+
+	return nil
+}
+
+func validChapterList(result []internal.ChapterListResult) error {
+	if len(result) == 0 {
+		return fmt.Errorf("no chapters found")
+	}
+
+	for _, chapter := range result {
+		if chapter.Slug == "" || chapter.Href == "" {
+			return fmt.Errorf("chapter without slug or link: %+v", chapter)
+		}
+	}
+
+	return nil
+}
+
+func validChapterDetail(result internal.ChapterDetailResult) error {
+	var contentPaths []string
+	if err := json.Unmarshal(result.ContentPaths, &contentPaths); err != nil {
+		return fmt.Errorf("invalid content paths: %w", err)
+	}
+
+	if result.FullTitle == "" || len(contentPaths) == 0 {
+		return fmt.Errorf("chapter without title or pages")
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package scraper
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.uber.org/zap"
+
+	"fourleaves.studio/manga-scraper/internal"
+	"fourleaves.studio/manga-scraper/internal/scraper/browser"
+	"fourleaves.studio/manga-scraper/internal/scraper/registry"
+)
+
+func TestFallbackScraper_ScrapeSeriesList(t *testing.T) {
+	browserResult := []internal.SeriesListResult{{Title: "Reincarnator", Slug: "reincarnator", SourcePath: "/manga/reincarnator/"}}
+
+	tests := []struct {
+		name   string
+		static []internal.SeriesListResult
+		err    error
+		want   string
+	}{
+		{"StaticResult", []internal.SeriesListResult{{Title: "Omniscient Reader", Slug: "omniscient-reader"}}, nil, "omniscient-reader"},
+		{"StaticError", nil, errors.New("layout changed"), "reincarnator"},
+		{"Empty", nil, nil, "reincarnator"},
+		{"MissingTitle", []internal.SeriesListResult{{Slug: "omniscient-reader"}}, nil, "reincarnator"},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			f := fallbackScraper{
+				static: registry.Funcs{
+					SeriesList: func(context.Context, *browser.Pool, string, *zap.Logger) ([]internal.SeriesListResult, error) {
+						return tc.static, tc.err
+					},
+				},
+				browser: registry.Funcs{
+					SeriesList: func(context.Context, *browser.Pool, string, *zap.Logger) ([]internal.SeriesListResult, error) {
+						return browserResult, nil
+					},
+				},
+				logger: zap.NewNop(),
+			}
+
+			result, err := f.ScrapeSeriesList(context.Background(), nil, "https://example.com/manga/", zap.NewNop())
+			if err != nil {
+				t.Fatalf("did not expect an error but got one: %v", err)
+			}
+
+			if len(result) != 1 || result[0].Slug != tc.want {
+				t.Errorf("expected %s, got %+v", tc.want, result)
+			}
+		})
+	}
+}
+
+func TestValidChapterDetail(t *testing.T) {
+	tests := []struct {
+		name    string
+		result  internal.ChapterDetailResult
+		wantErr bool
+	}{
+		{"Valid", internal.ChapterDetailResult{FullTitle: "Reincarnator Chapter 1", ContentPaths: []byte(`["/1.jpg"]`)}, false},
+		{"NoTitle", internal.ChapterDetailResult{ContentPaths: []byte(`["/1.jpg"]`)}, true},
+		{"NoPages", internal.ChapterDetailResult{FullTitle: "Reincarnator Chapter 1", ContentPaths: []byte(`null`)}, true},
+		{"InvalidPages", internal.ChapterDetailResult{FullTitle: "Reincarnator Chapter 1"}, true},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validChapterDetail(tc.result)
+			if tc.wantErr && err == nil {
+				t.Errorf("expected an error but got none")
+			}
+			if !tc.wantErr && err != nil {
+				t.Errorf("did not expect an error but got one: %v", err)
+			}
+		})
+	}
+}
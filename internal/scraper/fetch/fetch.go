@@ -0,0 +1,78 @@
+package fetch
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+
+	"fourleaves.studio/manga-scraper/internal"
+	"fourleaves.studio/manga-scraper/internal/scraper/throttle"
+)
+
+const userAgent = "Mozilla/5.0 (X11; Linux x86_64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/126.0.0.0 Safari/537.36"
+
+// Client downloads provider pages over plain HTTP without rendering JavaScript
+type Client struct {
+	http *http.Client
+}
+
+func NewClient(timeout time.Duration) *Client {
+	return &Client{
+		http: &http.Client{Timeout: timeout},
+	}
+}
+
+// Document downloads url and parses it as HTML
+func (c *Client) Document(ctx context.Context, url string) (*goquery.Document, error) {
+	b, err := c.Get(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	doc, err := goquery.NewDocumentFromReader(bytes.NewReader(b))
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrUnknown, "goquery.NewDocumentFromReader")
+	}
+
+	return doc, nil
+}
+
+// Get downloads url and returns the raw response body
+func (c *Client) Get(ctx context.Context, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrInvalidInput, "http.NewRequest")
+	}
+
+	release, err := throttle.Acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer release()
+
+	req.Header.Set("User-Agent", userAgent)
+	req.Header.Set("Accept", "text/html,application/xhtml+xml")
+
+	res, err := c.http.Do(req)
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrUnknown, "http.Do")
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, internal.NewErrorf(internal.ErrUnknown, "unexpected status %d fetching %s", res.StatusCode, url)
+	}
+
+	b, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrUnknown, "io.ReadAll")
+	}
+
+	return b, nil
+}
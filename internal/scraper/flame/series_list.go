@@ -5,42 +5,20 @@ import (
 	"sync"
 
 	"fourleaves.studio/manga-scraper/internal"
+	"fourleaves.studio/manga-scraper/internal/scraper/browser"
 	"fourleaves.studio/manga-scraper/internal/scraper/helper"
 	"github.com/go-rod/rod"
-	"github.com/go-rod/rod/lib/launcher"
-	"github.com/go-rod/rod/lib/proto"
 	"go.uber.org/zap"
 )
 
 // TODO: exclude novel
-func ScrapeSeriesList(ctx context.Context, browserURL, listURL string, logger *zap.Logger) ([]internal.SeriesListResult, error) {
-	l, err := launcher.NewManaged(browserURL)
+func ScrapeSeriesList(ctx context.Context, pool *browser.Pool, listURL string, logger *zap.Logger) ([]internal.SeriesListResult, error) {
+	page, release, err := pool.Page(ctx, listURL)
 	if err != nil {
 		return nil, err
 	}
 
-	l.Leakless(true)
-	l.Headless(true)
-
-	lC, err := l.Client()
-	if err != nil {
-		return nil, err
-	}
-
-	browser := rod.New().Client(lC)
-	err = browser.Connect()
-	if err != nil {
-		return nil, err
-	}
-
-	defer browser.MustClose()
-
-	pg, err := browser.Page(proto.TargetCreateTarget{URL: listURL})
-	if err != nil {
-		return nil, err
-	}
-
-	page := pg.Context(ctx)
+	defer release()
 
 	var results []internal.SeriesListResult
 
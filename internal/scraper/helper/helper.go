@@ -4,6 +4,8 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+
+	"fourleaves.studio/manga-scraper/internal"
 )
 
 // remove unnecessary strings and get float value, ex:
@@ -41,6 +43,18 @@ func GetPostID(s string) string {
 	return sArr[len(sArr)-1]
 }
 
+// remove scheme and host from url, ex:
+// https://luminouscomics.org/series/1718323201-a-bad-person/ ---> /series/1718323201-a-bad-person/
+func GetPath(s string) string {
+	sArr := strings.Split(s, "/")
+
+	if len(sArr) < 4 {
+		return ""
+	}
+
+	return "/" + strings.Join(sArr[3:], "/")
+}
+
 // remove duplicate string in array
 func RemoveDuplicate(s []string) []string {
 	inResult := make(map[string]bool)
@@ -53,3 +67,54 @@ func RemoveDuplicate(s []string) []string {
 	}
 	return result
 }
+
+// map the status shown by the provider to a series status, ex:
+// "On Going" ---> ONGOING
+// an unknown status returns an empty string
+func GetSeriesStatus(s string) internal.SeriesStatus {
+	switch strings.ReplaceAll(strings.ToLower(strings.TrimSpace(s)), " ", "") {
+	case "ongoing", "publishing":
+		return internal.OngoingSeriesStatus
+	case "completed", "complete", "finished", "end", "ended":
+		return internal.CompletedSeriesStatus
+	case "hiatus", "onhold", "onhiatus", "paused":
+		return internal.HiatusSeriesStatus
+	case "dropped", "cancelled", "canceled", "discontinued":
+		return internal.DroppedSeriesStatus
+	default:
+		return ""
+	}
+}
+
+// get the year from a release date, ex:
+// "March 12, 2021" ---> 2021
+func GetReleaseYear(s string) int {
+	yearRegex := regexp.MustCompile(`\b(19|20)\d{2}\b`)
+	year, _ := strconv.Atoi(yearRegex.FindString(s))
+	return year
+}
+
+// fill the series detail from one row of the info table, ex:
+// text "Status Ongoing", value "Ongoing" ---> result.Status = ONGOING
+// rows without a value or with an unknown label are ignored
+func SetSeriesInfo(result *internal.SeriesDetailResult, text, value string) {
+	value = strings.TrimSpace(value)
+	if value == "" || value == "-" || strings.EqualFold(value, "N/A") {
+		return
+	}
+
+	label := strings.ToLower(strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(text), value)))
+
+	switch {
+	case strings.HasPrefix(label, "status"):
+		result.Status = GetSeriesStatus(value)
+	case strings.HasPrefix(label, "type"):
+		result.Type = value
+	case strings.HasPrefix(label, "author"):
+		result.Author = value
+	case strings.HasPrefix(label, "artist"):
+		result.Artist = value
+	case strings.HasPrefix(label, "release"):
+		result.ReleaseYear = GetReleaseYear(value)
+	}
+}
@@ -0,0 +1,207 @@
+package helper
+
+import (
+	"reflect"
+	"testing"
+
+	"fourleaves.studio/manga-scraper/internal"
+)
+
+func TestGetChapterNumber(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want float64
+	}{
+		{"Integer", "Chapter 12", 12},
+		{"Decimal", "190.5 - Notice", 190.5},
+		{"NoNumber", "Prologue", 0},
+		{"Empty", "", 0},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := GetChapterNumber(tc.in); got != tc.want {
+				t.Errorf("expected %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestGetChapterTitle(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"LineBreak", "Clever Cleaning Life Of\nThe Returned Genius Hunter", "Clever Cleaning Life Of The Returned Genius Hunter"},
+		{"Whitespace", "  Chapter 1 \n", "Chapter 1"},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := GetChapterTitle(tc.in); got != tc.want {
+				t.Errorf("expected %q, got %q", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestGetSlug(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"WithPrefix", "https://flamescans.org/series/1687730521-barbarian-quest/", "barbarian-quest"},
+		{"WithoutPrefix", "https://asuratoon.com/manga/solo-leveling/", "solo-leveling"},
+		{"Empty", "", ""},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := GetSlug(tc.in); got != tc.want {
+				t.Errorf("expected %q, got %q", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestGetPostID(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"Shortlink", "https://asuratoon.com/?p=280097", "280097"},
+		{"NoQuery", "280097", "280097"},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := GetPostID(tc.in); got != tc.want {
+				t.Errorf("expected %q, got %q", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestGetPath(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"Series", "https://luminouscomics.org/series/1718323201-a-bad-person/", "/series/1718323201-a-bad-person/"},
+		{"Root", "https://luminouscomics.org/", "/"},
+		{"NoPath", "luminouscomics.org", ""},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := GetPath(tc.in); got != tc.want {
+				t.Errorf("expected %q, got %q", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestRemoveDuplicate(t *testing.T) {
+	got := RemoveDuplicate([]string{"Action", "Drama", "Action", "Fantasy", "Drama"})
+	want := []string{"Action", "Drama", "Fantasy"}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+}
+
+func TestGetSeriesStatus(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want internal.SeriesStatus
+	}{
+		{"Ongoing", "Ongoing", internal.OngoingSeriesStatus},
+		{"Spaced", " On Going ", internal.OngoingSeriesStatus},
+		{"Completed", "Completed", internal.CompletedSeriesStatus},
+		{"Hiatus", "On Hold", internal.HiatusSeriesStatus},
+		{"Dropped", "Cancelled", internal.DroppedSeriesStatus},
+		{"Unknown", "Coming Soon", ""},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := GetSeriesStatus(tc.in); got != tc.want {
+				t.Errorf("expected %q, got %q", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestGetReleaseYear(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want int
+	}{
+		{"Year", "2021", 2021},
+		{"Date", "March 12, 2019", 2019},
+		{"NoYear", "Unknown", 0},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := GetReleaseYear(tc.in); got != tc.want {
+				t.Errorf("expected %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestSetSeriesInfo(t *testing.T) {
+	rows := [][2]string{
+		{"Status Completed", "Completed"},
+		{"Type Manhwa", "Manhwa"},
+		{"Released 2020", "2020"},
+		{"Author Jang Sung-rak", "Jang Sung-rak"},
+		{"Artist -", "-"},
+		{"Posted By admin", "admin"},
+	}
+
+	var got internal.SeriesDetailResult
+	for _, row := range rows {
+		SetSeriesInfo(&got, row[0], row[1])
+	}
+
+	want := internal.SeriesDetailResult{
+		Status:      internal.CompletedSeriesStatus,
+		Type:        "Manhwa",
+		Author:      "Jang Sung-rak",
+		ReleaseYear: 2020,
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %+v, got %+v", want, got)
+	}
+}
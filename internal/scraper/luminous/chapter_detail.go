@@ -7,41 +7,18 @@ import (
 	"strings"
 
 	"fourleaves.studio/manga-scraper/internal"
+	"fourleaves.studio/manga-scraper/internal/scraper/browser"
 	"fourleaves.studio/manga-scraper/internal/scraper/helper"
-	"github.com/go-rod/rod"
-	"github.com/go-rod/rod/lib/launcher"
-	"github.com/go-rod/rod/lib/proto"
 	"go.uber.org/zap"
 )
 
-func ScrapeChapterDetail(ctx context.Context, browserURL, chapterURL string, logger *zap.Logger) (internal.ChapterDetailResult, error) {
-	l, err := launcher.NewManaged(browserURL)
+func ScrapeChapterDetail(ctx context.Context, pool *browser.Pool, chapterURL string, logger *zap.Logger) (internal.ChapterDetailResult, error) {
+	page, release, err := pool.Page(ctx, chapterURL)
 	if err != nil {
 		return internal.ChapterDetailResult{}, err
 	}
 
-	l.Leakless(true)
-	l.Headless(true)
-
-	lC, err := l.Client()
-	if err != nil {
-		return internal.ChapterDetailResult{}, err
-	}
-
-	browser := rod.New().Client(lC)
-	err = browser.Connect()
-	if err != nil {
-		return internal.ChapterDetailResult{}, err
-	}
-
-	defer browser.MustClose()
-
-	pg, err := browser.Page(proto.TargetCreateTarget{URL: chapterURL})
-	if err != nil {
-		return internal.ChapterDetailResult{}, err
-	}
-
-	page := pg.Context(ctx)
+	defer release()
 
 	elFT, err := page.Element("h1.entry-title")
 	if err != nil {
@@ -127,7 +104,7 @@ func ScrapeChapterDetail(ctx context.Context, browserURL, chapterURL string, log
 	var nextPath string
 
 	if nextHref != "" {
-		err := page.Navigate(nextHref)
+		err := browser.Navigate(page, nextHref)
 		if err != nil {
 			return internal.ChapterDetailResult{}, err
 		}
@@ -151,7 +128,7 @@ func ScrapeChapterDetail(ctx context.Context, browserURL, chapterURL string, log
 	var prevPath string
 
 	if prevHref != "" {
-		err := page.Navigate(prevHref)
+		err := browser.Navigate(page, prevHref)
 		if err != nil {
 			return internal.ChapterDetailResult{}, err
 		}
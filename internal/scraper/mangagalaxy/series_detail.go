@@ -7,41 +7,19 @@ import (
 	"strings"
 
 	"fourleaves.studio/manga-scraper/internal"
+	"fourleaves.studio/manga-scraper/internal/scraper/browser"
 	"fourleaves.studio/manga-scraper/internal/scraper/helper"
-	"github.com/go-rod/rod"
-	"github.com/go-rod/rod/lib/launcher"
-	"github.com/go-rod/rod/lib/proto"
+	"fourleaves.studio/manga-scraper/internal/scraper/themesia"
 	"go.uber.org/zap"
 )
 
-func ScrapeSeriesDetail(ctx context.Context, browserURL, seriesURL string, logger *zap.Logger) (internal.SeriesDetailResult, error) {
-	l, err := launcher.NewManaged(browserURL)
+func ScrapeSeriesDetail(ctx context.Context, pool *browser.Pool, seriesURL string, logger *zap.Logger) (internal.SeriesDetailResult, error) {
+	page, release, err := pool.Page(ctx, seriesURL)
 	if err != nil {
 		return internal.SeriesDetailResult{}, err
 	}
 
-	l.Leakless(true)
-	l.Headless(true)
-
-	lC, err := l.Client()
-	if err != nil {
-		return internal.SeriesDetailResult{}, err
-	}
-
-	browser := rod.New().Client(lC)
-	err = browser.Connect()
-	if err != nil {
-		return internal.SeriesDetailResult{}, err
-	}
-
-	defer browser.MustClose()
-
-	pg, err := browser.Page(proto.TargetCreateTarget{URL: seriesURL})
-	if err != nil {
-		return internal.SeriesDetailResult{}, err
-	}
-
-	page := pg.Context(ctx)
+	defer release()
 
 	elTH, err := page.Element("div.thumb > img")
 	if err != nil {
@@ -141,6 +119,10 @@ func ScrapeSeriesDetail(ctx context.Context, browserURL, seriesURL string, logge
 		Genres:       genres,
 	}
 
+	if err := themesia.SetSeriesInfo(page, themesia.DefaultSelectors, &result); err != nil {
+		return internal.SeriesDetailResult{}, err
+	}
+
 	logger.Debug("Scraped series detail", zap.Any("result", result))
 
 	return result, nil
@@ -0,0 +1,98 @@
+package scraper
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+)
+
+// offsetTracker keeps commits ordered while messages are processed concurrently
+// A partition is only committed up to the oldest message that is still in flight,
+// so a crash never skips a message that was overtaken by a faster one
+type offsetTracker struct {
+	mu         sync.Mutex
+	partitions map[string]*partitionOffsets
+}
+
+type partitionOffsets struct {
+	pending []kafka.Offset
+	done    map[kafka.Offset]bool
+}
+
+func newOffsetTracker() *offsetTracker {
+	return &offsetTracker{
+		partitions: make(map[string]*partitionOffsets),
+	}
+}
+
+// start registers a polled message, messages of a partition must be started in the order they were polled
+func (t *offsetTracker) start(tp kafka.TopicPartition) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	p, ok := t.partitions[partitionKey(tp)]
+	if !ok {
+		p = &partitionOffsets{done: make(map[kafka.Offset]bool)}
+		t.partitions[partitionKey(tp)] = p
+	}
+
+	p.pending = append(p.pending, tp.Offset)
+}
+
+// done marks a message as processed and returns the offset to commit, if any became committable
+func (t *offsetTracker) done(tp kafka.TopicPartition) (kafka.TopicPartition, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	p, ok := t.partitions[partitionKey(tp)]
+	if !ok {
+		return kafka.TopicPartition{}, false
+	}
+
+	p.done[tp.Offset] = true
+
+	var last kafka.Offset
+
+	committable := false
+
+	for len(p.pending) > 0 && p.done[p.pending[0]] {
+		last = p.pending[0]
+		delete(p.done, last)
+		p.pending = p.pending[1:]
+		committable = true
+	}
+
+	if !committable {
+		return kafka.TopicPartition{}, false
+	}
+
+	// the committed offset is the next message to consume
+	return kafka.TopicPartition{
+		Topic:     tp.Topic,
+		Partition: tp.Partition,
+		Offset:    last + 1,
+	}, true
+}
+
+// inFlight returns the number of started messages that are not committable yet
+func (t *offsetTracker) inFlight() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	n := 0
+	for _, p := range t.partitions {
+		n += len(p.pending)
+	}
+
+	return n
+}
+
+func partitionKey(tp kafka.TopicPartition) string {
+	topic := ""
+	if tp.Topic != nil {
+		topic = *tp.Topic
+	}
+
+	return fmt.Sprintf("%s/%d", topic, tp.Partition)
+}
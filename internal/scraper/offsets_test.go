@@ -0,0 +1,56 @@
+package scraper
+
+import (
+	"testing"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+)
+
+func TestOffsetTracker_CommitsInOrder(t *testing.T) {
+	topic := "scrape-request"
+	tp := func(partition int32, offset kafka.Offset) kafka.TopicPartition {
+		return kafka.TopicPartition{Topic: &topic, Partition: partition, Offset: offset}
+	}
+
+	tracker := newOffsetTracker()
+
+	for offset := kafka.Offset(10); offset < 14; offset++ {
+		tracker.start(tp(0, offset))
+	}
+
+	tracker.start(tp(1, 3))
+
+	tests := []struct {
+		name       string
+		done       kafka.TopicPartition
+		wantCommit bool
+		wantOffset kafka.Offset
+	}{
+		{"OvertakesOldest", tp(0, 12), false, 0},
+		{"OtherPartition", tp(1, 3), true, 4},
+		{"OldestDone", tp(0, 10), true, 11},
+		{"FillsGap", tp(0, 11), true, 13},
+		{"Last", tp(0, 13), true, 14},
+		{"Unknown", tp(2, 1), false, 0},
+	}
+
+	// the steps depend on each other and must run in order
+	for _, tc := range tests {
+		commit, ok := tracker.done(tc.done)
+		if ok != tc.wantCommit {
+			t.Fatalf("%s: expected commit %v, got %v", tc.name, tc.wantCommit, ok)
+		}
+
+		if ok && commit.Offset != tc.wantOffset {
+			t.Errorf("%s: expected offset %d, got %d", tc.name, tc.wantOffset, commit.Offset)
+		}
+
+		if ok && commit.Partition != tc.done.Partition {
+			t.Errorf("%s: expected partition %d, got %d", tc.name, tc.done.Partition, commit.Partition)
+		}
+	}
+
+	if n := tracker.inFlight(); n != 0 {
+		t.Errorf("expected no messages in flight, got %d", n)
+	}
+}
@@ -0,0 +1,13 @@
+package scraper
+
+// Site packages register themselves with the provider registry on import
+import (
+	_ "fourleaves.studio/manga-scraper/internal/scraper/agscomics"
+	_ "fourleaves.studio/manga-scraper/internal/scraper/anigliscans"
+	_ "fourleaves.studio/manga-scraper/internal/scraper/asura"
+	_ "fourleaves.studio/manga-scraper/internal/scraper/flame"
+	_ "fourleaves.studio/manga-scraper/internal/scraper/luminous"
+	_ "fourleaves.studio/manga-scraper/internal/scraper/mangagalaxy"
+	_ "fourleaves.studio/manga-scraper/internal/scraper/nightscans"
+	_ "fourleaves.studio/manga-scraper/internal/scraper/surya"
+)
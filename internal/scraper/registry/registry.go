@@ -0,0 +1,172 @@
+package registry
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"go.uber.org/zap"
+
+	"fourleaves.studio/manga-scraper/internal"
+	"fourleaves.studio/manga-scraper/internal/scraper/browser"
+)
+
+// ProviderScraper is implemented by every site package that knows how to scrape a provider
+type ProviderScraper interface {
+	ScrapeSeriesList(ctx context.Context, pool *browser.Pool, url string, logger *zap.Logger) ([]internal.SeriesListResult, error)
+	ScrapeSeriesDetail(ctx context.Context, pool *browser.Pool, url string, logger *zap.Logger) (internal.SeriesDetailResult, error)
+	ScrapeChapterList(ctx context.Context, pool *browser.Pool, url string, logger *zap.Logger) ([]internal.ChapterListResult, error)
+	ScrapeChapterDetail(ctx context.Context, pool *browser.Pool, url string, logger *zap.Logger) (internal.ChapterDetailResult, error)
+	Supports(requestType internal.ScrapeRequestType) bool
+}
+
+// Funcs adapts a set of scrape functions to the ProviderScraper interface
+// A nil function marks the request type as unsupported
+type Funcs struct {
+	SeriesList    func(ctx context.Context, pool *browser.Pool, url string, logger *zap.Logger) ([]internal.SeriesListResult, error)
+	SeriesDetail  func(ctx context.Context, pool *browser.Pool, url string, logger *zap.Logger) (internal.SeriesDetailResult, error)
+	ChapterList   func(ctx context.Context, pool *browser.Pool, url string, logger *zap.Logger) ([]internal.ChapterListResult, error)
+	ChapterDetail func(ctx context.Context, pool *browser.Pool, url string, logger *zap.Logger) (internal.ChapterDetailResult, error)
+	// Selectors describe the MangaThemesia layout of the site, the static scraper of the provider is built from them
+	// Nil when the site cannot be scraped from static pages
+	Selectors *internal.ProviderSelectors
+}
+
+func (f Funcs) ScrapeSeriesList(ctx context.Context, pool *browser.Pool, url string, logger *zap.Logger) ([]internal.SeriesListResult, error) {
+	if f.SeriesList == nil {
+		return nil, errNotSupported(internal.SeriesListRequestType)
+	}
+
+	return f.SeriesList(ctx, pool, url, logger)
+}
+
+func (f Funcs) ScrapeSeriesDetail(ctx context.Context, pool *browser.Pool, url string, logger *zap.Logger) (internal.SeriesDetailResult, error) {
+	if f.SeriesDetail == nil {
+		return internal.SeriesDetailResult{}, errNotSupported(internal.SeriesDetailRequestType)
+	}
+
+	return f.SeriesDetail(ctx, pool, url, logger)
+}
+
+func (f Funcs) ScrapeChapterList(ctx context.Context, pool *browser.Pool, url string, logger *zap.Logger) ([]internal.ChapterListResult, error) {
+	if f.ChapterList == nil {
+		return nil, errNotSupported(internal.ChapterListRequestType)
+	}
+
+	return f.ChapterList(ctx, pool, url, logger)
+}
+
+func (f Funcs) ScrapeChapterDetail(ctx context.Context, pool *browser.Pool, url string, logger *zap.Logger) (internal.ChapterDetailResult, error) {
+	if f.ChapterDetail == nil {
+		return internal.ChapterDetailResult{}, errNotSupported(internal.ChapterDetailRequestType)
+	}
+
+	return f.ChapterDetail(ctx, pool, url, logger)
+}
+
+func (f Funcs) Supports(requestType internal.ScrapeRequestType) bool {
+	switch requestType {
+	case internal.SeriesListRequestType:
+		return f.SeriesList != nil
+	case internal.SeriesDetailRequestType:
+		return f.SeriesDetail != nil
+	case internal.ChapterListRequestType:
+		return f.ChapterList != nil
+	case internal.ChapterDetailRequestType:
+		return f.ChapterDetail != nil
+	default:
+		return false
+	}
+}
+
+func errNotSupported(requestType internal.ScrapeRequestType) error {
+	return internal.NewErrorf(internal.ErrInvalidInput, "request type %s not supported by provider", requestType)
+}
+
+// Info describes a registered provider and the request types it supports
+type Info struct {
+	Slug  string
+	Types []internal.ScrapeRequestType
+}
+
+var requestTypes = []internal.ScrapeRequestType{
+	internal.SeriesListRequestType,
+	internal.SeriesDetailRequestType,
+	internal.ChapterListRequestType,
+	internal.ChapterDetailRequestType,
+}
+
+var (
+	mu        sync.RWMutex
+	providers = make(map[string]ProviderScraper)
+)
+
+// Register makes a provider scraper available under the given slug
+// It is meant to be called from the init function of a site package and panics on duplicates
+func Register(slug string, s ProviderScraper) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	if s == nil {
+		panic("registry: Register scraper is nil for provider " + slug)
+	}
+
+	if _, dup := providers[slug]; dup {
+		panic("registry: Register called twice for provider " + slug)
+	}
+
+	providers[slug] = s
+}
+
+// Lookup returns the provider scraper registered under the given slug
+func Lookup(slug string) (ProviderScraper, error) {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	s, ok := providers[slug]
+	if !ok {
+		return nil, internal.NewErrorf(internal.ErrNotFound, "no scraper registered for provider %q", slug)
+	}
+
+	return s, nil
+}
+
+// Selectors returns the MangaThemesia selectors registered for the given slug
+// The boolean is false when the provider is unknown or was registered without selectors
+func Selectors(slug string) (internal.ProviderSelectors, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	f, ok := providers[slug].(Funcs)
+	if !ok || f.Selectors == nil {
+		return internal.ProviderSelectors{}, false
+	}
+
+	return *f.Selectors, true
+}
+
+// List returns every registered provider sorted by slug
+func List() []Info {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	infos := make([]Info, 0, len(providers))
+
+	for slug, s := range providers {
+		info := Info{Slug: slug}
+
+		for _, t := range requestTypes {
+			if s.Supports(t) {
+				info.Types = append(info.Types, t)
+			}
+		}
+
+		infos = append(infos, info)
+	}
+
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].Slug < infos[j].Slug
+	})
+
+	return infos
+}
@@ -0,0 +1,114 @@
+package registry_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.uber.org/zap"
+
+	"fourleaves.studio/manga-scraper/internal"
+	"fourleaves.studio/manga-scraper/internal/scraper/browser"
+	"fourleaves.studio/manga-scraper/internal/scraper/registry"
+)
+
+func TestRegistry(t *testing.T) {
+	t.Parallel()
+
+	seriesList := func(context.Context, *browser.Pool, string, *zap.Logger) ([]internal.SeriesListResult, error) {
+		return []internal.SeriesListResult{{Title: "Title", Slug: "slug", SourcePath: "/?p=1"}}, nil
+	}
+
+	registry.Register("test-provider", registry.Funcs{SeriesList: seriesList})
+	registry.Register("test-themesia", registry.Funcs{
+		SeriesList: seriesList,
+		Selectors:  &internal.ProviderSelectors{Genres: "div.genres a"},
+	})
+
+	t.Run("Lookup: OK", func(t *testing.T) {
+		t.Parallel()
+
+		p, err := registry.Lookup("test-provider")
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		res, err := p.ScrapeSeriesList(context.Background(), nil, "", zap.NewNop())
+		if err != nil || len(res) != 1 {
+			t.Fatalf("expected one result, got %v, %v", res, err)
+		}
+	})
+
+	t.Run("Lookup: ERR not found", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := registry.Lookup("unknown")
+
+		var ierr *internal.Error
+		if !errors.As(err, &ierr) || ierr.Code() != internal.ErrNotFound {
+			t.Fatalf("expected not found error, got %v", err)
+		}
+	})
+
+	t.Run("Unsupported type: ERR invalid input", func(t *testing.T) {
+		t.Parallel()
+
+		p, _ := registry.Lookup("test-provider")
+
+		_, err := p.ScrapeChapterDetail(context.Background(), nil, "", zap.NewNop())
+
+		var ierr *internal.Error
+		if !errors.As(err, &ierr) || ierr.Code() != internal.ErrInvalidInput {
+			t.Fatalf("expected invalid input error, got %v", err)
+		}
+	})
+
+	t.Run("Selectors: OK", func(t *testing.T) {
+		t.Parallel()
+
+		sel, ok := registry.Selectors("test-themesia")
+		if !ok || sel.Genres != "div.genres a" {
+			t.Fatalf("expected registered selectors, got %+v, %v", sel, ok)
+		}
+	})
+
+	t.Run("Selectors: none registered", func(t *testing.T) {
+		t.Parallel()
+
+		for _, slug := range []string{"test-provider", "unknown"} {
+			if sel, ok := registry.Selectors(slug); ok {
+				t.Fatalf("%s: expected no selectors, got %+v", slug, sel)
+			}
+		}
+	})
+
+	t.Run("List", func(t *testing.T) {
+		t.Parallel()
+
+		for _, info := range registry.List() {
+			if info.Slug != "test-provider" {
+				continue
+			}
+
+			if len(info.Types) != 1 || info.Types[0] != internal.SeriesListRequestType {
+				t.Fatalf("unexpected types %v", info.Types)
+			}
+
+			return
+		}
+
+		t.Fatal("test-provider not listed")
+	})
+
+	t.Run("Register: panics on duplicate", func(t *testing.T) {
+		t.Parallel()
+
+		defer func() {
+			if recover() == nil {
+				t.Fatal("expected panic")
+			}
+		}()
+
+		registry.Register("test-provider", registry.Funcs{})
+	})
+}
@@ -0,0 +1,103 @@
+package scraper
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/go-rod/rod"
+
+	"fourleaves.studio/manga-scraper/internal"
+	"fourleaves.studio/manga-scraper/internal/backoff"
+)
+
+// RetryPolicy controls how often a failed scrape request is re-enqueued
+// MaxAttempts includes the first attempt, so 1 disables retries
+type RetryPolicy struct {
+	MaxAttempts int
+	BaseDelay   time.Duration
+	MaxDelay    time.Duration
+}
+
+type retryDecision int
+
+const (
+	failPermanently retryDecision = iota
+	retryLater
+	deadLetter
+)
+
+// Backoff returns the delay before the given retry attempt, doubling from BaseDelay up to MaxDelay
+func (p RetryPolicy) Backoff(attempt int) time.Duration {
+	return backoff.Exponential(p.BaseDelay, p.MaxDelay, attempt)
+}
+
+// decide picks what happens to a request whose attempt (starting at 0) failed with err
+func (p RetryPolicy) decide(attempt int, err error) retryDecision {
+	if !IsTransient(err) {
+		return failPermanently
+	}
+
+	if attempt+1 >= p.MaxAttempts {
+		return deadLetter
+	}
+
+	return retryLater
+}
+
+// IsTransient reports whether a scrape error is worth retrying
+// Timeouts, network errors and browser failures are transient,
+// while missing records, invalid input, unsupported request types and pages that no longer parse are permanent
+func IsTransient(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+
+	if errors.Is(err, context.Canceled) {
+		return false
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return true
+	}
+
+	// a layout change fails the same way on every attempt
+	if isParseError(err) {
+		return false
+	}
+
+	var iErr *internal.Error
+	if errors.As(err, &iErr) {
+		switch iErr.Code() {
+		case internal.ErrNotFound, internal.ErrInvalidInput, internal.ErrUniqueConstraint:
+			return false
+		}
+	}
+
+	return true
+}
+
+// isParseError reports whether err comes from reading a page whose layout or scripts changed
+func isParseError(err error) bool {
+	var (
+		syntaxErr   *json.SyntaxError
+		typeErr     *json.UnmarshalTypeError
+		numErr      *strconv.NumError
+		notFoundErr *rod.ElementNotFoundError
+		expectErr   *rod.ExpectElementError
+	)
+
+	return errors.As(err, &syntaxErr) ||
+		errors.As(err, &typeErr) ||
+		errors.As(err, &numErr) ||
+		errors.As(err, &notFoundErr) ||
+		errors.As(err, &expectErr)
+}
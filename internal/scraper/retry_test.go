@@ -0,0 +1,107 @@
+package scraper
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/go-rod/rod"
+
+	"fourleaves.studio/manga-scraper/internal"
+)
+
+func TestRetryPolicy_Backoff(t *testing.T) {
+	policy := RetryPolicy{MaxAttempts: 5, BaseDelay: 30 * time.Second, MaxDelay: 5 * time.Minute}
+
+	tests := []struct {
+		name    string
+		attempt int
+		want    time.Duration
+	}{
+		{"FirstAttempt", 0, 0},
+		{"FirstRetry", 1, 30 * time.Second},
+		{"SecondRetry", 2, time.Minute},
+		{"ThirdRetry", 3, 2 * time.Minute},
+		{"FourthRetry", 4, 4 * time.Minute},
+		{"Capped", 5, 5 * time.Minute},
+		{"CappedFarOut", 60, 5 * time.Minute},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := policy.Backoff(tc.attempt); got != tc.want {
+				t.Errorf("expected %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestRetryPolicy_Decide(t *testing.T) {
+	policy := RetryPolicy{MaxAttempts: 3, BaseDelay: time.Second, MaxDelay: time.Minute}
+	transient := internal.WrapErrorf(context.DeadlineExceeded, internal.ErrUnknown, "page.Navigate")
+	layout := internal.WrapErrorf(&rod.ElementNotFoundError{}, internal.ErrUnknown, "page.Element")
+	permanent := internal.NewErrorf(internal.ErrNotFound, "no scraper registered for provider %q", "unknown")
+
+	tests := []struct {
+		name    string
+		attempt int
+		err     error
+		want    retryDecision
+	}{
+		{"TransientFirstAttempt", 0, transient, retryLater},
+		{"TransientSecondAttempt", 1, transient, retryLater},
+		{"TransientExhausted", 2, transient, deadLetter},
+		{"Permanent", 0, permanent, failPermanently},
+		{"LayoutChanged", 0, layout, failPermanently},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := policy.decide(tc.attempt, tc.err); got != tc.want {
+				t.Errorf("expected %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestIsTransient(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"Nil", nil, false},
+		{"DeadlineExceeded", context.DeadlineExceeded, true},
+		{"WrappedDeadlineExceeded", internal.WrapErrorf(context.DeadlineExceeded, internal.ErrUnknown, "page.Navigate"), true},
+		{"Canceled", context.Canceled, false},
+		{"NetworkError", &net.OpError{Op: "dial", Err: errors.New("connection refused")}, true},
+		{"NotFound", internal.NewErrorf(internal.ErrNotFound, "chapter not found"), false},
+		{"InvalidInput", internal.NewErrorf(internal.ErrInvalidInput, "request type not supported by provider"), false},
+		{"Unknown", internal.NewErrorf(internal.ErrUnknown, "failed to update chapter"), true},
+		{"InvalidScript", internal.WrapErrorf(&json.SyntaxError{Offset: 1}, internal.ErrUnknown, "json.Unmarshal"), false},
+		{"InvalidNumber", &strconv.NumError{Func: "ParseFloat", Num: "ch", Err: strconv.ErrSyntax}, false},
+		{"MissingElement", internal.WrapErrorf(&rod.ElementNotFoundError{}, internal.ErrUnknown, "page.Element"), false},
+		{"Other", errors.New("navigation failed: net::ERR_CONNECTION_RESET"), true},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := IsTransient(tc.err); got != tc.want {
+				t.Errorf("expected %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"os"
 	"os/signal"
+	"sort"
 	"sync"
 	"syscall"
 	"time"
@@ -14,65 +15,121 @@ import (
 	"go.uber.org/zap"
 
 	"fourleaves.studio/manga-scraper/internal"
-	"fourleaves.studio/manga-scraper/internal/scraper/agscomics"
-	"fourleaves.studio/manga-scraper/internal/scraper/anigliscans"
-	"fourleaves.studio/manga-scraper/internal/scraper/asura"
-	"fourleaves.studio/manga-scraper/internal/scraper/flame"
-	"fourleaves.studio/manga-scraper/internal/scraper/luminous"
-	"fourleaves.studio/manga-scraper/internal/scraper/mangagalaxy"
-	"fourleaves.studio/manga-scraper/internal/scraper/nightscans"
-	"fourleaves.studio/manga-scraper/internal/scraper/surya"
+	"fourleaves.studio/manga-scraper/internal/scraper/browser"
+	"fourleaves.studio/manga-scraper/internal/scraper/fetch"
+	"fourleaves.studio/manga-scraper/internal/scraper/registry"
+	"fourleaves.studio/manga-scraper/internal/scraper/themesia"
+	"fourleaves.studio/manga-scraper/internal/scraper/throttle"
 )
 
+type ProviderRepository interface {
+	Find(ctx context.Context, slug string) (internal.Provider, error)
+}
+
 type SeriesRepository interface {
 	UpsertInit(ctx context.Context, params internal.CreateInitSeriesParams) (internal.Series, error)
 	Find(ctx context.Context, params internal.FindSeriesParams) (internal.Series, error)
 	UpdateInit(ctx context.Context, params internal.UpdateInitSeriesParams) (internal.Series, error)
 	UpdateLatest(ctx context.Context, params internal.UpdateLatestSeriesParams) (internal.Series, error)
+	SyncChapters(ctx context.Context, params internal.FindSeriesParams) (internal.Series, error)
 }
 
 type ChapterRepository interface {
 	UpsertInit(ctx context.Context, params internal.CreateInitChapterParams) (internal.Chapter, error)
 	Find(ctx context.Context, params internal.FindChapterParams) (internal.Chapter, error)
 	FindLatest(ctx context.Context, params internal.FindChapterParams) (internal.Chapter, error)
+	FindSlugs(ctx context.Context, params internal.FindChapterParams) ([]string, error)
 	Count(ctx context.Context, params internal.FindChapterParams) (int, error)
 	UpdateInit(ctx context.Context, params internal.UpdateInitChapterParams) (internal.Chapter, error)
 }
 
 type ScrapeRequestRepository interface {
 	Find(ctx context.Context, id string) (internal.ScrapeRequest, error)
-	FindPendings(ctx context.Context, params internal.FindScrapeRequestParams) ([]internal.ScrapeRequest, error)
+	FindPaginated(ctx context.Context, params internal.FindScrapeRequestParams) ([]internal.ScrapeRequest, error)
 	Update(ctx context.Context, params internal.UpdateScrapeRequestParams) (internal.ScrapeRequest, error)
 }
 
+type ScrapeRequestMessageBroker interface {
+	Retry(ctx context.Context, params internal.ScrapeRequest, attempt int, notBefore time.Time) error
+	DeadLettered(ctx context.Context, params internal.ScrapeRequest, attempt int) error
+}
+
+type ChapterMessageBroker interface {
+	Released(ctx context.Context, params internal.ChapterRelease) error
+}
+
+// CacheInvalidator drops the cached series and chapters a scrape outdated
+type CacheInvalidator interface {
+	SeriesChanged(ctx context.Context, provider string, slugs ...string) error
+	ChaptersChanged(ctx context.Context, provider, series string, slugs ...string) error
+}
+
 type Scraper struct {
 	repo        ScrapeRequestRepository
+	provider    ProviderRepository
 	series      SeriesRepository
 	chapter     ChapterRepository
 	kafkaClient *kafka.Consumer
 	logger      *zap.Logger
-	browserURL  string
+	pool        *browser.Pool
+	fetcher     *fetch.Client
+	msgBroker   ScrapeRequestMessageBroker
+	chapterMsg  ChapterMessageBroker
+	cache       CacheInvalidator
+	retry       RetryPolicy
+	concurrency Concurrency
 	doneC       chan struct{}
 	closeC      chan struct{}
+
+	providerMu    sync.Mutex
+	providerSlots map[string]chan struct{}
+	limiters      *throttle.Registry
+}
+
+// Concurrency bounds how many scrape requests the worker processes at once,
+// in total and for a single provider
+type Concurrency struct {
+	Workers     int
+	PerProvider int
 }
 
 func NewScraper(
 	repo ScrapeRequestRepository,
+	provider ProviderRepository,
 	series SeriesRepository,
 	chapter ChapterRepository,
 	kafkaClient *kafka.Consumer,
 	logger *zap.Logger,
-	browserURL string,
+	pool *browser.Pool,
+	fetcher *fetch.Client,
+	msgBroker ScrapeRequestMessageBroker,
+	chapterMsg ChapterMessageBroker,
+	cache CacheInvalidator,
+	retry RetryPolicy,
+	concurrency Concurrency,
 ) *Scraper {
+	if concurrency.Workers < 1 {
+		concurrency.Workers = 1
+	}
+
 	return &Scraper{
-		repo:        repo,
-		series:      series,
-		chapter:     chapter,
-		kafkaClient: kafkaClient,
-		logger:      logger,
-		browserURL:  browserURL,
-		doneC:       make(chan struct{}),
-		closeC:      make(chan struct{}),
+		repo:          repo,
+		provider:      provider,
+		series:        series,
+		chapter:       chapter,
+		kafkaClient:   kafkaClient,
+		logger:        logger,
+		pool:          pool,
+		fetcher:       fetcher,
+		msgBroker:     msgBroker,
+		chapterMsg:    chapterMsg,
+		cache:         cache,
+		retry:         retry,
+		concurrency:   concurrency,
+		doneC:         make(chan struct{}),
+		closeC:        make(chan struct{}),
+		providerSlots: make(map[string]chan struct{}),
+		limiters:      throttle.NewRegistry(),
 	}
 }
 
@@ -90,11 +147,12 @@ func (s *Scraper) StartServer() (<-chan error, error) {
 
 		s.logger.Info("Shutdown signal received")
 
-		ctxTimeout, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		ctxTimeout, cancel := context.WithTimeout(context.Background(), requestTimeout+10*time.Second)
 
 		defer func() {
 			_ = s.logger.Sync()
 			_ = s.kafkaClient.Unsubscribe()
+			_ = s.pool.Close()
 
 			stop()
 			cancel()
@@ -108,6 +166,15 @@ func (s *Scraper) StartServer() (<-chan error, error) {
 		s.logger.Info("Shutdown completed")
 	}()
 
+	for _, p := range registry.List() {
+		types := make([]string, len(p.Types))
+		for i := range p.Types {
+			types[i] = string(p.Types[i])
+		}
+
+		s.logger.Info("Registered provider", zap.String("provider", p.Slug), zap.Strings("types", types))
+	}
+
 	go func() {
 		s.logger.Info("Listening and serving")
 
@@ -119,68 +186,92 @@ func (s *Scraper) StartServer() (<-chan error, error) {
 	return errC, nil
 }
 
-func (s *Scraper) ListenAndServe() error {
-	commit := func(msg *kafka.Message) {
-		if _, err := s.kafkaClient.CommitMessage(msg); err != nil {
-			s.logger.Error("commit failed", zap.Error(err))
-		}
-	}
+// requestTimeout bounds the processing of a single scrape request, shutdown waits this long for in-flight requests
+const requestTimeout = 2 * time.Minute
+
+// queuedPerWorker bounds how many consumed requests may wait for a slot, per worker and per provider slot,
+// so requests of a saturated provider queue up without starving the others
+const queuedPerWorker = 4
+
+type scrapeEvent struct {
+	Type      string
+	Value     internal.ScrapeRequest
+	Attempt   int
+	NotBefore time.Time
+}
 
+func (s *Scraper) ListenAndServe() error {
 	go func() {
+		var wg sync.WaitGroup
+
+		offsets := newOffsetTracker()
+		slots := make(chan struct{}, s.concurrency.Workers)
+		queue := newBacklog(s.concurrency)
+
+		// requests that can not be taken yet wait on a paused partition, so the consumer keeps polling
+		// and stays in the group: retries until their backoff has passed, others until there is room for them
+		paused := make(map[string]pausedPartition)
+
 		run := true
 
 		for run {
 			select {
 			case <-s.closeC:
 				run = false
+				continue
 			default:
-				msg, ok := s.kafkaClient.Poll(150).(*kafka.Message)
-				if !ok {
-					continue
-				}
+			}
 
-				var evt struct {
-					Type  string
-					Value internal.ScrapeRequest
-				}
+			s.resumePartitions(paused, queue)
 
-				if err := json.NewDecoder(bytes.NewReader(msg.Value)).Decode(&evt); err != nil {
-					s.logger.Info("Ignoring message, invalid", zap.Error(err))
-					commit(msg)
+			msg, ok := s.kafkaClient.Poll(150).(*kafka.Message)
+			if !ok {
+				continue
+			}
 
-					continue
-				}
+			var evt scrapeEvent
 
-				timeout := 2 * time.Minute
-
-				ctx, cancel := context.WithTimeout(context.Background(), timeout)
-				defer cancel()
-
-				// TODO: retry on temp network error
-				switch evt.Type {
-				case string(internal.SeriesListRequestType):
-					if err := s.ScrapeSeriesList(ctx, evt.Value); err != nil {
-						s.logger.Error("ScrapeSeriesList failed", zap.Error(err))
-					}
-				case string(internal.SeriesDetailRequestType):
-					if err := s.ScrapeSeriesDetail(ctx, evt.Value); err != nil {
-						s.logger.Error("ScrapeSeriesDetail failed", zap.Error(err))
-					}
-				case string(internal.ChapterListRequestType):
-					if err := s.ScrapeChapterList(ctx, evt.Value); err != nil {
-						s.logger.Error("ScrapeChapterList failed", zap.Error(err))
-					}
-				case string(internal.ChapterDetailRequestType):
-					if err := s.ScrapeChapterDetail(ctx, evt.Value); err != nil {
-						s.logger.Error("ScrapeChapterDetail failed", zap.Error(err))
-					}
-				}
+			if err := json.NewDecoder(bytes.NewReader(msg.Value)).Decode(&evt); err != nil {
+				s.logger.Info("Ignoring message, invalid", zap.Error(err))
+				offsets.start(msg.TopicPartition)
+				s.commit(offsets, msg.TopicPartition)
+
+				continue
+			}
+
+			provider := evt.Value.Provider
+
+			if time.Now().Before(evt.NotBefore) {
+				s.pausePartition(paused, msg.TopicPartition, evt.NotBefore, provider)
+				continue
+			}
 
-				s.logger.Info("Consumed", zap.String("type", evt.Type), zap.String("id", evt.Value.ID))
-				commit(msg)
+			if !queue.add(provider) {
+				s.pausePartition(paused, msg.TopicPartition, time.Time{}, provider)
+				continue
 			}
+
+			offsets.start(msg.TopicPartition)
+
+			wg.Add(1)
+
+			go func(tp kafka.TopicPartition) {
+				defer func() {
+					queue.done(provider)
+					wg.Done()
+				}()
+
+				// a request still queued at shutdown is left uncommitted and consumed again on restart
+				if s.process(evt, slots) {
+					s.commit(offsets, tp)
+				}
+			}(msg.TopicPartition)
 		}
 
+		s.logger.Info("Draining in-flight requests", zap.Int("count", offsets.inFlight()))
+
+		wg.Wait()
+
 		s.logger.Info("No more messages to consume. Exiting.")
 
 		s.doneC <- struct{}{}
@@ -189,6 +280,138 @@ func (s *Scraper) ListenAndServe() error {
 	return nil
 }
 
+// process runs a single scrape request once a slot for its provider and then a worker slot are free
+// The provider slot comes first, so a request waiting on a saturated provider never holds a worker slot
+// It reports false when the worker shut down before the request started
+func (s *Scraper) process(evt scrapeEvent, slots chan struct{}) bool {
+	release, ok := s.acquireProvider(evt.Value.Provider)
+	if !ok {
+		return false
+	}
+
+	defer release()
+
+	select {
+	case slots <- struct{}{}:
+		defer func() { <-slots }()
+	case <-s.closeC:
+		return false
+	}
+
+	evt.Value.Retries = evt.Attempt
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+
+	if receipt, err := s.repo.Find(ctx, evt.Value.ID); err == nil && receipt.Status == internal.CancelledRequestStatus {
+		s.logger.Info("Skipping cancelled request", zap.String("type", evt.Type), zap.String("id", evt.Value.ID))
+		return true
+	}
+
+	switch evt.Type {
+	case string(internal.SeriesListRequestType):
+		if err := s.ScrapeSeriesList(ctx, evt.Value); err != nil {
+			s.logger.Error("ScrapeSeriesList failed", zap.Int("attempt", evt.Attempt), zap.Error(err))
+		}
+	case string(internal.SeriesDetailRequestType):
+		if err := s.ScrapeSeriesDetail(ctx, evt.Value); err != nil {
+			s.logger.Error("ScrapeSeriesDetail failed", zap.Int("attempt", evt.Attempt), zap.Error(err))
+		}
+	case string(internal.ChapterListRequestType):
+		if err := s.ScrapeChapterList(ctx, evt.Value); err != nil {
+			s.logger.Error("ScrapeChapterList failed", zap.Int("attempt", evt.Attempt), zap.Error(err))
+		}
+	case string(internal.ChapterDetailRequestType):
+		if err := s.ScrapeChapterDetail(ctx, evt.Value); err != nil {
+			s.logger.Error("ScrapeChapterDetail failed", zap.Int("attempt", evt.Attempt), zap.Error(err))
+		}
+	}
+
+	s.logger.Info("Consumed", zap.String("type", evt.Type), zap.String("id", evt.Value.ID))
+
+	return true
+}
+
+// commit marks the message as processed and commits every offset of its partition that is no longer in flight
+func (s *Scraper) commit(offsets *offsetTracker, tp kafka.TopicPartition) {
+	next, ok := offsets.done(tp)
+	if !ok {
+		return
+	}
+
+	if _, err := s.kafkaClient.CommitOffsets([]kafka.TopicPartition{next}); err != nil {
+		s.logger.Error("commit failed", zap.String("partition", next.String()), zap.Error(err))
+	}
+}
+
+// acquireProvider blocks until the provider has a free slot and returns the function releasing it,
+// it gives up when the worker shuts down while waiting
+func (s *Scraper) acquireProvider(provider string) (func(), bool) {
+	if s.concurrency.PerProvider <= 0 {
+		return func() {}, true
+	}
+
+	s.providerMu.Lock()
+
+	slots, ok := s.providerSlots[provider]
+	if !ok {
+		slots = make(chan struct{}, s.concurrency.PerProvider)
+		s.providerSlots[provider] = slots
+	}
+
+	s.providerMu.Unlock()
+
+	select {
+	case slots <- struct{}{}:
+	case <-s.closeC:
+		return nil, false
+	}
+
+	return func() {
+		<-slots
+	}, true
+}
+
+type pausedPartition struct {
+	partition kafka.TopicPartition
+	until     time.Time
+	provider  string
+}
+
+// pausePartition stops fetching from the partition of a message that can not be taken yet
+// and rewinds to it, so it is consumed again once the partition is resumed
+func (s *Scraper) pausePartition(paused map[string]pausedPartition, tp kafka.TopicPartition, until time.Time, provider string) {
+	if err := s.kafkaClient.Pause([]kafka.TopicPartition{tp}); err != nil {
+		s.logger.Error("pause failed", zap.String("partition", tp.String()), zap.Error(err))
+	}
+
+	if err := s.kafkaClient.Seek(tp, 0); err != nil {
+		s.logger.Error("seek failed", zap.String("partition", tp.String()), zap.Error(err))
+	}
+
+	key := partitionKey(tp)
+	paused[key] = pausedPartition{partition: tp, until: until, provider: provider}
+
+	s.logger.Debug("Delaying request", zap.String("partition", key), zap.String("provider", provider), zap.Time("until", until))
+}
+
+// resumePartitions resumes the paused partitions whose message is due and has room in the backlog
+func (s *Scraper) resumePartitions(paused map[string]pausedPartition, queue *backlog) {
+	now := time.Now()
+
+	for key, p := range paused {
+		if now.Before(p.until) || !queue.hasRoom(p.provider) {
+			continue
+		}
+
+		if err := s.kafkaClient.Resume([]kafka.TopicPartition{p.partition}); err != nil {
+			s.logger.Error("resume failed", zap.String("partition", key), zap.Error(err))
+		}
+
+		delete(paused, key)
+	}
+}
+
 func (s *Scraper) Shutdown(ctx context.Context) error {
 	s.logger.Info("Shutting down server")
 
@@ -204,6 +427,100 @@ func (s *Scraper) Shutdown(ctx context.Context) error {
 	}
 }
 
+// providerScraper prefers the selector config stored on the provider record
+// and falls back to the site package registered for the provider
+// When the provider asks for static fetching of the request type and has its own selectors, the browser scraper is only used as a fallback
+// Providers with a rate limit get their limiter attached to every page the scraper opens
+func (s *Scraper) providerScraper(ctx context.Context, slug string, requestType internal.ScrapeRequestType) (registry.ProviderScraper, error) {
+	provider, err := s.provider.Find(ctx, slug)
+	if err != nil {
+		s.logger.Warn("failed to find provider, using registered scraper", zap.String("provider", slug), zap.Error(err))
+	}
+
+	var browserScraper registry.ProviderScraper
+
+	found := err == nil
+
+	if found && !provider.Selectors.IsZero() {
+		browserScraper = themesia.New(*provider.Selectors)
+	} else if browserScraper, err = registry.Lookup(slug); err != nil {
+		if !found {
+			return nil, err
+		}
+
+		// Providers that only exist in the database are stock MangaThemesia sites until selectors are set for them
+		browserScraper = themesia.New(themesia.DefaultSelectors)
+	}
+
+	scraper := browserScraper
+
+	// Static fetching needs selectors written for the site, either stored with the provider or registered by its site package
+	// The theme defaults alone can match the wrong elements
+	if provider.FetchModes.For(requestType) == internal.StaticFetchMode {
+		selectors, ok := registry.Selectors(slug)
+		if !provider.Selectors.IsZero() {
+			selectors, ok = *provider.Selectors, true
+		}
+
+		if ok {
+			scraper = fallbackScraper{
+				static:  themesia.NewStatic(selectors, s.fetcher),
+				browser: browserScraper,
+				logger:  s.logger,
+			}
+		} else {
+			s.logger.Warn("static fetch mode needs selectors, using the browser", zap.String("provider", slug))
+		}
+	}
+
+	if limiter := s.limiters.Get(slug, provider.RateLimit); limiter != nil {
+		scraper = throttledScraper{scraper: scraper, limiter: limiter}
+	}
+
+	return scraper, nil
+}
+
+// fail records a failed attempt of the request and decides what happens next
+// Transient errors are re-enqueued with backoff until the retry policy is exhausted,
+// after which the request is dead-lettered. Permanent errors mark the request as failed right away
+func (s *Scraper) fail(ctx context.Context, event internal.ScrapeRequest, totalTime float64, err error) error {
+	status := internal.FailedRequestStatus
+
+	switch s.retry.decide(event.Retries, err) {
+	case retryLater:
+		attempt := event.Retries + 1
+		notBefore := time.Now().Add(s.retry.Backoff(attempt))
+
+		if pErr := s.msgBroker.Retry(ctx, event, attempt, notBefore); pErr != nil {
+			s.logger.Error("failed to enqueue retry", zap.String("id", event.ID), zap.Error(pErr))
+			break
+		}
+
+		status = internal.PendingRequestStatus
+
+		s.logger.Info("Scheduled retry", zap.String("id", event.ID), zap.Int("attempt", attempt), zap.Time("notBefore", notBefore))
+	case deadLetter:
+		if pErr := s.msgBroker.DeadLettered(ctx, event, event.Retries); pErr != nil {
+			s.logger.Error("failed to publish dead letter", zap.String("id", event.ID), zap.Error(pErr))
+		}
+
+		status = internal.DeadLetterRequestStatus
+
+		s.logger.Warn("Dead-lettered request", zap.String("id", event.ID), zap.Int("attempt", event.Retries))
+	}
+
+	_, _ = s.repo.Update(ctx, internal.UpdateScrapeRequestParams{
+		ID:        event.ID,
+		Status:    status,
+		Retries:   event.Retries,
+		TotalTime: totalTime,
+		Error:     true,
+		Message:   err.Error(),
+	})
+
+	return err
+}
+
 var skipSeriesSlug = map[string]bool{
 	"worn-and-torn-newbie%d9%8e%d9%8e-1": true,
 	"novel-of-memorize":                  true,
@@ -214,7 +531,6 @@ var skipSeriesSlug = map[string]bool{
 
 func (s *Scraper) ScrapeSeriesList(ctx context.Context, event internal.ScrapeRequest) error {
 	var result []internal.SeriesListResult
-	var err error
 
 	s.logger.Info(
 		"received scrape request",
@@ -233,42 +549,22 @@ func (s *Scraper) ScrapeSeriesList(ctx context.Context, event internal.ScrapeReq
 
 	startTime := time.Now()
 
-	switch event.Provider {
-	case "asura":
-		result, err = asura.ScrapeSeriesList(scrapeCtx, s.browserURL, requestURL, s.logger)
-	case "surya":
-		result, err = surya.ScrapeSeriesList(scrapeCtx, s.browserURL, requestURL, s.logger)
-	case "flame":
-		result, err = flame.ScrapeSeriesList(scrapeCtx, s.browserURL, requestURL, s.logger)
-	case "luminous":
-		result, err = luminous.ScrapeSeriesList(scrapeCtx, s.browserURL, requestURL, s.logger)
-	case "anigliscans":
-		result, err = anigliscans.ScrapeSeriesList(scrapeCtx, s.browserURL, requestURL, s.logger)
-	case "agscomics":
-		result, err = agscomics.ScrapeSeriesList(scrapeCtx, s.browserURL, requestURL, s.logger)
-	case "nightscans":
-		result, err = nightscans.ScrapeSeriesList(scrapeCtx, s.browserURL, requestURL, s.logger)
-	case "mangagalaxy":
-		result, err = mangagalaxy.ScrapeSeriesList(scrapeCtx, s.browserURL, requestURL, s.logger)
-	default:
-		err = internal.NewErrorf(internal.ErrInvalidInput, "not implemented yet")
+	provider, err := s.providerScraper(ctx, event.Provider, internal.SeriesListRequestType)
+	if err == nil {
+		result, err = provider.ScrapeSeriesList(scrapeCtx, s.pool, requestURL, s.logger)
 	}
 
 	endTime := time.Since(startTime).Seconds()
 
 	if err != nil {
-		_, _ = s.repo.Update(ctx, internal.UpdateScrapeRequestParams{
-			ID:        event.ID,
-			Status:    internal.FailedRequestStatus,
-			TotalTime: endTime,
-			Error:     true,
-			Message:   err.Error(),
-		})
-
-		return err
+		return s.fail(ctx, event, endTime, err)
 	}
 
-	var wg sync.WaitGroup
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		upserts []string
+	)
 
 	wg.Add(len(result))
 
@@ -291,14 +587,23 @@ func (s *Scraper) ScrapeSeriesList(ctx context.Context, event internal.ScrapeReq
 				s.logger.Error("failed to create series", zap.Error(err))
 				return
 			}
+
+			mu.Lock()
+			upserts = append(upserts, result[i].Slug)
+			mu.Unlock()
 		}(i)
 	}
 
 	wg.Wait()
 
+	if len(upserts) > 0 {
+		s.invalidateSeries(ctx, event.Provider, upserts...)
+	}
+
 	_, err = s.repo.Update(ctx, internal.UpdateScrapeRequestParams{
 		ID:        event.ID,
 		Status:    internal.CompletedRequestStatus,
+		Retries:   event.Retries,
 		TotalTime: endTime,
 		Error:     false,
 		Message:   "Completed successfully",
@@ -309,7 +614,6 @@ func (s *Scraper) ScrapeSeriesList(ctx context.Context, event internal.ScrapeReq
 
 func (s *Scraper) ScrapeSeriesDetail(ctx context.Context, event internal.ScrapeRequest) error {
 	var result internal.SeriesDetailResult
-	var err error
 
 	s.logger.Info(
 		"received scrape request",
@@ -329,39 +633,15 @@ func (s *Scraper) ScrapeSeriesDetail(ctx context.Context, event internal.ScrapeR
 
 	startTime := time.Now()
 
-	switch event.Provider {
-	case "asura":
-		result, err = asura.ScrapeSeriesDetail(scrapeCtx, s.browserURL, requestURL, s.logger)
-	case "surya":
-		result, err = surya.ScrapeSeriesDetail(scrapeCtx, s.browserURL, requestURL, s.logger)
-	case "flame":
-		result, err = flame.ScrapeSeriesDetail(scrapeCtx, s.browserURL, requestURL, s.logger)
-	case "luminous":
-		result, err = luminous.ScrapeSeriesDetail(scrapeCtx, s.browserURL, requestURL, s.logger)
-	case "anigliscans":
-		result, err = anigliscans.ScrapeSeriesDetail(scrapeCtx, s.browserURL, requestURL, s.logger)
-	case "agscomics":
-		result, err = agscomics.ScrapeSeriesDetail(scrapeCtx, s.browserURL, requestURL, s.logger)
-	case "nightscans":
-		result, err = nightscans.ScrapeSeriesDetail(scrapeCtx, s.browserURL, requestURL, s.logger)
-	case "mangagalaxy":
-		result, err = mangagalaxy.ScrapeSeriesDetail(scrapeCtx, s.browserURL, requestURL, s.logger)
-	default:
-		err = internal.NewErrorf(internal.ErrInvalidInput, "not implemented yet")
+	provider, err := s.providerScraper(ctx, event.Provider, internal.SeriesDetailRequestType)
+	if err == nil {
+		result, err = provider.ScrapeSeriesDetail(scrapeCtx, s.pool, requestURL, s.logger)
 	}
 
 	endTime := time.Since(startTime).Seconds()
 
 	if err != nil {
-		_, _ = s.repo.Update(ctx, internal.UpdateScrapeRequestParams{
-			ID:        event.ID,
-			Status:    internal.FailedRequestStatus,
-			TotalTime: endTime,
-			Error:     true,
-			Message:   err.Error(),
-		})
-
-		return err
+		return s.fail(ctx, event, endTime, err)
 	}
 
 	_, err = s.series.UpdateInit(ctx, internal.UpdateInitSeriesParams{
@@ -370,22 +650,22 @@ func (s *Scraper) ScrapeSeriesDetail(ctx context.Context, event internal.ScrapeR
 		ThumbnailURL: result.ThumbnailURL,
 		Synopsis:     result.Synopsis,
 		Genres:       result.Genres,
+		Status:       result.Status,
+		Type:         result.Type,
+		Author:       result.Author,
+		Artist:       result.Artist,
+		ReleaseYear:  result.ReleaseYear,
 	})
 	if err != nil {
-		_, _ = s.repo.Update(ctx, internal.UpdateScrapeRequestParams{
-			ID:        event.ID,
-			Status:    internal.FailedRequestStatus,
-			TotalTime: endTime,
-			Error:     true,
-			Message:   err.Error(),
-		})
-
-		return err
+		return s.fail(ctx, event, endTime, err)
 	}
 
+	s.invalidateSeries(ctx, event.Provider, event.Series)
+
 	_, err = s.repo.Update(ctx, internal.UpdateScrapeRequestParams{
 		ID:        event.ID,
 		Status:    internal.CompletedRequestStatus,
+		Retries:   event.Retries,
 		TotalTime: endTime,
 		Error:     false,
 		Message:   "Completed successfully",
@@ -396,7 +676,6 @@ func (s *Scraper) ScrapeSeriesDetail(ctx context.Context, event internal.ScrapeR
 
 func (s *Scraper) ScrapeChapterList(ctx context.Context, event internal.ScrapeRequest) error {
 	var result []internal.ChapterListResult
-	var err error
 
 	s.logger.Info(
 		"received scrape request",
@@ -416,42 +695,40 @@ func (s *Scraper) ScrapeChapterList(ctx context.Context, event internal.ScrapeRe
 
 	startTime := time.Now()
 
-	switch event.Provider {
-	case "asura":
-		result, err = asura.ScrapeChapterList(scrapeCtx, s.browserURL, requestURL, s.logger)
-	case "surya":
-		result, err = surya.ScrapeChapterList(scrapeCtx, s.browserURL, requestURL, s.logger)
-	case "flame":
-		result, err = flame.ScrapeChapterList(scrapeCtx, s.browserURL, requestURL, s.logger)
-	case "luminous":
-		result, err = luminous.ScrapeChapterList(scrapeCtx, s.browserURL, requestURL, s.logger)
-	case "anigliscans":
-		result, err = anigliscans.ScrapeChapterList(scrapeCtx, s.browserURL, requestURL, s.logger)
-	case "agscomics":
-		result, err = agscomics.ScrapeChapterList(scrapeCtx, s.browserURL, requestURL, s.logger)
-	case "nightscans":
-		result, err = nightscans.ScrapeChapterList(scrapeCtx, s.browserURL, requestURL, s.logger)
-	case "mangagalaxy":
-		result, err = mangagalaxy.ScrapeChapterList(scrapeCtx, s.browserURL, requestURL, s.logger)
-	default:
-		err = internal.NewErrorf(internal.ErrInvalidInput, "not implemented yet")
+	provider, err := s.providerScraper(ctx, event.Provider, internal.ChapterListRequestType)
+	if err == nil {
+		result, err = provider.ScrapeChapterList(scrapeCtx, s.pool, requestURL, s.logger)
 	}
 
 	endTime := time.Since(startTime).Seconds()
 
 	if err != nil {
-		_, _ = s.repo.Update(ctx, internal.UpdateScrapeRequestParams{
-			ID:        event.ID,
-			Status:    internal.FailedRequestStatus,
-			TotalTime: endTime,
-			Error:     true,
-			Message:   err.Error(),
-		})
+		return s.fail(ctx, event, endTime, err)
+	}
+
+	existing, err := s.chapter.FindSlugs(ctx, internal.FindChapterParams{
+		Provider: event.Provider,
+		Series:   event.Series,
+	})
+	if err != nil {
+		return s.fail(ctx, event, endTime, err)
+	}
+
+	known := make(map[string]bool, len(existing))
+	for _, slug := range existing {
+		known[slug] = true
+	}
 
-		return err
+	// the chapters stored by a previous attempt that failed to publish their release are still new
+	for _, slug := range event.Unreleased {
+		delete(known, slug)
 	}
 
-	var wg sync.WaitGroup
+	var (
+		wg          sync.WaitGroup
+		mu          sync.Mutex
+		newChapters []int
+	)
 
 	wg.Add(len(result))
 
@@ -471,14 +748,85 @@ func (s *Scraper) ScrapeChapterList(ctx context.Context, event internal.ScrapeRe
 				s.logger.Error("failed to create chapter", zap.Error(err))
 				return
 			}
+
+			if !known[result[i].Slug] {
+				mu.Lock()
+				newChapters = append(newChapters, i)
+				mu.Unlock()
+			}
 		}(i)
 	}
 
 	wg.Wait()
 
+	series, err := s.series.SyncChapters(ctx, internal.FindSeriesParams{
+		Provider: event.Provider,
+		Slug:     event.Series,
+	})
+	if err != nil {
+		return s.fail(ctx, event, endTime, err)
+	}
+
+	chapters := make([]string, 0, len(result))
+	for i := range result {
+		chapters = append(chapters, result[i].Slug)
+	}
+
+	s.invalidateChapters(ctx, event.Provider, event.Series, chapters...)
+	s.invalidateSeries(ctx, event.Provider, event.Series)
+
+	if len(newChapters) > 0 {
+		sort.Ints(newChapters)
+
+		slugs := make([]string, 0, len(newChapters))
+		for _, i := range newChapters {
+			slugs = append(slugs, result[i].Slug)
+		}
+
+		s.logger.Info(
+			"new chapters found",
+			zap.String("provider", event.Provider),
+			zap.String("series", event.Series),
+			zap.Strings("chapters", slugs),
+			zap.Int("chaptersCount", series.ChaptersCount),
+			zap.String("latestChapter", series.LatestChapter),
+		)
+	}
+
+	// the first scrape of a series finds its whole back catalogue, none of which is a release
+	if len(newChapters) > 0 && len(existing) > 0 {
+		releasedAt := time.Now()
+
+		var unreleased []string
+
+		for _, i := range newChapters {
+			if err := s.chapterMsg.Released(ctx, internal.ChapterRelease{
+				Provider:    event.Provider,
+				Series:      event.Series,
+				SeriesTitle: series.Title,
+				Chapter:     result[i].Slug,
+				Number:      result[i].Number,
+				ShortTitle:  result[i].ShortTitle,
+				SourceURL:   result[i].Href,
+				ReleasedAt:  releasedAt,
+			}); err != nil {
+				s.logger.Error("failed to publish chapter release", zap.String("chapter", result[i].Slug), zap.Error(err))
+				unreleased = append(unreleased, result[i].Slug)
+			}
+		}
+
+		// the scrape is only complete once every release is published, the retry publishes the rest
+		if len(unreleased) > 0 {
+			event.Unreleased = unreleased
+
+			return s.fail(ctx, event, endTime, internal.NewErrorf(internal.ErrUnknown, "failed to publish %d chapter releases", len(unreleased)))
+		}
+	}
+
 	_, err = s.repo.Update(ctx, internal.UpdateScrapeRequestParams{
 		ID:        event.ID,
 		Status:    internal.CompletedRequestStatus,
+		Retries:   event.Retries,
 		TotalTime: endTime,
 		Error:     false,
 		Message:   "Completed successfully",
@@ -489,7 +837,6 @@ func (s *Scraper) ScrapeChapterList(ctx context.Context, event internal.ScrapeRe
 
 func (s *Scraper) ScrapeChapterDetail(ctx context.Context, event internal.ScrapeRequest) error {
 	var result internal.ChapterDetailResult
-	var err error
 
 	s.logger.Info(
 		"received scrape request",
@@ -510,39 +857,15 @@ func (s *Scraper) ScrapeChapterDetail(ctx context.Context, event internal.Scrape
 
 	startTime := time.Now()
 
-	switch event.Provider {
-	case "asura":
-		result, err = asura.ScrapeChapterDetail(scrapeCtx, s.browserURL, requestURL, s.logger)
-	case "surya":
-		result, err = surya.ScrapeChapterDetail(scrapeCtx, s.browserURL, requestURL, s.logger)
-	case "flame":
-		result, err = flame.ScrapeChapterDetail(scrapeCtx, s.browserURL, requestURL, s.logger)
-	case "luminous":
-		result, err = luminous.ScrapeChapterDetail(scrapeCtx, s.browserURL, requestURL, s.logger)
-	case "anigliscans":
-		result, err = anigliscans.ScrapeChapterDetail(scrapeCtx, s.browserURL, requestURL, s.logger)
-	case "agscomics":
-		result, err = agscomics.ScrapeChapterDetail(scrapeCtx, s.browserURL, requestURL, s.logger)
-	case "nightscans":
-		result, err = nightscans.ScrapeChapterDetail(scrapeCtx, s.browserURL, requestURL, s.logger)
-	case "mangagalaxy":
-		result, err = mangagalaxy.ScrapeChapterDetail(scrapeCtx, s.browserURL, requestURL, s.logger)
-	default:
-		err = internal.NewErrorf(internal.ErrInvalidInput, "not implemented yet")
+	provider, err := s.providerScraper(ctx, event.Provider, internal.ChapterDetailRequestType)
+	if err == nil {
+		result, err = provider.ScrapeChapterDetail(scrapeCtx, s.pool, requestURL, s.logger)
 	}
 
 	endTime := time.Since(startTime).Seconds()
 
 	if err != nil {
-		_, _ = s.repo.Update(ctx, internal.UpdateScrapeRequestParams{
-			ID:        event.ID,
-			Status:    internal.FailedRequestStatus,
-			TotalTime: endTime,
-			Error:     true,
-			Message:   err.Error(),
-		})
-
-		return err
+		return s.fail(ctx, event, endTime, err)
 	}
 
 	_, err = s.chapter.UpdateInit(ctx, internal.UpdateInitChapterParams{
@@ -558,20 +881,15 @@ func (s *Scraper) ScrapeChapterDetail(ctx context.Context, event internal.Scrape
 		PrevPath:     result.PrevPath,
 	})
 	if err != nil {
-		_, _ = s.repo.Update(ctx, internal.UpdateScrapeRequestParams{
-			ID:        event.ID,
-			Status:    internal.FailedRequestStatus,
-			TotalTime: endTime,
-			Error:     true,
-			Message:   err.Error(),
-		})
-
-		return err
+		return s.fail(ctx, event, endTime, err)
 	}
 
+	s.invalidateChapters(ctx, event.Provider, event.Series, event.Chapter)
+
 	_, err = s.repo.Update(ctx, internal.UpdateScrapeRequestParams{
 		ID:        event.ID,
 		Status:    internal.CompletedRequestStatus,
+		Retries:   event.Retries,
 		TotalTime: endTime,
 		Error:     false,
 		Message:   "Completed successfully",
@@ -579,3 +897,17 @@ func (s *Scraper) ScrapeChapterDetail(ctx context.Context, event internal.Scrape
 
 	return err
 }
+
+// invalidateSeries is best effort, the cached series expire on their own when the invalidation fails
+func (s *Scraper) invalidateSeries(ctx context.Context, provider string, slugs ...string) {
+	if err := s.cache.SeriesChanged(ctx, provider, slugs...); err != nil {
+		s.logger.Error("failed to invalidate cached series", zap.String("provider", provider), zap.Error(err))
+	}
+}
+
+// invalidateChapters is best effort, the cached chapters expire on their own when the invalidation fails
+func (s *Scraper) invalidateChapters(ctx context.Context, provider, series string, slugs ...string) {
+	if err := s.cache.ChaptersChanged(ctx, provider, series, slugs...); err != nil {
+		s.logger.Error("failed to invalidate cached chapters", zap.String("provider", provider), zap.String("series", series), zap.Error(err))
+	}
+}
@@ -0,0 +1,112 @@
+package scraper
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.uber.org/zap"
+
+	"fourleaves.studio/manga-scraper/internal"
+	"fourleaves.studio/manga-scraper/internal/scraper/browser"
+	"fourleaves.studio/manga-scraper/internal/scraper/registry"
+	"fourleaves.studio/manga-scraper/internal/scraper/throttle"
+)
+
+type fakeProviderRepo map[string]internal.Provider
+
+func (r fakeProviderRepo) Find(_ context.Context, slug string) (internal.Provider, error) {
+	provider, ok := r[slug]
+	if !ok {
+		return internal.Provider{}, internal.NewErrorf(internal.ErrNotFound, "provider not found")
+	}
+
+	return provider, nil
+}
+
+func init() {
+	registry.Register("test-registered", registry.Funcs{
+		SeriesList: func(context.Context, *browser.Pool, string, *zap.Logger) ([]internal.SeriesListResult, error) {
+			return []internal.SeriesListResult{{Slug: "registered"}}, nil
+		},
+	})
+	registry.Register("test-static", registry.Funcs{Selectors: &internal.ProviderSelectors{}})
+}
+
+func TestScraper_providerScraper(t *testing.T) {
+	static := &internal.ProviderFetchModes{SeriesList: internal.StaticFetchMode}
+
+	s := &Scraper{
+		provider: fakeProviderRepo{
+			"test-registered": {Slug: "test-registered"},
+			"test-database":   {Slug: "test-database", FetchModes: static},
+			"test-static":     {Slug: "test-static", FetchModes: static},
+		},
+		logger:   zap.NewNop(),
+		limiters: throttle.NewRegistry(),
+	}
+
+	t.Run("Registered", func(t *testing.T) {
+		t.Parallel()
+
+		scraper, err := s.providerScraper(context.Background(), "test-registered", internal.SeriesListRequestType)
+		if err != nil {
+			t.Fatalf("did not expect an error but got one: %v", err)
+		}
+
+		result, err := scraper.ScrapeSeriesList(context.Background(), nil, "", zap.NewNop())
+		if err != nil || len(result) != 1 || result[0].Slug != "registered" {
+			t.Errorf("expected the registered scraper, got %+v, %v", result, err)
+		}
+	})
+
+	t.Run("DatabaseOnly", func(t *testing.T) {
+		t.Parallel()
+
+		scraper, err := s.providerScraper(context.Background(), "test-database", internal.ChapterDetailRequestType)
+		if err != nil {
+			t.Fatalf("did not expect an error but got one: %v", err)
+		}
+
+		if !scraper.Supports(internal.ChapterDetailRequestType) {
+			t.Error("expected the theme scraper to support every request type")
+		}
+	})
+
+	t.Run("StaticRegisteredSelectors", func(t *testing.T) {
+		t.Parallel()
+
+		scraper, err := s.providerScraper(context.Background(), "test-static", internal.SeriesListRequestType)
+		if err != nil {
+			t.Fatalf("did not expect an error but got one: %v", err)
+		}
+
+		if _, ok := scraper.(fallbackScraper); !ok {
+			t.Errorf("expected a static scraper, got %T", scraper)
+		}
+	})
+
+	t.Run("StaticWithoutSelectors", func(t *testing.T) {
+		t.Parallel()
+
+		scraper, err := s.providerScraper(context.Background(), "test-database", internal.SeriesListRequestType)
+		if err != nil {
+			t.Fatalf("did not expect an error but got one: %v", err)
+		}
+
+		if _, ok := scraper.(fallbackScraper); ok {
+			t.Error("expected the browser scraper without selectors")
+		}
+	})
+
+	t.Run("Unknown", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := s.providerScraper(context.Background(), "test-unknown", internal.SeriesListRequestType)
+
+		var ierr *internal.Error
+		if !errors.As(err, &ierr) || ierr.Code() != internal.ErrNotFound {
+			t.Errorf("expected not found error, got %v", err)
+		}
+	})
+}
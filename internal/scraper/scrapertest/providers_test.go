@@ -0,0 +1,64 @@
+package scrapertest_test
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"fourleaves.studio/manga-scraper/internal/scraper/fetch"
+	"fourleaves.studio/manga-scraper/internal/scraper/registry"
+	"fourleaves.studio/manga-scraper/internal/scraper/scrapertest"
+	"fourleaves.studio/manga-scraper/internal/scraper/themesia"
+
+	_ "fourleaves.studio/manga-scraper/internal/scraper/agscomics"
+	_ "fourleaves.studio/manga-scraper/internal/scraper/anigliscans"
+	_ "fourleaves.studio/manga-scraper/internal/scraper/asura"
+	_ "fourleaves.studio/manga-scraper/internal/scraper/flame"
+	_ "fourleaves.studio/manga-scraper/internal/scraper/luminous"
+	_ "fourleaves.studio/manga-scraper/internal/scraper/mangagalaxy"
+	_ "fourleaves.studio/manga-scraper/internal/scraper/nightscans"
+	_ "fourleaves.studio/manga-scraper/internal/scraper/surya"
+)
+
+func TestProviders(t *testing.T) {
+	t.Parallel()
+
+	// Each provider keeps its snapshots in the testdata directory of its site package
+	tests := []struct {
+		slug string
+		dir  string
+	}{
+		{"agscomics", "agscomics"},
+		{"anigliscans", "anigliscans"},
+		{"asura", "asura"},
+		{"flame", "flame"},
+		{"luminous", "luminous"},
+		{"mangagalaxy", "mangagalaxy"},
+		{"nightscans", "nightscans"},
+		{"surya", "surya"},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.slug, func(t *testing.T) {
+			t.Parallel()
+
+			scraper, err := registry.Lookup(tc.slug)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			selectors, ok := registry.Selectors(tc.slug)
+			if !ok {
+				t.Fatalf("no selectors registered for provider %q", tc.slug)
+			}
+
+			scrapertest.Run(t, scrapertest.Suite{
+				Dir:     filepath.Join("..", tc.dir, "testdata"),
+				Browser: scraper,
+				Static:  themesia.NewStatic(selectors, fetch.NewClient(10*time.Second)),
+			})
+		})
+	}
+}
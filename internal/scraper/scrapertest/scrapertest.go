@@ -0,0 +1,143 @@
+// Package scrapertest runs provider scrapers against recorded HTML snapshots served from a local server.
+//
+// Snapshots live in the testdata directory of each site package:
+//
+//	testdata/sources.json          live pages the snapshots were recorded from
+//	testdata/pages/<path>/index.html
+//	testdata/golden/<mode>/<type>.json
+//
+// TestProviders drives every registered site from one table, adding a site means adding its row.
+// Occurrences of {{BASE_URL}} and {{HOST}} in a snapshot are replaced with the address of the test server.
+// Run the tests with UPDATE_GOLDEN=1 to rewrite the golden files after refreshing the snapshots.
+// CI compares against the committed golden files, the scheduled refresh records the live pages
+// with cmd/scraper-snapshot and opens a pull request when a golden file changes.
+package scrapertest
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"testing"
+)
+
+const (
+	BaseURLPlaceholder = "{{BASE_URL}}"
+	HostPlaceholder    = "{{HOST}}"
+)
+
+// Sources lists the live pages a snapshot directory was recorded from
+type Sources struct {
+	BaseURL    string `json:"baseURL"`
+	SeriesList string `json:"seriesList"`
+	Series     string `json:"series"`
+	Chapter    string `json:"chapter"`
+}
+
+// LoadSources reads testdata/sources.json from dir
+func LoadSources(t testing.TB, dir string) Sources {
+	t.Helper()
+
+	b, err := os.ReadFile(filepath.Join(dir, "sources.json"))
+	if err != nil {
+		t.Fatalf("read sources: %v", err)
+	}
+
+	var sources Sources
+	if err := json.Unmarshal(b, &sources); err != nil {
+		t.Fatalf("decode sources: %v", err)
+	}
+
+	return sources
+}
+
+// NewServer serves the snapshots under dir/pages until the test ends
+func NewServer(t testing.TB, dir string) *httptest.Server {
+	t.Helper()
+
+	var srv *httptest.Server
+
+	srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		name := filepath.Join(dir, "pages", filepath.FromSlash(path.Clean("/"+r.URL.Path)), "index.html")
+
+		b, err := os.ReadFile(name)
+		if err != nil {
+			http.NotFound(w, r)
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		_, _ = w.Write(Expand(b, srv.URL))
+	}))
+
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+// Expand replaces the placeholders of a snapshot with the given server address
+func Expand(b []byte, baseURL string) []byte {
+	b = bytes.ReplaceAll(b, []byte(BaseURLPlaceholder), []byte(baseURL))
+	b = bytes.ReplaceAll(b, []byte(HostPlaceholder), []byte(hostOf(baseURL)))
+
+	return b
+}
+
+// Collapse is the inverse of Expand, so recorded output does not depend on the server address
+func Collapse(b []byte, baseURL string) []byte {
+	b = bytes.ReplaceAll(b, []byte(baseURL), []byte(BaseURLPlaceholder))
+	b = bytes.ReplaceAll(b, []byte(hostOf(baseURL)), []byte(HostPlaceholder))
+
+	return b
+}
+
+// Golden compares got with the golden file, or rewrites the file when UPDATE_GOLDEN is set
+func Golden(t testing.TB, name string, got interface{}, baseURL string) {
+	t.Helper()
+
+	var buf bytes.Buffer
+
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+
+	if err := enc.Encode(got); err != nil {
+		t.Fatalf("encode result: %v", err)
+	}
+
+	b := Collapse(buf.Bytes(), baseURL)
+
+	if os.Getenv("UPDATE_GOLDEN") != "" {
+		if err := os.MkdirAll(filepath.Dir(name), 0o750); err != nil {
+			t.Fatalf("create golden dir: %v", err)
+		}
+
+		if err := os.WriteFile(name, b, 0o600); err != nil {
+			t.Fatalf("write golden: %v", err)
+		}
+
+		return
+	}
+
+	want, err := os.ReadFile(name)
+	if err != nil {
+		t.Fatalf("read golden: %v (run with UPDATE_GOLDEN=1 to create it)", err)
+	}
+
+	if !bytes.Equal(want, b) {
+		t.Errorf("result does not match %s\n--- want\n%s\n--- got\n%s", name, want, b)
+	}
+}
+
+func hostOf(baseURL string) string {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return baseURL
+	}
+
+	return u.Host
+}
@@ -0,0 +1,171 @@
+package scrapertest
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	"go.uber.org/zap/zaptest"
+
+	"fourleaves.studio/manga-scraper/internal/scraper/browser"
+	"fourleaves.studio/manga-scraper/internal/scraper/registry"
+)
+
+// Suite runs the four scrape functions of a provider against the snapshots in Dir
+type Suite struct {
+	Dir string
+	// Browser is the scraper registered for the provider, it only runs when ROD_BROWSER_URL is set
+	Browser registry.ProviderScraper
+	// Static is the HTTP-only scraper for the provider, it always runs
+	Static registry.ProviderScraper
+}
+
+// Run executes the suite, asserting the results against testdata/golden/browser and testdata/golden/static
+func Run(t *testing.T, s Suite) {
+	t.Helper()
+
+	sources := LoadSources(t, s.Dir)
+	srv := NewServer(t, s.Dir)
+
+	if s.Static != nil {
+		t.Run("static", func(t *testing.T) {
+			run(t, s.Static, nil, sources, srv.URL, filepath.Join(s.Dir, "golden", "static"))
+		})
+	}
+
+	if s.Browser != nil {
+		t.Run("browser", func(t *testing.T) {
+			run(t, s.Browser, BrowserPool(t), sources, srv.URL, filepath.Join(s.Dir, "golden", "browser"))
+		})
+	}
+}
+
+// BrowserPool connects to the browser at ROD_BROWSER_URL and skips the test when it is not set
+// The browser must be able to reach the local test server
+func BrowserPool(t *testing.T) *browser.Pool {
+	t.Helper()
+
+	browserURL := os.Getenv("ROD_BROWSER_URL")
+	if browserURL == "" {
+		t.Skip("ROD_BROWSER_URL is not set")
+	}
+
+	pool := browser.NewPool(browserURL, 1, 0, zaptest.NewLogger(t))
+	t.Cleanup(func() { _ = pool.Close() })
+
+	return pool
+}
+
+func run(t *testing.T, s registry.ProviderScraper, pool *browser.Pool, sources Sources, baseURL, goldenDir string) {
+	logger := zaptest.NewLogger(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	t.Cleanup(cancel)
+
+	t.Run("series list", func(t *testing.T) {
+		result, err := s.ScrapeSeriesList(ctx, pool, baseURL+sources.SeriesList, logger)
+		if err != nil {
+			t.Fatalf("ScrapeSeriesList: %v", err)
+		}
+
+		if len(result) == 0 {
+			t.Fatal("no series scraped")
+		}
+
+		for _, r := range result {
+			if r.Title == "" || r.Slug == "" || r.SourcePath == "" {
+				t.Errorf("incomplete series %+v", r)
+			}
+		}
+
+		sort.Slice(result, func(i, j int) bool { return result[i].Slug < result[j].Slug })
+
+		Golden(t, filepath.Join(goldenDir, "series_list.json"), result, baseURL)
+	})
+
+	t.Run("series detail", func(t *testing.T) {
+		result, err := s.ScrapeSeriesDetail(ctx, pool, baseURL+sources.Series, logger)
+		if err != nil {
+			t.Fatalf("ScrapeSeriesDetail: %v", err)
+		}
+
+		if result.ThumbnailURL == "" || result.Synopsis == "" {
+			t.Errorf("incomplete series detail %+v", result)
+		}
+
+		Golden(t, filepath.Join(goldenDir, "series_detail.json"), struct {
+			ThumbnailURL string
+			Synopsis     string
+			Genres       json.RawMessage
+			Status       string
+			Type         string
+			Author       string
+			Artist       string
+			ReleaseYear  int
+		}{
+			result.ThumbnailURL,
+			result.Synopsis,
+			result.Genres,
+			string(result.Status),
+			result.Type,
+			result.Author,
+			result.Artist,
+			result.ReleaseYear,
+		}, baseURL)
+	})
+
+	t.Run("chapter list", func(t *testing.T) {
+		result, err := s.ScrapeChapterList(ctx, pool, baseURL+sources.Series, logger)
+		if err != nil {
+			t.Fatalf("ScrapeChapterList: %v", err)
+		}
+
+		if len(result) == 0 {
+			t.Fatal("no chapters scraped")
+		}
+
+		for _, r := range result {
+			if r.Slug == "" || r.Href == "" || r.Number == 0 {
+				t.Errorf("incomplete chapter %+v", r)
+			}
+		}
+
+		sort.Slice(result, func(i, j int) bool { return result[i].Number < result[j].Number })
+
+		Golden(t, filepath.Join(goldenDir, "chapter_list.json"), result, baseURL)
+	})
+
+	t.Run("chapter detail", func(t *testing.T) {
+		result, err := s.ScrapeChapterDetail(ctx, pool, baseURL+sources.Chapter, logger)
+		if err != nil {
+			t.Fatalf("ScrapeChapterDetail: %v", err)
+		}
+
+		var contentPaths []string
+		if err := json.Unmarshal(result.ContentPaths, &contentPaths); err != nil || len(contentPaths) == 0 {
+			t.Errorf("no content paths scraped %s", result.ContentPaths)
+		}
+
+		Golden(t, filepath.Join(goldenDir, "chapter_detail.json"), struct {
+			FullTitle    string
+			SourcePath   string
+			ContentPaths json.RawMessage
+			NextPath     string
+			NextSlug     string
+			PrevPath     string
+			PrevSlug     string
+		}{
+			result.FullTitle,
+			result.SourcePath,
+			result.ContentPaths,
+			result.NextPath,
+			result.NextSlug,
+			result.PrevPath,
+			result.PrevSlug,
+		}, baseURL)
+	})
+}
@@ -0,0 +1,152 @@
+package themesia
+
+import (
+	"context"
+	"encoding/json"
+	"regexp"
+	"strings"
+
+	"github.com/go-rod/rod"
+	"go.uber.org/zap"
+
+	"fourleaves.studio/manga-scraper/internal"
+	"fourleaves.studio/manga-scraper/internal/scraper/browser"
+	"fourleaves.studio/manga-scraper/internal/scraper/helper"
+)
+
+func ScrapeChapterDetail(ctx context.Context, pool *browser.Pool, chapterURL string, sel internal.ProviderSelectors, logger *zap.Logger) (internal.ChapterDetailResult, error) {
+	page, release, err := pool.Page(ctx, chapterURL)
+	if err != nil {
+		return internal.ChapterDetailResult{}, err
+	}
+
+	defer release()
+
+	elFT, err := page.Element(sel.ChapterFullTitle)
+	if err != nil {
+		return internal.ChapterDetailResult{}, err
+	}
+
+	tFT, err := elFT.Text()
+	if err != nil {
+		return internal.ChapterDetailResult{}, err
+	}
+
+	fullTitle := strings.TrimSpace(tFT)
+
+	sourcePath, err := scrapeSourcePath(page, sel)
+	if err != nil {
+		return internal.ChapterDetailResult{}, err
+	}
+
+	elTS, err := page.ElementR("script", sel.ReaderScript)
+	if err != nil {
+		return internal.ChapterDetailResult{}, err
+	}
+
+	tTS, err := elTS.Text()
+	if err != nil {
+		return internal.ChapterDetailResult{}, err
+	}
+
+	var tsReader internal.TSReaderScript
+
+	scriptRegex := regexp.MustCompile(`^\s*` + regexp.QuoteMeta(sel.ReaderScript) + `\((.*)\);`)
+
+	match := scriptRegex.FindStringSubmatch(tTS)
+	if len(match) < 2 {
+		return internal.ChapterDetailResult{}, internal.NewErrorf(internal.ErrNotFound, "reader script not found")
+	}
+
+	err = json.Unmarshal([]byte(match[1]), &tsReader)
+	if err != nil {
+		return internal.ChapterDetailResult{}, err
+	}
+
+	if len(tsReader.Sources) == 0 {
+		return internal.ChapterDetailResult{}, internal.NewErrorf(internal.ErrNotFound, "reader sources not found")
+	}
+
+	var contentPaths []string
+
+	images := tsReader.Sources[0].Images
+	for i := range images {
+		if images[i] == "" {
+			continue
+		}
+
+		if imgPath := helper.GetPath(images[i]); imgPath != "" {
+			contentPaths = append(contentPaths, imgPath)
+		}
+	}
+
+	contentPathsJSON, err := json.Marshal(helper.RemoveDuplicate(contentPaths))
+	if err != nil {
+		return internal.ChapterDetailResult{}, err
+	}
+
+	nextHref := tsReader.NextURL
+	nextSlug := helper.GetSlug(nextHref)
+
+	prevHref := tsReader.PrevURL
+	prevSlug := helper.GetSlug(prevHref)
+
+	var nextPath string
+
+	if nextHref != "" {
+		if err := browser.Navigate(page, nextHref); err != nil {
+			return internal.ChapterDetailResult{}, err
+		}
+
+		nextPath, err = scrapeSourcePath(page, sel)
+		if err != nil {
+			return internal.ChapterDetailResult{}, err
+		}
+	}
+
+	var prevPath string
+
+	if prevHref != "" {
+		if err := browser.Navigate(page, prevHref); err != nil {
+			return internal.ChapterDetailResult{}, err
+		}
+
+		prevPath, err = scrapeSourcePath(page, sel)
+		if err != nil {
+			return internal.ChapterDetailResult{}, err
+		}
+	}
+
+	result := internal.ChapterDetailResult{
+		FullTitle:    fullTitle,
+		SourcePath:   sourcePath,
+		ContentPaths: contentPathsJSON,
+		NextPath:     nextPath,
+		NextSlug:     nextSlug,
+		PrevPath:     prevPath,
+		PrevSlug:     prevSlug,
+	}
+
+	logger.Debug("Scraped chapter detail", zap.Any("result", result))
+
+	return result, nil
+}
+
+// scrapeSourcePath reads the post id from the shortlink of the current page
+func scrapeSourcePath(page *rod.Page, sel internal.ProviderSelectors) (string, error) {
+	elHR, err := page.Element(sel.Shortlink)
+	if err != nil {
+		return "", err
+	}
+
+	href, err := elHR.Attribute("href")
+	if err != nil {
+		return "", err
+	}
+
+	if href == nil {
+		return "", internal.NewErrorf(internal.ErrNotFound, "shortlink not found")
+	}
+
+	return "/?p=" + helper.GetPostID(*href), nil
+}
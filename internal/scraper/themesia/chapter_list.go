@@ -0,0 +1,113 @@
+package themesia
+
+import (
+	"context"
+	"sync"
+
+	"github.com/go-rod/rod"
+	"go.uber.org/zap"
+
+	"fourleaves.studio/manga-scraper/internal"
+	"fourleaves.studio/manga-scraper/internal/scraper/browser"
+	"fourleaves.studio/manga-scraper/internal/scraper/helper"
+)
+
+func ScrapeChapterList(ctx context.Context, pool *browser.Pool, seriesURL string, sel internal.ProviderSelectors, logger *zap.Logger) ([]internal.ChapterListResult, error) {
+	page, release, err := pool.Page(ctx, seriesURL)
+	if err != nil {
+		return nil, err
+	}
+
+	defer release()
+
+	var results []internal.ChapterListResult
+
+	elC, err := page.Element(sel.ChapterListContainer)
+	if err != nil {
+		return nil, err
+	}
+
+	elAs, err := elC.Elements(sel.ChapterListLink)
+	if err != nil {
+		return nil, err
+	}
+
+	errCh := make(chan error, 1)
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+
+	wg.Add(len(elAs))
+
+	for _, e := range elAs {
+		go func(e *rod.Element) {
+			defer wg.Done()
+
+			href, err := e.Attribute("href")
+			if err != nil {
+				select {
+				case errCh <- err:
+				default:
+				}
+				return
+			}
+
+			if href == nil {
+				return
+			}
+
+			slug := helper.GetSlug(*href)
+
+			elT, err := e.Element(sel.ChapterTitle)
+			if err != nil {
+				select {
+				case errCh <- err:
+				default:
+				}
+				return
+			}
+
+			tT, err := elT.Text()
+			if err != nil {
+				select {
+				case errCh <- err:
+				default:
+				}
+				return
+			}
+
+			title := helper.GetChapterTitle(tT)
+
+			// Fall back to the chapter title when the number attribute is missing
+			chapterNumber := helper.GetChapterNumber(tT)
+
+			parents, err := e.Parents(sel.ChapterNumber)
+			if err == nil && !parents.Empty() {
+				dataNum, err := parents.First().Attribute(sel.ChapterNumberAttr)
+				if err == nil && dataNum != nil {
+					chapterNumber = helper.GetChapterNumber(*dataNum)
+				}
+			}
+
+			mu.Lock()
+			results = append(results, internal.ChapterListResult{
+				ShortTitle: title,
+				Slug:       slug,
+				Number:     chapterNumber,
+				Href:       *href,
+			})
+			mu.Unlock()
+		}(e)
+	}
+
+	wg.Wait()
+	close(errCh)
+
+	if err := <-errCh; err != nil {
+		return nil, err
+	}
+
+	logger.Debug("Scraped chapter list", zap.Int("count", len(results)))
+
+	return results, nil
+}
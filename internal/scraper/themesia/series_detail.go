@@ -0,0 +1,125 @@
+package themesia
+
+import (
+	"context"
+	"encoding/json"
+	"regexp"
+	"strings"
+
+	"github.com/go-rod/rod"
+	"go.uber.org/zap"
+
+	"fourleaves.studio/manga-scraper/internal"
+	"fourleaves.studio/manga-scraper/internal/scraper/browser"
+	"fourleaves.studio/manga-scraper/internal/scraper/helper"
+)
+
+func ScrapeSeriesDetail(ctx context.Context, pool *browser.Pool, seriesURL string, sel internal.ProviderSelectors, logger *zap.Logger) (internal.SeriesDetailResult, error) {
+	page, release, err := pool.Page(ctx, seriesURL)
+	if err != nil {
+		return internal.SeriesDetailResult{}, err
+	}
+
+	defer release()
+
+	elTH, err := page.Element(sel.Thumbnail)
+	if err != nil {
+		return internal.SeriesDetailResult{}, err
+	}
+
+	thumbnailURL, err := elTH.Attribute("src")
+	if err != nil {
+		return internal.SeriesDetailResult{}, err
+	}
+
+	if thumbnailURL == nil || !strings.HasPrefix(*thumbnailURL, "http") {
+		thumbnailURL, err = elTH.Attribute(sel.ThumbnailFallbackAttr)
+		if err != nil {
+			return internal.SeriesDetailResult{}, err
+		}
+	}
+
+	if thumbnailURL == nil {
+		return internal.SeriesDetailResult{}, internal.NewErrorf(internal.ErrNotFound, "thumbnail not found")
+	}
+
+	var synopsisArr []string
+
+	elSP, err := page.Elements(sel.Synopsis)
+	if err != nil {
+		return internal.SeriesDetailResult{}, err
+	}
+
+	if len(elSP) == 0 {
+		elSP, err = page.Elements(sel.SynopsisFallback)
+		if err != nil {
+			return internal.SeriesDetailResult{}, err
+		}
+	}
+
+	for _, e := range elSP {
+		text, _ := e.Text()
+		if text == "&nbsp;" || text == "\u00a0" || text == "" {
+			continue
+		}
+
+		synopsisArr = append(synopsisArr, text)
+	}
+
+	synopsisRegex := regexp.MustCompile(`\n`)
+	synopsis := synopsisRegex.ReplaceAllString(strings.Join(helper.RemoveDuplicate(synopsisArr), "<br />"), "<br />")
+
+	var genreArr []string
+
+	elG, err := page.Elements(sel.Genres)
+	if err != nil {
+		return internal.SeriesDetailResult{}, err
+	}
+
+	for _, e := range elG {
+		genre, _ := e.Text()
+		genreArr = append(genreArr, genre)
+	}
+
+	genres, err := json.Marshal(helper.RemoveDuplicate(genreArr))
+	if err != nil {
+		return internal.SeriesDetailResult{}, err
+	}
+
+	result := internal.SeriesDetailResult{
+		ThumbnailURL: *thumbnailURL,
+		Synopsis:     synopsis,
+		Genres:       genres,
+	}
+
+	if err := SetSeriesInfo(page, sel, &result); err != nil {
+		return internal.SeriesDetailResult{}, err
+	}
+
+	logger.Debug("Scraped series detail", zap.Any("result", result))
+
+	return result, nil
+}
+
+// SetSeriesInfo fills the status, type, author, artist and release year of result from the info rows of a series page
+// Rows without a value are skipped
+func SetSeriesInfo(page *rod.Page, sel internal.ProviderSelectors, result *internal.SeriesDetailResult) error {
+	elI, err := page.Elements(sel.SeriesInfo)
+	if err != nil {
+		return err
+	}
+
+	for _, e := range elI {
+		text, _ := e.Text()
+
+		elV, err := e.Elements(sel.SeriesInfoValue)
+		if err != nil || elV.Empty() {
+			continue
+		}
+
+		value, _ := elV.First().Text()
+		helper.SetSeriesInfo(result, text, value)
+	}
+
+	return nil
+}
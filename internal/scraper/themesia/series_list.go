@@ -0,0 +1,105 @@
+package themesia
+
+import (
+	"context"
+	"sync"
+
+	"github.com/go-rod/rod"
+	"go.uber.org/zap"
+
+	"fourleaves.studio/manga-scraper/internal"
+	"fourleaves.studio/manga-scraper/internal/scraper/browser"
+	"fourleaves.studio/manga-scraper/internal/scraper/helper"
+)
+
+func ScrapeSeriesList(ctx context.Context, pool *browser.Pool, listURL string, sel internal.ProviderSelectors, logger *zap.Logger) ([]internal.SeriesListResult, error) {
+	page, release, err := pool.Page(ctx, listURL)
+	if err != nil {
+		return nil, err
+	}
+
+	defer release()
+
+	var results []internal.SeriesListResult
+
+	el, err := page.Element(sel.SeriesListContainer)
+	if err != nil {
+		return nil, err
+	}
+
+	elA, err := el.Elements(sel.SeriesListLink)
+	if err != nil {
+		return nil, err
+	}
+
+	errCh := make(chan error, 1)
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+
+	wg.Add(len(elA))
+
+	for _, e := range elA {
+		go func(e *rod.Element) {
+			defer wg.Done()
+
+			title, err := e.Text()
+			if err != nil {
+				select {
+				case errCh <- err:
+				default:
+				}
+				return
+			}
+
+			postID, err := e.Attribute("rel")
+			if err != nil {
+				select {
+				case errCh <- err:
+				default:
+				}
+				return
+			}
+
+			href, err := e.Attribute("href")
+			if err != nil {
+				select {
+				case errCh <- err:
+				default:
+				}
+				return
+			}
+
+			if href == nil {
+				return
+			}
+
+			slug := helper.GetSlug(*href)
+
+			// Fall back to the series URL when the theme does not expose the post id
+			sourcePath := helper.GetPath(*href)
+			if postID != nil && *postID != "" {
+				sourcePath = "/?p=" + *postID
+			}
+
+			mu.Lock()
+			results = append(results, internal.SeriesListResult{
+				Title:      title,
+				Slug:       slug,
+				SourcePath: sourcePath,
+			})
+			mu.Unlock()
+		}(e)
+	}
+
+	wg.Wait()
+	close(errCh)
+
+	if err := <-errCh; err != nil {
+		return nil, err
+	}
+
+	logger.Debug("Scraped series list", zap.Int("count", len(results)))
+
+	return results, nil
+}
@@ -0,0 +1,293 @@
+package themesia
+
+import (
+	"context"
+	"encoding/json"
+	"regexp"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+	"go.uber.org/zap"
+
+	"fourleaves.studio/manga-scraper/internal"
+	"fourleaves.studio/manga-scraper/internal/scraper/browser"
+	"fourleaves.studio/manga-scraper/internal/scraper/fetch"
+	"fourleaves.studio/manga-scraper/internal/scraper/helper"
+	"fourleaves.studio/manga-scraper/internal/scraper/registry"
+)
+
+// NewStatic returns a provider scraper that parses the server rendered HTML without a browser
+// Every method fails with ErrNotFound when an expected element is missing so the caller can fall back
+func NewStatic(selectors internal.ProviderSelectors, client *fetch.Client) registry.ProviderScraper {
+	sel := WithDefaults(selectors)
+
+	return registry.Funcs{
+		SeriesList: func(ctx context.Context, _ *browser.Pool, url string, logger *zap.Logger) ([]internal.SeriesListResult, error) {
+			return StaticSeriesList(ctx, client, url, sel, logger)
+		},
+		SeriesDetail: func(ctx context.Context, _ *browser.Pool, url string, logger *zap.Logger) (internal.SeriesDetailResult, error) {
+			return StaticSeriesDetail(ctx, client, url, sel, logger)
+		},
+		ChapterList: func(ctx context.Context, _ *browser.Pool, url string, logger *zap.Logger) ([]internal.ChapterListResult, error) {
+			return StaticChapterList(ctx, client, url, sel, logger)
+		},
+		ChapterDetail: func(ctx context.Context, _ *browser.Pool, url string, logger *zap.Logger) (internal.ChapterDetailResult, error) {
+			return StaticChapterDetail(ctx, client, url, sel, logger)
+		},
+	}
+}
+
+func StaticSeriesList(ctx context.Context, client *fetch.Client, listURL string, sel internal.ProviderSelectors, logger *zap.Logger) ([]internal.SeriesListResult, error) {
+	doc, err := client.Document(ctx, listURL)
+	if err != nil {
+		return nil, err
+	}
+
+	container := doc.Find(sel.SeriesListContainer).First()
+	if container.Length() == 0 {
+		return nil, internal.NewErrorf(internal.ErrNotFound, "series list container %q not found", sel.SeriesListContainer)
+	}
+
+	var results []internal.SeriesListResult
+
+	container.Find(sel.SeriesListLink).Each(func(_ int, e *goquery.Selection) {
+		href, ok := e.Attr("href")
+		if !ok {
+			return
+		}
+
+		sourcePath := helper.GetPath(href)
+		if postID, ok := e.Attr("rel"); ok && postID != "" {
+			sourcePath = "/?p=" + postID
+		}
+
+		results = append(results, internal.SeriesListResult{
+			Title:      strings.TrimSpace(e.Text()),
+			Slug:       helper.GetSlug(href),
+			SourcePath: sourcePath,
+		})
+	})
+
+	if len(results) == 0 {
+		return nil, internal.NewErrorf(internal.ErrNotFound, "no series found with %q", sel.SeriesListLink)
+	}
+
+	logger.Debug("Scraped series list", zap.Int("count", len(results)))
+
+	return results, nil
+}
+
+func StaticSeriesDetail(ctx context.Context, client *fetch.Client, seriesURL string, sel internal.ProviderSelectors, logger *zap.Logger) (internal.SeriesDetailResult, error) {
+	doc, err := client.Document(ctx, seriesURL)
+	if err != nil {
+		return internal.SeriesDetailResult{}, err
+	}
+
+	elTH := doc.Find(sel.Thumbnail).First()
+
+	thumbnailURL := elTH.AttrOr("src", "")
+	if !strings.HasPrefix(thumbnailURL, "http") {
+		thumbnailURL = elTH.AttrOr(sel.ThumbnailFallbackAttr, "")
+	}
+
+	if thumbnailURL == "" {
+		return internal.SeriesDetailResult{}, internal.NewErrorf(internal.ErrNotFound, "thumbnail %q not found", sel.Thumbnail)
+	}
+
+	elSP := doc.Find(sel.Synopsis)
+	if elSP.Length() == 0 {
+		elSP = doc.Find(sel.SynopsisFallback)
+	}
+
+	var synopsisArr []string
+
+	elSP.Each(func(_ int, e *goquery.Selection) {
+		text := strings.TrimSpace(e.Text())
+		if text == "&nbsp;" || text == "\u00a0" || text == "" {
+			return
+		}
+
+		synopsisArr = append(synopsisArr, text)
+	})
+
+	synopsisRegex := regexp.MustCompile(`\n`)
+	synopsis := synopsisRegex.ReplaceAllString(strings.Join(helper.RemoveDuplicate(synopsisArr), "<br />"), "<br />")
+
+	var genreArr []string
+
+	doc.Find(sel.Genres).Each(func(_ int, e *goquery.Selection) {
+		genreArr = append(genreArr, strings.TrimSpace(e.Text()))
+	})
+
+	genres, err := json.Marshal(helper.RemoveDuplicate(genreArr))
+	if err != nil {
+		return internal.SeriesDetailResult{}, err
+	}
+
+	result := internal.SeriesDetailResult{
+		ThumbnailURL: thumbnailURL,
+		Synopsis:     synopsis,
+		Genres:       genres,
+	}
+
+	doc.Find(sel.SeriesInfo).Each(func(_ int, e *goquery.Selection) {
+		helper.SetSeriesInfo(&result, e.Text(), e.Find(sel.SeriesInfoValue).First().Text())
+	})
+
+	logger.Debug("Scraped series detail", zap.Any("result", result))
+
+	return result, nil
+}
+
+func StaticChapterList(ctx context.Context, client *fetch.Client, seriesURL string, sel internal.ProviderSelectors, logger *zap.Logger) ([]internal.ChapterListResult, error) {
+	doc, err := client.Document(ctx, seriesURL)
+	if err != nil {
+		return nil, err
+	}
+
+	container := doc.Find(sel.ChapterListContainer).First()
+	if container.Length() == 0 {
+		return nil, internal.NewErrorf(internal.ErrNotFound, "chapter list container %q not found", sel.ChapterListContainer)
+	}
+
+	var results []internal.ChapterListResult
+
+	container.Find(sel.ChapterListLink).Each(func(_ int, e *goquery.Selection) {
+		href, ok := e.Attr("href")
+		if !ok {
+			return
+		}
+
+		tT := e.Find(sel.ChapterTitle).First().Text()
+
+		chapterNumber := helper.GetChapterNumber(tT)
+		if dataNum, ok := e.Closest(sel.ChapterNumber).Attr(sel.ChapterNumberAttr); ok {
+			chapterNumber = helper.GetChapterNumber(dataNum)
+		}
+
+		results = append(results, internal.ChapterListResult{
+			ShortTitle: helper.GetChapterTitle(tT),
+			Slug:       helper.GetSlug(href),
+			Number:     chapterNumber,
+			Href:       href,
+		})
+	})
+
+	if len(results) == 0 {
+		return nil, internal.NewErrorf(internal.ErrNotFound, "no chapters found with %q", sel.ChapterListLink)
+	}
+
+	logger.Debug("Scraped chapter list", zap.Int("count", len(results)))
+
+	return results, nil
+}
+
+func StaticChapterDetail(ctx context.Context, client *fetch.Client, chapterURL string, sel internal.ProviderSelectors, logger *zap.Logger) (internal.ChapterDetailResult, error) {
+	doc, err := client.Document(ctx, chapterURL)
+	if err != nil {
+		return internal.ChapterDetailResult{}, err
+	}
+
+	fullTitle := strings.TrimSpace(doc.Find(sel.ChapterFullTitle).First().Text())
+	if fullTitle == "" {
+		return internal.ChapterDetailResult{}, internal.NewErrorf(internal.ErrNotFound, "chapter title %q not found", sel.ChapterFullTitle)
+	}
+
+	sourcePath, err := staticSourcePath(doc, sel)
+	if err != nil {
+		return internal.ChapterDetailResult{}, err
+	}
+
+	scriptRegex := regexp.MustCompile(`(?m)^\s*` + regexp.QuoteMeta(sel.ReaderScript) + `\((.*)\);`)
+
+	var script string
+
+	doc.Find("script").EachWithBreak(func(_ int, e *goquery.Selection) bool {
+		if match := scriptRegex.FindStringSubmatch(e.Text()); len(match) == 2 {
+			script = match[1]
+			return false
+		}
+
+		return true
+	})
+
+	// the reader is injected by javascript on some sites, the browser fallback handles those
+	if script == "" {
+		return internal.ChapterDetailResult{}, internal.NewErrorf(internal.ErrNotFound, "reader script %q not found", sel.ReaderScript)
+	}
+
+	var tsReader internal.TSReaderScript
+
+	if err := json.Unmarshal([]byte(script), &tsReader); err != nil {
+		return internal.ChapterDetailResult{}, internal.WrapErrorf(err, internal.ErrNotFound, "invalid reader script")
+	}
+
+	if len(tsReader.Sources) == 0 {
+		return internal.ChapterDetailResult{}, internal.NewErrorf(internal.ErrNotFound, "reader sources not found")
+	}
+
+	var contentPaths []string
+
+	for _, img := range tsReader.Sources[0].Images {
+		if img == "" {
+			continue
+		}
+
+		if imgPath := helper.GetPath(img); imgPath != "" {
+			contentPaths = append(contentPaths, imgPath)
+		}
+	}
+
+	contentPathsJSON, err := json.Marshal(helper.RemoveDuplicate(contentPaths))
+	if err != nil {
+		return internal.ChapterDetailResult{}, err
+	}
+
+	var nextPath, prevPath string
+
+	if tsReader.NextURL != "" {
+		nextPath, err = staticSourcePathOf(ctx, client, tsReader.NextURL, sel)
+		if err != nil {
+			return internal.ChapterDetailResult{}, err
+		}
+	}
+
+	if tsReader.PrevURL != "" {
+		prevPath, err = staticSourcePathOf(ctx, client, tsReader.PrevURL, sel)
+		if err != nil {
+			return internal.ChapterDetailResult{}, err
+		}
+	}
+
+	result := internal.ChapterDetailResult{
+		FullTitle:    fullTitle,
+		SourcePath:   sourcePath,
+		ContentPaths: contentPathsJSON,
+		NextPath:     nextPath,
+		NextSlug:     helper.GetSlug(tsReader.NextURL),
+		PrevPath:     prevPath,
+		PrevSlug:     helper.GetSlug(tsReader.PrevURL),
+	}
+
+	logger.Debug("Scraped chapter detail", zap.Any("result", result))
+
+	return result, nil
+}
+
+func staticSourcePathOf(ctx context.Context, client *fetch.Client, url string, sel internal.ProviderSelectors) (string, error) {
+	doc, err := client.Document(ctx, url)
+	if err != nil {
+		return "", err
+	}
+
+	return staticSourcePath(doc, sel)
+}
+
+func staticSourcePath(doc *goquery.Document, sel internal.ProviderSelectors) (string, error) {
+	href, ok := doc.Find(sel.Shortlink).First().Attr("href")
+	if !ok {
+		return "", internal.NewErrorf(internal.ErrNotFound, "shortlink %q not found", sel.Shortlink)
+	}
+
+	return "/?p=" + helper.GetPostID(href), nil
+}
@@ -0,0 +1,83 @@
+package themesia
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"fourleaves.studio/manga-scraper/internal"
+	"fourleaves.studio/manga-scraper/internal/scraper/browser"
+	"fourleaves.studio/manga-scraper/internal/scraper/registry"
+)
+
+// DefaultSelectors are the selectors used by stock MangaThemesia sites
+var DefaultSelectors = internal.ProviderSelectors{
+	SeriesListContainer:   "div.soralist",
+	SeriesListLink:        "a.series",
+	Thumbnail:             "div.thumb > img",
+	ThumbnailFallbackAttr: "data-src",
+	Synopsis:              "div.entry-content p",
+	SynopsisFallback:      `div.entry-content div[class^="contents"] div`,
+	Genres:                "span.mgen > a",
+	SeriesInfo:            "div.tsinfo div.imptdt",
+	SeriesInfoValue:       "i, a",
+	ChapterListContainer:  "div.eplister",
+	ChapterListLink:       "a",
+	ChapterTitle:          "span.chapternum",
+	ChapterNumber:         "li",
+	ChapterNumberAttr:     "data-num",
+	ChapterFullTitle:      "h1.entry-title",
+	Shortlink:             "link[rel='shortlink']",
+	ReaderScript:          "ts_reader.run",
+}
+
+// WithDefaults fills every empty selector with its MangaThemesia default
+func WithDefaults(sel internal.ProviderSelectors) internal.ProviderSelectors {
+	or := func(v, d string) string {
+		if v == "" {
+			return d
+		}
+
+		return v
+	}
+
+	return internal.ProviderSelectors{
+		SeriesListContainer:   or(sel.SeriesListContainer, DefaultSelectors.SeriesListContainer),
+		SeriesListLink:        or(sel.SeriesListLink, DefaultSelectors.SeriesListLink),
+		Thumbnail:             or(sel.Thumbnail, DefaultSelectors.Thumbnail),
+		ThumbnailFallbackAttr: or(sel.ThumbnailFallbackAttr, DefaultSelectors.ThumbnailFallbackAttr),
+		Synopsis:              or(sel.Synopsis, DefaultSelectors.Synopsis),
+		SynopsisFallback:      or(sel.SynopsisFallback, DefaultSelectors.SynopsisFallback),
+		Genres:                or(sel.Genres, DefaultSelectors.Genres),
+		SeriesInfo:            or(sel.SeriesInfo, DefaultSelectors.SeriesInfo),
+		SeriesInfoValue:       or(sel.SeriesInfoValue, DefaultSelectors.SeriesInfoValue),
+		ChapterListContainer:  or(sel.ChapterListContainer, DefaultSelectors.ChapterListContainer),
+		ChapterListLink:       or(sel.ChapterListLink, DefaultSelectors.ChapterListLink),
+		ChapterTitle:          or(sel.ChapterTitle, DefaultSelectors.ChapterTitle),
+		ChapterNumber:         or(sel.ChapterNumber, DefaultSelectors.ChapterNumber),
+		ChapterNumberAttr:     or(sel.ChapterNumberAttr, DefaultSelectors.ChapterNumberAttr),
+		ChapterFullTitle:      or(sel.ChapterFullTitle, DefaultSelectors.ChapterFullTitle),
+		Shortlink:             or(sel.Shortlink, DefaultSelectors.Shortlink),
+		ReaderScript:          or(sel.ReaderScript, DefaultSelectors.ReaderScript),
+	}
+}
+
+// New returns a provider scraper driven entirely by the given selectors
+func New(selectors internal.ProviderSelectors) registry.ProviderScraper {
+	sel := WithDefaults(selectors)
+
+	return registry.Funcs{
+		SeriesList: func(ctx context.Context, pool *browser.Pool, url string, logger *zap.Logger) ([]internal.SeriesListResult, error) {
+			return ScrapeSeriesList(ctx, pool, url, sel, logger)
+		},
+		SeriesDetail: func(ctx context.Context, pool *browser.Pool, url string, logger *zap.Logger) (internal.SeriesDetailResult, error) {
+			return ScrapeSeriesDetail(ctx, pool, url, sel, logger)
+		},
+		ChapterList: func(ctx context.Context, pool *browser.Pool, url string, logger *zap.Logger) ([]internal.ChapterListResult, error) {
+			return ScrapeChapterList(ctx, pool, url, sel, logger)
+		},
+		ChapterDetail: func(ctx context.Context, pool *browser.Pool, url string, logger *zap.Logger) (internal.ChapterDetailResult, error) {
+			return ScrapeChapterDetail(ctx, pool, url, sel, logger)
+		},
+	}
+}
@@ -0,0 +1,32 @@
+package themesia
+
+import (
+	"testing"
+
+	"fourleaves.studio/manga-scraper/internal"
+)
+
+func TestWithDefaults(t *testing.T) {
+	t.Parallel()
+
+	got := WithDefaults(internal.ProviderSelectors{
+		Synopsis:              "div.summary p",
+		ThumbnailFallbackAttr: "data-lazy-src",
+	})
+
+	if got.Synopsis != "div.summary p" {
+		t.Errorf("Expected custom synopsis selector but got %q", got.Synopsis)
+	}
+
+	if got.ThumbnailFallbackAttr != "data-lazy-src" {
+		t.Errorf("Expected custom thumbnail attribute but got %q", got.ThumbnailFallbackAttr)
+	}
+
+	if got.ChapterListContainer != DefaultSelectors.ChapterListContainer {
+		t.Errorf("Expected default chapter list selector but got %q", got.ChapterListContainer)
+	}
+
+	if WithDefaults(internal.ProviderSelectors{}) != DefaultSelectors {
+		t.Errorf("Expected empty selectors to resolve to the defaults")
+	}
+}
@@ -0,0 +1,175 @@
+package throttle
+
+import (
+	"context"
+	"math/rand"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"fourleaves.studio/manga-scraper/internal"
+)
+
+// Limiter enforces the rate limit of a single provider across every scrape of the worker
+// A nil Limiter does not limit anything
+type Limiter struct {
+	rate      *rate.Limiter
+	inFlight  chan struct{}
+	jitterMin time.Duration
+	jitterMax time.Duration
+}
+
+func New(cfg internal.ProviderRateLimit) *Limiter {
+	l := &Limiter{
+		jitterMin: time.Duration(cfg.JitterMinMs) * time.Millisecond,
+		jitterMax: time.Duration(cfg.JitterMaxMs) * time.Millisecond,
+	}
+
+	if cfg.RequestsPerMinute > 0 {
+		burst := cfg.Burst
+		if burst < 1 {
+			burst = 1
+		}
+
+		l.rate = rate.NewLimiter(rate.Limit(cfg.RequestsPerMinute/60), burst)
+	}
+
+	if cfg.MaxInFlight > 0 {
+		l.inFlight = make(chan struct{}, cfg.MaxInFlight)
+	}
+
+	return l
+}
+
+// Acquire blocks until a page of the provider may be requested
+// The returned release function frees the in-flight slot once the page is done
+func (l *Limiter) Acquire(ctx context.Context) (func(), error) {
+	if l == nil {
+		return func() {}, nil
+	}
+
+	if l.inFlight != nil {
+		select {
+		case l.inFlight <- struct{}{}:
+		case <-ctx.Done():
+			return nil, internal.WrapErrorf(ctx.Err(), internal.ErrUnknown, "throttle.inFlight")
+		}
+	}
+
+	var once sync.Once
+
+	release := func() {
+		once.Do(func() {
+			if l.inFlight != nil {
+				<-l.inFlight
+			}
+		})
+	}
+
+	if err := l.Wait(ctx); err != nil {
+		release()
+		return nil, err
+	}
+
+	return release, nil
+}
+
+// Wait blocks until the rate limit and jitter allow another request, without taking an in-flight slot
+// It paces the requests a scrape makes on a page it already holds, like following the next chapter
+func (l *Limiter) Wait(ctx context.Context) error {
+	if l == nil {
+		return nil
+	}
+
+	if l.rate != nil {
+		if err := l.rate.Wait(ctx); err != nil {
+			return internal.WrapErrorf(err, internal.ErrUnknown, "throttle.rate")
+		}
+	}
+
+	if delay := l.jitter(); delay > 0 {
+		timer := time.NewTimer(delay)
+		defer timer.Stop()
+
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			return internal.WrapErrorf(ctx.Err(), internal.ErrUnknown, "throttle.jitter")
+		}
+	}
+
+	return nil
+}
+
+func (l *Limiter) jitter() time.Duration {
+	if l.jitterMax <= 0 {
+		return 0
+	}
+
+	if l.jitterMax == l.jitterMin {
+		return l.jitterMin
+	}
+
+	return l.jitterMin + time.Duration(rand.Int63n(int64(l.jitterMax-l.jitterMin)))
+}
+
+// Registry keeps one limiter per provider and replaces it when the provider config changes
+type Registry struct {
+	mu       sync.Mutex
+	limiters map[string]entry
+}
+
+type entry struct {
+	cfg     internal.ProviderRateLimit
+	limiter *Limiter
+}
+
+func NewRegistry() *Registry {
+	return &Registry{
+		limiters: make(map[string]entry),
+	}
+}
+
+// Get returns the limiter of the provider, or nil when the provider has no rate limit
+func (r *Registry) Get(slug string, cfg *internal.ProviderRateLimit) *Limiter {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if cfg == nil {
+		delete(r.limiters, slug)
+		return nil
+	}
+
+	if e, ok := r.limiters[slug]; ok && e.cfg == *cfg {
+		return e.limiter
+	}
+
+	l := New(*cfg)
+	r.limiters[slug] = entry{cfg: *cfg, limiter: l}
+
+	return l
+}
+
+type contextKey struct{}
+
+// NewContext returns a context carrying the limiter, which is picked up by the browser pool and fetch client
+func NewContext(ctx context.Context, l *Limiter) context.Context {
+	if l == nil {
+		return ctx
+	}
+
+	return context.WithValue(ctx, contextKey{}, l)
+}
+
+// Acquire waits on the limiter carried by ctx, if any
+func Acquire(ctx context.Context) (func(), error) {
+	l, _ := ctx.Value(contextKey{}).(*Limiter)
+	return l.Acquire(ctx)
+}
+
+// Wait paces a request on the limiter carried by ctx, if any
+func Wait(ctx context.Context) error {
+	l, _ := ctx.Value(contextKey{}).(*Limiter)
+	return l.Wait(ctx)
+}
@@ -0,0 +1,112 @@
+package throttle
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"fourleaves.studio/manga-scraper/internal"
+)
+
+func TestAcquire_NoLimiter(t *testing.T) {
+	release, err := Acquire(context.Background())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	release()
+}
+
+func TestLimiter_MaxInFlight(t *testing.T) {
+	l := New(internal.ProviderRateLimit{MaxInFlight: 1})
+	ctx := NewContext(context.Background(), l)
+
+	release, err := Acquire(ctx)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+	defer cancel()
+
+	if _, err := Acquire(timeoutCtx); err == nil {
+		t.Fatal("expected the second page to wait for a free slot")
+	}
+
+	release()
+	release()
+
+	release, err = Acquire(ctx)
+	if err != nil {
+		t.Fatalf("expected the slot to be free after release, got %v", err)
+	}
+
+	release()
+}
+
+func TestLimiter_Rate(t *testing.T) {
+	l := New(internal.ProviderRateLimit{RequestsPerMinute: 60, Burst: 1})
+
+	release, err := l.Acquire(context.Background())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	release()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	if _, err := l.Acquire(ctx); err == nil {
+		t.Fatal("expected the second request to exceed the rate limit")
+	}
+}
+
+func TestLimiter_Wait(t *testing.T) {
+	l := New(internal.ProviderRateLimit{RequestsPerMinute: 60, Burst: 1, MaxInFlight: 1})
+	ctx := NewContext(context.Background(), l)
+
+	release, err := Acquire(ctx)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	defer release()
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+	defer cancel()
+
+	// the page already holds the in-flight slot, so only the rate limit applies
+	if err := Wait(timeoutCtx); err == nil {
+		t.Fatal("expected the navigation to exceed the rate limit")
+	}
+}
+
+func TestLimiter_Jitter(t *testing.T) {
+	l := New(internal.ProviderRateLimit{JitterMinMs: 10, JitterMaxMs: 30})
+
+	for i := 0; i < 100; i++ {
+		if d := l.jitter(); d < 10*time.Millisecond || d >= 30*time.Millisecond {
+			t.Fatalf("expected jitter within [10ms, 30ms), got %v", d)
+		}
+	}
+}
+
+func TestRegistry_Get(t *testing.T) {
+	r := NewRegistry()
+
+	if l := r.Get("asura", nil); l != nil {
+		t.Errorf("expected no limiter without config, got %v", l)
+	}
+
+	cfg := &internal.ProviderRateLimit{RequestsPerMinute: 30}
+
+	first := r.Get("asura", cfg)
+	if first == nil || r.Get("asura", cfg) != first {
+		t.Error("expected the limiter to be reused while the config is unchanged")
+	}
+
+	if r.Get("asura", &internal.ProviderRateLimit{RequestsPerMinute: 10}) == first {
+		t.Error("expected a new limiter after the config changed")
+	}
+}
@@ -0,0 +1,39 @@
+package scraper
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"fourleaves.studio/manga-scraper/internal"
+	"fourleaves.studio/manga-scraper/internal/scraper/browser"
+	"fourleaves.studio/manga-scraper/internal/scraper/registry"
+	"fourleaves.studio/manga-scraper/internal/scraper/throttle"
+)
+
+// throttledScraper attaches the provider limiter to every scrape, so each page opened by the browser pool
+// or downloaded by the fetch client waits on the provider rate limit
+type throttledScraper struct {
+	scraper registry.ProviderScraper
+	limiter *throttle.Limiter
+}
+
+func (t throttledScraper) ScrapeSeriesList(ctx context.Context, pool *browser.Pool, url string, logger *zap.Logger) ([]internal.SeriesListResult, error) {
+	return t.scraper.ScrapeSeriesList(throttle.NewContext(ctx, t.limiter), pool, url, logger)
+}
+
+func (t throttledScraper) ScrapeSeriesDetail(ctx context.Context, pool *browser.Pool, url string, logger *zap.Logger) (internal.SeriesDetailResult, error) {
+	return t.scraper.ScrapeSeriesDetail(throttle.NewContext(ctx, t.limiter), pool, url, logger)
+}
+
+func (t throttledScraper) ScrapeChapterList(ctx context.Context, pool *browser.Pool, url string, logger *zap.Logger) ([]internal.ChapterListResult, error) {
+	return t.scraper.ScrapeChapterList(throttle.NewContext(ctx, t.limiter), pool, url, logger)
+}
+
+func (t throttledScraper) ScrapeChapterDetail(ctx context.Context, pool *browser.Pool, url string, logger *zap.Logger) (internal.ChapterDetailResult, error) {
+	return t.scraper.ScrapeChapterDetail(throttle.NewContext(ctx, t.limiter), pool, url, logger)
+}
+
+func (t throttledScraper) Supports(requestType internal.ScrapeRequestType) bool {
+	return t.scraper.Supports(requestType)
+}
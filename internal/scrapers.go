@@ -1,5 +1,7 @@
 package internal
 
+import "time"
+
 type ScrapeRequest struct {
 	ID          string              `json:"id"`
 	Type        ScrapeRequestType   `json:"type"`
@@ -13,6 +15,9 @@ type ScrapeRequest struct {
 	TotalTime   float64             `json:"totalTime,omitempty"`
 	Error       bool                `json:"error,omitempty"`
 	Message     string              `json:"message,omitempty"`
+	// Unreleased lists the new chapters of a chapter list scrape whose release could not be published,
+	// the retry of the request publishes them again
+	Unreleased []string `json:"unreleased,omitempty"`
 }
 
 type CreateScrapeRequestParams struct {
@@ -28,17 +33,53 @@ type CreateScrapeRequestParams struct {
 type UpdateScrapeRequestParams struct {
 	ID        string
 	Status    ScrapeRequestStatus
+	Retries   int
 	TotalTime float64
 	Error     bool
 	Message   string
 }
 
 type FindScrapeRequestParams struct {
-	Status ScrapeRequestStatus
-	Order  SortOrder
-	Page   int
-	Size   int
-	Cursor string
+	Type          ScrapeRequestType
+	Provider      string
+	Series        string
+	Status        ScrapeRequestStatus
+	Error         *bool
+	Message       string
+	CreatedAfter  time.Time
+	CreatedBefore time.Time
+	Order         SortOrder
+	Page          int
+	Size          int
+	Cursor        string
+}
+
+// ScrapeRequestPage is a page of scrape requests with the number of requests matching the filters
+type ScrapeRequestPage struct {
+	Requests []ScrapeRequest
+	Total    int
+}
+
+// RetryScrapeRequestParams selects failed requests to publish again
+type RetryScrapeRequestParams struct {
+	Type          ScrapeRequestType
+	Provider      string
+	Status        ScrapeRequestStatus
+	Message       string
+	CreatedAfter  time.Time
+	CreatedBefore time.Time
+	Limit         int
+}
+
+type RetryScrapeRequestResult struct {
+	Matched  int      `json:"matched"`
+	Requeued []string `json:"requeued"`
+	Skipped  int      `json:"skipped"`
+}
+
+type DeleteScrapeRequestParams struct {
+	Status        ScrapeRequestStatus
+	CreatedBefore time.Time
 }
 
 type SeriesListResult struct {
@@ -48,9 +89,14 @@ type SeriesListResult struct {
 }
 
 type SeriesDetailResult struct {
-	ThumbnailURL string `json:"thumbnailURL"`
-	Synopsis     string `json:"synopsis"`
-	Genres       []byte `json:"genres"`
+	ThumbnailURL string       `json:"thumbnailURL"`
+	Synopsis     string       `json:"synopsis"`
+	Genres       []byte       `json:"genres"`
+	Status       SeriesStatus `json:"status"`
+	Type         string       `json:"type"`
+	Author       string       `json:"author"`
+	Artist       string       `json:"artist"`
+	ReleaseYear  int          `json:"releaseYear"`
 }
 
 type ChapterListResult struct {
@@ -81,11 +127,16 @@ type TSReaderScript struct {
 type ScrapeRequestStatus string
 
 const (
-	PendingRequestStatus   ScrapeRequestStatus = "PENDING"
-	CompletedRequestStatus ScrapeRequestStatus = "COMPLETED"
-	FailedRequestStatus    ScrapeRequestStatus = "FAILED"
+	PendingRequestStatus    ScrapeRequestStatus = "PENDING"
+	CompletedRequestStatus  ScrapeRequestStatus = "COMPLETED"
+	FailedRequestStatus     ScrapeRequestStatus = "FAILED"
+	DeadLetterRequestStatus ScrapeRequestStatus = "DEAD_LETTER"
+	CancelledRequestStatus  ScrapeRequestStatus = "CANCELLED"
 )
 
+// MaxRetryScrapeRequests bounds how many requests a single bulk retry publishes
+const MaxRetryScrapeRequests = 1000
+
 type ScrapeRequestType string
 
 const (
@@ -148,6 +199,51 @@ func (s *UpdateScrapeRequestParams) Validate() error {
 	return nil
 }
 
+func (s *FindScrapeRequestParams) Validate() error {
+	if s.Page < 1 {
+		return NewErrorf(ErrInvalidInput, "page must be greater than 0")
+	}
+
+	if s.Size < 1 {
+		return NewErrorf(ErrInvalidInput, "size must be greater than 0")
+	}
+
+	if !s.CreatedAfter.IsZero() && !s.CreatedBefore.IsZero() && s.CreatedBefore.Before(s.CreatedAfter) {
+		return NewErrorf(ErrInvalidInput, "createdBefore must not be earlier than createdAfter")
+	}
+
+	return nil
+}
+
+func (s *RetryScrapeRequestParams) Validate() error {
+	if s.Status != FailedRequestStatus && s.Status != DeadLetterRequestStatus {
+		return NewErrorf(ErrInvalidInput, "only failed or dead-lettered requests can be retried")
+	}
+
+	if s.Limit < 1 || s.Limit > MaxRetryScrapeRequests {
+		return NewErrorf(ErrInvalidInput, "limit must be between 1 and %d", MaxRetryScrapeRequests)
+	}
+
+	if !s.CreatedAfter.IsZero() && !s.CreatedBefore.IsZero() && s.CreatedBefore.Before(s.CreatedAfter) {
+		return NewErrorf(ErrInvalidInput, "createdBefore must not be earlier than createdAfter")
+	}
+
+	return nil
+}
+
+func (s *DeleteScrapeRequestParams) Validate() error {
+	if s.CreatedBefore.IsZero() {
+		return NewErrorf(ErrInvalidInput, "createdBefore is required")
+	}
+
+	// the worker still expects pending requests to exist, they have to be cancelled first
+	if s.Status == PendingRequestStatus {
+		return NewErrorf(ErrInvalidInput, "pending requests can not be deleted")
+	}
+
+	return nil
+}
+
 func CreateValidScrapeRequestParams() *CreateScrapeRequestParams {
 	return &CreateScrapeRequestParams{
 		Type:        SeriesListRequestType,
@@ -1,6 +1,9 @@
 package internal
 
-import "testing"
+import (
+	"testing"
+	"time"
+)
 
 func TestCreateScrapeRequestParams_Validate(t *testing.T) {
 	tests := []struct {
@@ -55,3 +58,85 @@ func TestUpdateScrapeRequestParams_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestFindScrapeRequestParams_Validate(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name    string
+		params  FindScrapeRequestParams
+		wantErr bool
+	}{
+		{"Valid input", FindScrapeRequestParams{Page: 1, Size: 10}, false},
+		{"Valid range", FindScrapeRequestParams{Page: 1, Size: 10, CreatedAfter: now.Add(-time.Hour), CreatedBefore: now}, false},
+		{"Zero page", FindScrapeRequestParams{Page: 0, Size: 10}, true},
+		{"Zero size", FindScrapeRequestParams{Page: 1, Size: 0}, true},
+		{"Inverted range", FindScrapeRequestParams{Page: 1, Size: 10, CreatedAfter: now, CreatedBefore: now.Add(-time.Hour)}, true},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Create a local variable and assign the value of tc to it.
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tt.params.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestDeleteScrapeRequestParams_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  DeleteScrapeRequestParams
+		wantErr bool
+	}{
+		{"Valid input", DeleteScrapeRequestParams{CreatedBefore: time.Now()}, false},
+		{"Valid status", DeleteScrapeRequestParams{Status: "COMPLETED", CreatedBefore: time.Now()}, false},
+		{"Missing createdBefore", DeleteScrapeRequestParams{Status: "COMPLETED"}, true},
+		{"Pending status", DeleteScrapeRequestParams{Status: "PENDING", CreatedBefore: time.Now()}, true},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Create a local variable and assign the value of tc to it.
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tt.params.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRetryScrapeRequestParams_Validate(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name    string
+		params  RetryScrapeRequestParams
+		wantErr bool
+	}{
+		{"Valid input", RetryScrapeRequestParams{Status: "FAILED", Limit: 100}, false},
+		{"Valid dead letter", RetryScrapeRequestParams{Status: "DEAD_LETTER", Provider: "asura", Limit: 1}, false},
+		{"Completed status", RetryScrapeRequestParams{Status: "COMPLETED", Limit: 100}, true},
+		{"Zero limit", RetryScrapeRequestParams{Status: "FAILED"}, true},
+		{"Limit too high", RetryScrapeRequestParams{Status: "FAILED", Limit: MaxRetryScrapeRequests + 1}, true},
+		{"Inverted range", RetryScrapeRequestParams{Status: "FAILED", Limit: 100, CreatedAfter: now, CreatedBefore: now.Add(-time.Hour)}, true},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Create a local variable and assign the value of tc to it.
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tt.params.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -1,16 +1,43 @@
 package internal
 
+import (
+	"strings"
+	"time"
+)
+
 type Series struct {
-	Provider      string   `json:"provider"`
-	Slug          string   `json:"slug"`
-	Title         string   `json:"title"`
-	SourceURL     string   `json:"sourceURL"`
-	CoverURL      string   `json:"coverURL"`
-	Synopsis      string   `json:"synopsis"`
-	Genres        []string `json:"genres"`
-	ChaptersCount int      `json:"chaptersCount"`
-	LatestChapter string   `json:"latestChapter"`
+	Provider      string       `json:"provider"`
+	Slug          string       `json:"slug"`
+	Title         string       `json:"title"`
+	SourceURL     string       `json:"sourceURL"`
+	CoverURL      string       `json:"coverURL"`
+	Synopsis      string       `json:"synopsis"`
+	Genres        []string     `json:"genres"`
+	Status        SeriesStatus `json:"status"`
+	Type          string       `json:"type"`
+	Author        string       `json:"author"`
+	Artist        string       `json:"artist"`
+	ReleaseYear   int          `json:"releaseYear"`
+	ChaptersCount int          `json:"chaptersCount"`
+	LatestChapter string       `json:"latestChapter"`
+	// LatestChapterAt is when the latest chapter was first seen, nil until the series has chapters
+	LatestChapterAt *time.Time `json:"latestChapterAt,omitempty"`
+	// WorkID and Sources are set when the series is linked to a work, Sources lists the other providers
+	WorkID  string         `json:"workId,omitempty"`
+	Sources []SeriesSource `json:"sources,omitempty"`
+	// UpdatedAt is when the series row was last written
+	UpdatedAt time.Time `json:"updatedAt"`
 }
+
+type SeriesStatus string
+
+const (
+	OngoingSeriesStatus   SeriesStatus = "ONGOING"
+	CompletedSeriesStatus SeriesStatus = "COMPLETED"
+	HiatusSeriesStatus    SeriesStatus = "HIATUS"
+	DroppedSeriesStatus   SeriesStatus = "DROPPED"
+)
+
 type SeriesBC struct {
 	Provider Breadcrumb `json:"provider"`
 	Series   Breadcrumb `json:"series"`
@@ -32,6 +59,83 @@ type FindSeriesParams struct {
 	Cursor   string
 }
 
+// SeriesSort is the field a series list is sorted by, ties are broken by provider and slug
+type SeriesSort string
+
+const (
+	SlugSeriesSort          SeriesSort = "slug"
+	TitleSeriesSort         SeriesSort = "title"
+	ChaptersSeriesSort      SeriesSort = "chapters"
+	UpdatedSeriesSort       SeriesSort = "updated"
+	LatestChapterSeriesSort SeriesSort = "latest"
+)
+
+// GenresMatch tells whether a series needs any or all of the requested genres
+type GenresMatch string
+
+const (
+	AnyGenresMatch GenresMatch = "any"
+	AllGenresMatch GenresMatch = "all"
+)
+
+// BrowseSeriesParams filters and sorts the series of a provider, or of every provider when Provider is empty
+type BrowseSeriesParams struct {
+	Provider     string
+	Genres       []string
+	GenresMatch  GenresMatch
+	Status       SeriesStatus
+	MinChapters  int
+	UpdatedSince time.Time
+	Sort         SeriesSort
+	Order        SortOrder
+	Cursor       string
+	Size         int
+}
+
+// SearchSeriesParams searches the series of every provider, Provider, Genres and Status narrow the hits down
+type SearchSeriesParams struct {
+	Query    string
+	Provider string
+	Genres   []string
+	Status   SeriesStatus
+	From     int
+	Size     int
+}
+
+// MaxSearchWindow is how deep search results can be paged, the default max_result_window of the indices
+const MaxSearchWindow = 10000
+
+// SeriesSearchResult is a page of search hits with the total hits of every page,
+// DidYouMean is a spelling correction of the query when nothing matched
+type SeriesSearchResult struct {
+	Hits       []SeriesSearchHit `json:"hits"`
+	Total      int               `json:"total"`
+	DidYouMean string            `json:"didYouMean,omitempty"`
+}
+
+// SeriesSearchHit is a matching series, Highlights holds the matched snippets by field
+type SeriesSearchHit struct {
+	Series
+	Highlights map[string][]string `json:"highlights,omitempty"`
+}
+
+// SuggestSeriesParams completes a partly typed title, Size is the most suggestions returned
+type SuggestSeriesParams struct {
+	Query string
+	Size  int
+}
+
+// MaxSuggestions caps SuggestSeriesParams.Size, suggestions are meant for a dropdown
+const MaxSuggestions = 20
+
+// SeriesSuggestion is a title completion, pointing at the first series found with that title
+type SeriesSuggestion struct {
+	Provider string `json:"provider"`
+	Slug     string `json:"slug"`
+	Title    string `json:"title"`
+	CoverURL string `json:"coverURL"`
+}
+
 const (
 	ASC  SortOrder = "asc"
 	DESC SortOrder = "desc"
@@ -45,6 +149,12 @@ type UpdateInitSeriesParams struct {
 	ThumbnailURL string
 	Synopsis     string
 	Genres       []byte
+	// the fields below are left untouched when empty, not every provider shows them
+	Status      SeriesStatus
+	Type        string
+	Author      string
+	Artist      string
+	ReleaseYear int
 }
 
 type UpdateLatestSeriesParams struct {
@@ -65,6 +175,84 @@ func NewSortOrder(s string) SortOrder {
 	}
 }
 
+// IsDefault reports whether the params only page a single provider by slug, the list served by FindPaginated
+func (p *BrowseSeriesParams) IsDefault() bool {
+	return p.Provider != "" &&
+		len(p.Genres) == 0 &&
+		p.Status == "" &&
+		p.MinChapters == 0 &&
+		p.UpdatedSince.IsZero() &&
+		(p.Sort == "" || p.Sort == SlugSeriesSort)
+}
+
+func (p *BrowseSeriesParams) Validate() error {
+	if p.Size < 1 {
+		return NewErrorf(ErrInvalidInput, "size must be greater than 0")
+	}
+
+	if p.MinChapters < 0 {
+		return NewErrorf(ErrInvalidInput, "min chapters can not be negative")
+	}
+
+	switch p.Sort {
+	case "", SlugSeriesSort, TitleSeriesSort, ChaptersSeriesSort, UpdatedSeriesSort, LatestChapterSeriesSort:
+	default:
+		return NewErrorf(ErrInvalidInput, "invalid sort %s", p.Sort)
+	}
+
+	switch p.GenresMatch {
+	case "", AnyGenresMatch, AllGenresMatch:
+	default:
+		return NewErrorf(ErrInvalidInput, "invalid genres match %s", p.GenresMatch)
+	}
+
+	switch p.Status {
+	case "", OngoingSeriesStatus, CompletedSeriesStatus, HiatusSeriesStatus, DroppedSeriesStatus:
+	default:
+		return NewErrorf(ErrInvalidInput, "invalid status %s", p.Status)
+	}
+
+	return nil
+}
+
+func (p *SearchSeriesParams) Validate() error {
+	if strings.TrimSpace(p.Query) == "" {
+		return NewErrorf(ErrInvalidInput, "query is required")
+	}
+
+	if p.From < 0 {
+		return NewErrorf(ErrInvalidInput, "from can not be negative")
+	}
+
+	if p.Size < 1 {
+		return NewErrorf(ErrInvalidInput, "size must be greater than 0")
+	}
+
+	if p.From+p.Size > MaxSearchWindow {
+		return NewErrorf(ErrInvalidInput, "from and size can not exceed %d", MaxSearchWindow)
+	}
+
+	switch p.Status {
+	case "", OngoingSeriesStatus, CompletedSeriesStatus, HiatusSeriesStatus, DroppedSeriesStatus:
+	default:
+		return NewErrorf(ErrInvalidInput, "invalid status %s", p.Status)
+	}
+
+	return nil
+}
+
+func (p *SuggestSeriesParams) Validate() error {
+	if strings.TrimSpace(p.Query) == "" {
+		return NewErrorf(ErrInvalidInput, "query is required")
+	}
+
+	if p.Size < 1 || p.Size > MaxSuggestions {
+		return NewErrorf(ErrInvalidInput, "size must be between 1 and %d", MaxSuggestions)
+	}
+
+	return nil
+}
+
 func (s *CreateInitSeriesParams) Validate() error {
 	if s.Provider == "" {
 		return NewErrorf(ErrInvalidInput, "provider is required")
@@ -98,6 +286,16 @@ func (s *UpdateInitSeriesParams) Validate() error {
 		return NewErrorf(ErrInvalidInput, "thumbnail URL is required")
 	}
 
+	switch s.Status {
+	case "", OngoingSeriesStatus, CompletedSeriesStatus, HiatusSeriesStatus, DroppedSeriesStatus:
+	default:
+		return NewErrorf(ErrInvalidInput, "invalid status %s", s.Status)
+	}
+
+	if s.ReleaseYear < 0 {
+		return NewErrorf(ErrInvalidInput, "release year must not be negative")
+	}
+
 	return nil
 }
 
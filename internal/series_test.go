@@ -1,6 +1,9 @@
 package internal
 
-import "testing"
+import (
+	"testing"
+	"time"
+)
 
 func TestNewSortOrder(t *testing.T) {
 	cases := []struct {
@@ -61,10 +64,13 @@ func TestUpdateInitSeriesParams_Validate(t *testing.T) {
 		params  UpdateInitSeriesParams
 		wantErr bool
 	}{
-		{"Valid params", UpdateInitSeriesParams{"provider", "slug", "thumbnailURL", "synopsis", []byte("genres")}, false},
-		{"Empty provider", UpdateInitSeriesParams{"", "slug", "thumbnailURL", "synopsis", []byte("genres")}, true},
-		{"Empty slug", UpdateInitSeriesParams{"provider", "", "thumbnailURL", "synopsis", []byte("genres")}, true},
-		{"Empty thumbnailURL", UpdateInitSeriesParams{"provider", "slug", "", "synopsis", []byte("genres")}, true},
+		{"Valid params", UpdateInitSeriesParams{Provider: "provider", Slug: "slug", ThumbnailURL: "thumbnailURL", Synopsis: "synopsis", Genres: []byte("genres")}, false},
+		{"Empty provider", UpdateInitSeriesParams{Provider: "", Slug: "slug", ThumbnailURL: "thumbnailURL", Synopsis: "synopsis", Genres: []byte("genres")}, true},
+		{"Empty slug", UpdateInitSeriesParams{Provider: "provider", Slug: "", ThumbnailURL: "thumbnailURL", Synopsis: "synopsis", Genres: []byte("genres")}, true},
+		{"Empty thumbnailURL", UpdateInitSeriesParams{Provider: "provider", Slug: "slug", ThumbnailURL: "", Synopsis: "synopsis", Genres: []byte("genres")}, true},
+		{"With info", UpdateInitSeriesParams{Provider: "provider", Slug: "slug", ThumbnailURL: "thumbnailURL", Status: CompletedSeriesStatus, Type: "Manhwa", ReleaseYear: 2021}, false},
+		{"Invalid status", UpdateInitSeriesParams{Provider: "provider", Slug: "slug", ThumbnailURL: "thumbnailURL", Status: "FINISHED"}, true},
+		{"Negative release year", UpdateInitSeriesParams{Provider: "provider", Slug: "slug", ThumbnailURL: "thumbnailURL", ReleaseYear: -1}, true},
 	}
 
 	for _, tc := range cases {
@@ -110,3 +116,110 @@ func TestUpdateLatestSeriesParams_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestBrowseSeriesParams_Validate(t *testing.T) {
+	cases := []struct {
+		name    string
+		params  BrowseSeriesParams
+		wantErr bool
+	}{
+		{"Valid params", BrowseSeriesParams{Size: 10}, false},
+		{"Valid filters", BrowseSeriesParams{Genres: []string{"action"}, GenresMatch: AllGenresMatch, Status: OngoingSeriesStatus, Sort: LatestChapterSeriesSort, Size: 10}, false},
+		{"Empty size", BrowseSeriesParams{}, true},
+		{"Negative min chapters", BrowseSeriesParams{MinChapters: -1, Size: 10}, true},
+		{"Invalid sort", BrowseSeriesParams{Sort: "views", Size: 10}, true},
+		{"Invalid genres match", BrowseSeriesParams{GenresMatch: "none", Size: 10}, true},
+		{"Invalid status", BrowseSeriesParams{Status: "ENDED", Size: 10}, true},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tc.params.Validate()
+			if (err != nil) != tc.wantErr {
+				t.Errorf("Expected error: %v, got: %v", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestSearchSeriesParams_Validate(t *testing.T) {
+	cases := []struct {
+		name    string
+		params  SearchSeriesParams
+		wantErr bool
+	}{
+		{"Valid params", SearchSeriesParams{Query: "solo lev", Size: 10}, false},
+		{"Valid filters", SearchSeriesParams{Query: "solo lev", Provider: "asura", Genres: []string{"action"}, Status: OngoingSeriesStatus, From: 20, Size: 10}, false},
+		{"Empty query", SearchSeriesParams{Query: " ", Size: 10}, true},
+		{"Negative from", SearchSeriesParams{Query: "solo", From: -1, Size: 10}, true},
+		{"Empty size", SearchSeriesParams{Query: "solo"}, true},
+		{"Past the search window", SearchSeriesParams{Query: "solo", From: MaxSearchWindow, Size: 10}, true},
+		{"Invalid status", SearchSeriesParams{Query: "solo", Status: "ENDED", Size: 10}, true},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tc.params.Validate()
+			if (err != nil) != tc.wantErr {
+				t.Errorf("Expected error: %v, got: %v", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestSuggestSeriesParams_Validate(t *testing.T) {
+	cases := []struct {
+		name    string
+		params  SuggestSeriesParams
+		wantErr bool
+	}{
+		{"Valid params", SuggestSeriesParams{Query: "solo", Size: 5}, false},
+		{"Empty query", SuggestSeriesParams{Size: 5}, true},
+		{"Empty size", SuggestSeriesParams{Query: "solo"}, true},
+		{"Too many suggestions", SuggestSeriesParams{Query: "solo", Size: MaxSuggestions + 1}, true},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tc.params.Validate()
+			if (err != nil) != tc.wantErr {
+				t.Errorf("Expected error: %v, got: %v", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestBrowseSeriesParams_IsDefault(t *testing.T) {
+	cases := []struct {
+		name   string
+		params BrowseSeriesParams
+		want   bool
+	}{
+		{"Provider by slug", BrowseSeriesParams{Provider: "asura", Sort: SlugSeriesSort}, true},
+		{"Provider without sort", BrowseSeriesParams{Provider: "asura"}, true},
+		{"Every provider", BrowseSeriesParams{}, false},
+		{"Sorted by title", BrowseSeriesParams{Provider: "asura", Sort: TitleSeriesSort}, false},
+		{"Filtered by genre", BrowseSeriesParams{Provider: "asura", Genres: []string{"action"}}, false},
+		{"Filtered by update time", BrowseSeriesParams{Provider: "asura", UpdatedSince: time.Now()}, false},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tc.params.IsDefault(); got != tc.want {
+				t.Errorf("Expected %v but got %v", tc.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,211 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+
+	"fourleaves.studio/manga-scraper/internal"
+)
+
+type APIKeyRepository interface {
+	Create(ctx context.Context, params internal.APIKeyParams) (internal.APIKey, error)
+	Find(ctx context.Context, id string) (internal.APIKey, error)
+	FindByHash(ctx context.Context, hash string) (internal.APIKey, error)
+	FindAll(ctx context.Context) ([]internal.APIKey, error)
+	Update(ctx context.Context, params internal.APIKeyParams) (internal.APIKey, error)
+	Rotate(ctx context.Context, params internal.APIKeyParams) (internal.APIKey, error)
+	Revoke(ctx context.Context, id string) (internal.APIKey, error)
+}
+
+type APIKeyUsageRepository interface {
+	Record(ctx context.Context, id string) (internal.APIKeyUsage, error)
+	Find(ctx context.Context, id string) (internal.APIKeyUsage, error)
+}
+
+type APIKeyService struct {
+	repo  APIKeyRepository
+	usage APIKeyUsageRepository
+}
+
+func NewAPIKeyService(repo APIKeyRepository, usage APIKeyUsageRepository) *APIKeyService {
+	return &APIKeyService{
+		repo:  repo,
+		usage: usage,
+	}
+}
+
+const (
+	// apiKeyPrefix marks the keys of this API, so leaked keys are easy to find in code and logs
+	apiKeyPrefix = "msk_"
+	// apiKeyPrefixLength is how much of a key is stored in the clear to tell keys apart
+	apiKeyPrefixLength = len(apiKeyPrefix) + 8
+)
+
+// Create stores a new key and returns it with the key itself, the only time the key is shown
+func (s *APIKeyService) Create(ctx context.Context, params internal.APIKeyParams) (internal.APIKey, error) {
+	defer newSentrySpan(ctx, "APIKeyService.Create").Finish()
+
+	if err := params.Validate(); err != nil {
+		return internal.APIKey{}, internal.WrapErrorf(err, internal.ErrInvalidInput, "params.Validate")
+	}
+
+	secret, err := newAPIKey()
+	if err != nil {
+		return internal.APIKey{}, internal.WrapErrorf(err, internal.ErrUnknown, "newAPIKey")
+	}
+
+	params.Prefix = secret[:apiKeyPrefixLength]
+	params.Hash = hashAPIKey(secret)
+
+	key, err := s.repo.Create(ctx, params)
+	if err != nil {
+		return internal.APIKey{}, internal.WrapErrorf(err, internal.ErrUnknown, "repo.Create")
+	}
+
+	key.Key = secret
+
+	return key, nil
+}
+
+func (s *APIKeyService) Find(ctx context.Context, id string) (internal.APIKey, error) {
+	defer newSentrySpan(ctx, "APIKeyService.Find").Finish()
+
+	key, err := s.repo.Find(ctx, id)
+	if err != nil {
+		return internal.APIKey{}, internal.WrapErrorf(err, internal.ErrUnknown, "repo.Find")
+	}
+
+	return key, nil
+}
+
+func (s *APIKeyService) FindAll(ctx context.Context) ([]internal.APIKey, error) {
+	defer newSentrySpan(ctx, "APIKeyService.FindAll").Finish()
+
+	keys, err := s.repo.FindAll(ctx)
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrUnknown, "repo.FindAll")
+	}
+
+	return keys, nil
+}
+
+// Update replaces the name, scopes and limits of the key, the key itself is kept
+func (s *APIKeyService) Update(ctx context.Context, params internal.APIKeyParams) (internal.APIKey, error) {
+	defer newSentrySpan(ctx, "APIKeyService.Update").Finish()
+
+	if err := params.Validate(); err != nil {
+		return internal.APIKey{}, internal.WrapErrorf(err, internal.ErrInvalidInput, "params.Validate")
+	}
+
+	key, err := s.repo.Update(ctx, params)
+	if err != nil {
+		return internal.APIKey{}, internal.WrapErrorf(err, internal.ErrUnknown, "repo.Update")
+	}
+
+	return key, nil
+}
+
+// Rotate replaces the key and returns the new one, a revoked key cannot be rotated
+func (s *APIKeyService) Rotate(ctx context.Context, id string) (internal.APIKey, error) {
+	defer newSentrySpan(ctx, "APIKeyService.Rotate").Finish()
+
+	key, err := s.repo.Find(ctx, id)
+	if err != nil {
+		return internal.APIKey{}, internal.WrapErrorf(err, internal.ErrUnknown, "repo.Find")
+	}
+
+	if key.RevokedAt != nil {
+		return internal.APIKey{}, internal.NewErrorf(internal.ErrInvalidInput, "api key is revoked")
+	}
+
+	secret, err := newAPIKey()
+	if err != nil {
+		return internal.APIKey{}, internal.WrapErrorf(err, internal.ErrUnknown, "newAPIKey")
+	}
+
+	key, err = s.repo.Rotate(ctx, internal.APIKeyParams{
+		ID:     id,
+		Prefix: secret[:apiKeyPrefixLength],
+		Hash:   hashAPIKey(secret),
+	})
+	if err != nil {
+		return internal.APIKey{}, internal.WrapErrorf(err, internal.ErrUnknown, "repo.Rotate")
+	}
+
+	key.Key = secret
+
+	return key, nil
+}
+
+func (s *APIKeyService) Revoke(ctx context.Context, id string) (internal.APIKey, error) {
+	defer newSentrySpan(ctx, "APIKeyService.Revoke").Finish()
+
+	key, err := s.repo.Revoke(ctx, id)
+	if err != nil {
+		return internal.APIKey{}, internal.WrapErrorf(err, internal.ErrUnknown, "repo.Revoke")
+	}
+
+	return key, nil
+}
+
+// Authenticate returns the active key matching the key a client sent, unknown and revoked keys are not found
+func (s *APIKeyService) Authenticate(ctx context.Context, secret string) (internal.APIKey, error) {
+	defer newSentrySpan(ctx, "APIKeyService.Authenticate").Finish()
+
+	key, err := s.repo.FindByHash(ctx, hashAPIKey(secret))
+	if err != nil {
+		return internal.APIKey{}, internal.WrapErrorf(err, internal.ErrUnknown, "repo.FindByHash")
+	}
+
+	if key.RevokedAt != nil {
+		return internal.APIKey{}, internal.NewErrorf(internal.ErrNotFound, "api key is revoked")
+	}
+
+	return key, nil
+}
+
+// RecordUsage counts a request of the key and returns its usage including the request
+func (s *APIKeyService) RecordUsage(ctx context.Context, id string) (internal.APIKeyUsage, error) {
+	defer newSentrySpan(ctx, "APIKeyService.RecordUsage").Finish()
+
+	usage, err := s.usage.Record(ctx, id)
+	if err != nil {
+		return internal.APIKeyUsage{}, internal.WrapErrorf(err, internal.ErrUnknown, "usage.Record")
+	}
+
+	return usage, nil
+}
+
+func (s *APIKeyService) FindUsage(ctx context.Context, id string) (internal.APIKeyUsage, error) {
+	defer newSentrySpan(ctx, "APIKeyService.FindUsage").Finish()
+
+	if _, err := s.repo.Find(ctx, id); err != nil {
+		return internal.APIKeyUsage{}, internal.WrapErrorf(err, internal.ErrUnknown, "repo.Find")
+	}
+
+	usage, err := s.usage.Find(ctx, id)
+	if err != nil {
+		return internal.APIKeyUsage{}, internal.WrapErrorf(err, internal.ErrUnknown, "usage.Find")
+	}
+
+	return usage, nil
+}
+
+func newAPIKey() (string, error) {
+	b := make([]byte, 32)
+
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return apiKeyPrefix + hex.EncodeToString(b), nil
+}
+
+// hashAPIKey hashes a key for storage, the keys are random enough that a fast unsalted hash cannot be reversed
+func hashAPIKey(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+
+	return hex.EncodeToString(sum[:])
+}
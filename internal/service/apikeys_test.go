@@ -0,0 +1,217 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"fourleaves.studio/manga-scraper/internal"
+	"fourleaves.studio/manga-scraper/internal/service/mock"
+	"go.uber.org/mock/gomock"
+)
+
+func TestAPIKeyService_Create(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock.NewMockAPIKeyRepository(ctrl)
+	mockUsage := mock.NewMockAPIKeyUsageRepository(ctrl)
+	service := NewAPIKeyService(mockRepo, mockUsage)
+
+	testCases := []struct {
+		name          string
+		params        internal.APIKeyParams
+		mockReturn    func()
+		expectedError bool
+	}{
+		{
+			name:   "generates a key",
+			params: internal.APIKeyParams{Name: "partner", Scopes: []internal.APIKeyScope{internal.ReadAPIKeyScope}},
+			mockReturn: func() {
+				mockRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, params internal.APIKeyParams) (internal.APIKey, error) {
+						if !strings.HasPrefix(params.Prefix, apiKeyPrefix) || len(params.Prefix) != apiKeyPrefixLength {
+							t.Errorf("unexpected prefix %q", params.Prefix)
+						}
+						if len(params.Hash) != 64 {
+							t.Errorf("expected a 64 character hash, got %q", params.Hash)
+						}
+
+						return internal.APIKey{ID: "1", Name: params.Name, Prefix: params.Prefix}, nil
+					})
+			},
+		},
+		{
+			name:          "validation failure",
+			params:        internal.APIKeyParams{Name: "partner"},
+			mockReturn:    func() {},
+			expectedError: true,
+		},
+		{
+			name:   "repository error",
+			params: internal.APIKeyParams{Name: "partner", Scopes: []internal.APIKeyScope{internal.ReadAPIKeyScope}},
+			mockReturn: func() {
+				mockRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					Return(internal.APIKey{}, fmt.Errorf("test error"))
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockReturn()
+
+			result, err := service.Create(context.Background(), tc.params)
+			if (err != nil) != tc.expectedError {
+				t.Errorf("expected error: %v, got: %v", tc.expectedError, err)
+			}
+
+			if !tc.expectedError && !strings.HasPrefix(result.Key, result.Prefix) {
+				t.Errorf("expected the key to be returned on create, got %q", result.Key)
+			}
+		})
+	}
+}
+
+func TestAPIKeyService_Rotate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock.NewMockAPIKeyRepository(ctrl)
+	mockUsage := mock.NewMockAPIKeyUsageRepository(ctrl)
+	service := NewAPIKeyService(mockRepo, mockUsage)
+
+	revokedAt := time.Now()
+
+	testCases := []struct {
+		name          string
+		mockReturn    func()
+		expectedError bool
+	}{
+		{
+			name: "replaces the key",
+			mockReturn: func() {
+				mockRepo.EXPECT().
+					Find(gomock.Any(), "1").
+					Return(internal.APIKey{ID: "1", Prefix: "msk_00000000"}, nil)
+				mockRepo.EXPECT().
+					Rotate(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, params internal.APIKeyParams) (internal.APIKey, error) {
+						if params.ID != "1" || params.Prefix == "msk_00000000" || params.Hash == "" {
+							t.Errorf("unexpected params %+v", params)
+						}
+
+						return internal.APIKey{ID: "1", Prefix: params.Prefix}, nil
+					})
+			},
+		},
+		{
+			name: "revoked key",
+			mockReturn: func() {
+				mockRepo.EXPECT().
+					Find(gomock.Any(), "1").
+					Return(internal.APIKey{ID: "1", RevokedAt: &revokedAt}, nil)
+			},
+			expectedError: true,
+		},
+		{
+			name: "not found",
+			mockReturn: func() {
+				mockRepo.EXPECT().
+					Find(gomock.Any(), "1").
+					Return(internal.APIKey{}, internal.NewErrorf(internal.ErrNotFound, "api key not found"))
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockReturn()
+
+			result, err := service.Rotate(context.Background(), "1")
+			if (err != nil) != tc.expectedError {
+				t.Errorf("expected error: %v, got: %v", tc.expectedError, err)
+			}
+
+			if !tc.expectedError && !strings.HasPrefix(result.Key, result.Prefix) {
+				t.Errorf("expected the new key to be returned on rotate, got %q", result.Key)
+			}
+		})
+	}
+}
+
+func TestAPIKeyService_Authenticate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock.NewMockAPIKeyRepository(ctrl)
+	mockUsage := mock.NewMockAPIKeyUsageRepository(ctrl)
+	service := NewAPIKeyService(mockRepo, mockUsage)
+
+	revokedAt := time.Now()
+
+	testCases := []struct {
+		name          string
+		mockReturn    func()
+		expectedCode  internal.ErrorCode
+		expectedError bool
+	}{
+		{
+			name: "active key",
+			mockReturn: func() {
+				mockRepo.EXPECT().
+					FindByHash(gomock.Any(), hashAPIKey("msk_secret")).
+					Return(internal.APIKey{ID: "1"}, nil)
+			},
+		},
+		{
+			name: "revoked key",
+			mockReturn: func() {
+				mockRepo.EXPECT().
+					FindByHash(gomock.Any(), hashAPIKey("msk_secret")).
+					Return(internal.APIKey{ID: "1", RevokedAt: &revokedAt}, nil)
+			},
+			expectedCode:  internal.ErrNotFound,
+			expectedError: true,
+		},
+		{
+			name: "unknown key",
+			mockReturn: func() {
+				mockRepo.EXPECT().
+					FindByHash(gomock.Any(), hashAPIKey("msk_secret")).
+					Return(internal.APIKey{}, internal.NewErrorf(internal.ErrNotFound, "api key not found"))
+			},
+			expectedCode:  internal.ErrNotFound,
+			expectedError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockReturn()
+
+			_, err := service.Authenticate(context.Background(), "msk_secret")
+			if (err != nil) != tc.expectedError {
+				t.Errorf("expected error: %v, got: %v", tc.expectedError, err)
+			}
+
+			if tc.expectedError {
+				var ierr *internal.Error
+				for errors.As(err, &ierr) {
+					err = ierr.Unwrap()
+				}
+
+				if ierr == nil || ierr.Code() != tc.expectedCode {
+					t.Errorf("expected code %v, got %v", tc.expectedCode, err)
+				}
+			}
+		})
+	}
+}
@@ -14,18 +14,20 @@ type ChapterRepository interface {
 	Count(ctx context.Context, params internal.FindChapterParams) (int, error)
 	FindAll(ctx context.Context, params internal.FindChapterParams) ([]internal.Chapter, error)
 	FindListWithRel(ctx context.Context, params internal.FindChapterParams) (internal.ChapterList, error)
-	FindPaginated(ctx context.Context, params internal.FindChapterParams) ([]internal.Chapter, error)
+	FindPaginated(ctx context.Context, params internal.FindChapterParams) (internal.ChapterPage, error)
 	UpdateInit(ctx context.Context, params internal.UpdateInitChapterParams) (internal.Chapter, error)
 	Delete(ctx context.Context, params internal.FindChapterParams) error
 }
 
 type ChapterService struct {
-	repo ChapterRepository
+	repo  ChapterRepository
+	works WorkSourceRepository
 }
 
-func NewChapterService(repo ChapterRepository) *ChapterService {
+func NewChapterService(repo ChapterRepository, works WorkSourceRepository) *ChapterService {
 	return &ChapterService{
-		repo: repo,
+		repo:  repo,
+		works: works,
 	}
 }
 
@@ -52,6 +54,11 @@ func (s *ChapterService) Find(ctx context.Context, params internal.FindChapterPa
 		return internal.Chapter{}, internal.WrapErrorf(err, internal.ErrUnknown, "repo.Find")
 	}
 
+	// sources are optional, a chapter of an unlinked series or a failed lookup still returns the chapter
+	if sources, err := s.works.FindChapterSources(ctx, chapter); err == nil {
+		chapter.Sources = sources
+	}
+
 	return chapter, nil
 }
 
@@ -107,18 +114,26 @@ func (s *ChapterService) FindListWithRel(ctx context.Context, params internal.Fi
 		return internal.ChapterList{}, internal.WrapErrorf(err, internal.ErrUnknown, "repo.FindListWithRel")
 	}
 
+	if work, err := s.works.FindBySeries(ctx, internal.FindSeriesParams{
+		Provider: chapterList.Series.Provider,
+		Slug:     chapterList.Series.Slug,
+	}); err == nil {
+		chapterList.Series.WorkID = work.ID
+		chapterList.Series.Sources = seriesSources(work, chapterList.Series.Provider, chapterList.Series.Slug)
+	}
+
 	return chapterList, nil
 }
 
-func (s *ChapterService) FindPaginated(ctx context.Context, params internal.FindChapterParams) ([]internal.Chapter, error) {
+func (s *ChapterService) FindPaginated(ctx context.Context, params internal.FindChapterParams) (internal.ChapterPage, error) {
 	defer newSentrySpan(ctx, "ChapterService.FindPaginated").Finish()
 
-	chapters, err := s.repo.FindPaginated(ctx, params)
+	page, err := s.repo.FindPaginated(ctx, params)
 	if err != nil {
-		return nil, internal.WrapErrorf(err, internal.ErrUnknown, "repo.FindPaginated")
+		return internal.ChapterPage{}, internal.WrapErrorf(err, internal.ErrUnknown, "repo.FindPaginated")
 	}
 
-	return chapters, nil
+	return page, nil
 }
 
 func (s *ChapterService) UpdateInit(ctx context.Context, params internal.UpdateInitChapterParams) (internal.Chapter, error) {
@@ -160,10 +160,10 @@ func (mr *MockChapterRepositoryMockRecorder) FindListWithRel(ctx, params any) *g
 }
 
 // FindPaginated mocks base method.
-func (m *MockChapterRepository) FindPaginated(ctx context.Context, params internal.FindChapterParams) ([]internal.Chapter, error) {
+func (m *MockChapterRepository) FindPaginated(ctx context.Context, params internal.FindChapterParams) (internal.ChapterPage, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "FindPaginated", ctx, params)
-	ret0, _ := ret[0].([]internal.Chapter)
+	ret0, _ := ret[0].(internal.ChapterPage)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -0,0 +1,359 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/service/reindex.go
+//
+// Generated by this command:
+//
+//	mockgen -package mock -destination internal/service/mock/reindex.go -source internal/service/reindex.go ReindexJobRepository,ReindexSeriesRepository,ReindexChangeRepository,ReindexProviderRepository,ReindexSearchRepository
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	internal "fourleaves.studio/manga-scraper/internal"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockReindexJobRepository is a mock of ReindexJobRepository interface.
+type MockReindexJobRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockReindexJobRepositoryMockRecorder
+}
+
+// MockReindexJobRepositoryMockRecorder is the mock recorder for MockReindexJobRepository.
+type MockReindexJobRepositoryMockRecorder struct {
+	mock *MockReindexJobRepository
+}
+
+// NewMockReindexJobRepository creates a new mock instance.
+func NewMockReindexJobRepository(ctrl *gomock.Controller) *MockReindexJobRepository {
+	mock := &MockReindexJobRepository{ctrl: ctrl}
+	mock.recorder = &MockReindexJobRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReindexJobRepository) EXPECT() *MockReindexJobRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockReindexJobRepository) Create(ctx context.Context, provider string) (internal.ReindexJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, provider)
+	ret0, _ := ret[0].(internal.ReindexJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockReindexJobRepositoryMockRecorder) Create(ctx, provider any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockReindexJobRepository)(nil).Create), ctx, provider)
+}
+
+// Find mocks base method.
+func (m *MockReindexJobRepository) Find(ctx context.Context, id string) (internal.ReindexJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", ctx, id)
+	ret0, _ := ret[0].(internal.ReindexJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockReindexJobRepositoryMockRecorder) Find(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockReindexJobRepository)(nil).Find), ctx, id)
+}
+
+// ReleaseStale mocks base method.
+func (m *MockReindexJobRepository) ReleaseStale(ctx context.Context, provider string, since time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseStale", ctx, provider, since)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseStale indicates an expected call of ReleaseStale.
+func (mr *MockReindexJobRepositoryMockRecorder) ReleaseStale(ctx, provider, since any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseStale", reflect.TypeOf((*MockReindexJobRepository)(nil).ReleaseStale), ctx, provider, since)
+}
+
+// Update mocks base method.
+func (m *MockReindexJobRepository) Update(ctx context.Context, params internal.UpdateReindexJobParams) (internal.ReindexJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, params)
+	ret0, _ := ret[0].(internal.ReindexJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockReindexJobRepositoryMockRecorder) Update(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockReindexJobRepository)(nil).Update), ctx, params)
+}
+
+// MockReindexSeriesRepository is a mock of ReindexSeriesRepository interface.
+type MockReindexSeriesRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockReindexSeriesRepositoryMockRecorder
+}
+
+// MockReindexSeriesRepositoryMockRecorder is the mock recorder for MockReindexSeriesRepository.
+type MockReindexSeriesRepositoryMockRecorder struct {
+	mock *MockReindexSeriesRepository
+}
+
+// NewMockReindexSeriesRepository creates a new mock instance.
+func NewMockReindexSeriesRepository(ctrl *gomock.Controller) *MockReindexSeriesRepository {
+	mock := &MockReindexSeriesRepository{ctrl: ctrl}
+	mock.recorder = &MockReindexSeriesRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReindexSeriesRepository) EXPECT() *MockReindexSeriesRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Find mocks base method.
+func (m *MockReindexSeriesRepository) Find(ctx context.Context, params internal.FindSeriesParams) (internal.Series, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", ctx, params)
+	ret0, _ := ret[0].(internal.Series)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockReindexSeriesRepositoryMockRecorder) Find(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockReindexSeriesRepository)(nil).Find), ctx, params)
+}
+
+// FindAll mocks base method.
+func (m *MockReindexSeriesRepository) FindAll(ctx context.Context, params internal.FindSeriesParams) ([]internal.Series, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx, params)
+	ret0, _ := ret[0].([]internal.Series)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockReindexSeriesRepositoryMockRecorder) FindAll(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockReindexSeriesRepository)(nil).FindAll), ctx, params)
+}
+
+// MockReindexChangeRepository is a mock of ReindexChangeRepository interface.
+type MockReindexChangeRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockReindexChangeRepositoryMockRecorder
+}
+
+// MockReindexChangeRepositoryMockRecorder is the mock recorder for MockReindexChangeRepository.
+type MockReindexChangeRepositoryMockRecorder struct {
+	mock *MockReindexChangeRepository
+}
+
+// NewMockReindexChangeRepository creates a new mock instance.
+func NewMockReindexChangeRepository(ctrl *gomock.Controller) *MockReindexChangeRepository {
+	mock := &MockReindexChangeRepository{ctrl: ctrl}
+	mock.recorder = &MockReindexChangeRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReindexChangeRepository) EXPECT() *MockReindexChangeRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindByProvider mocks base method.
+func (m *MockReindexChangeRepository) FindByProvider(ctx context.Context, provider string) ([]internal.SeriesChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByProvider", ctx, provider)
+	ret0, _ := ret[0].([]internal.SeriesChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByProvider indicates an expected call of FindByProvider.
+func (mr *MockReindexChangeRepositoryMockRecorder) FindByProvider(ctx, provider any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByProvider", reflect.TypeOf((*MockReindexChangeRepository)(nil).FindByProvider), ctx, provider)
+}
+
+// MockReindexProviderRepository is a mock of ReindexProviderRepository interface.
+type MockReindexProviderRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockReindexProviderRepositoryMockRecorder
+}
+
+// MockReindexProviderRepositoryMockRecorder is the mock recorder for MockReindexProviderRepository.
+type MockReindexProviderRepositoryMockRecorder struct {
+	mock *MockReindexProviderRepository
+}
+
+// NewMockReindexProviderRepository creates a new mock instance.
+func NewMockReindexProviderRepository(ctrl *gomock.Controller) *MockReindexProviderRepository {
+	mock := &MockReindexProviderRepository{ctrl: ctrl}
+	mock.recorder = &MockReindexProviderRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReindexProviderRepository) EXPECT() *MockReindexProviderRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Find mocks base method.
+func (m *MockReindexProviderRepository) Find(ctx context.Context, slug string) (internal.Provider, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", ctx, slug)
+	ret0, _ := ret[0].(internal.Provider)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockReindexProviderRepositoryMockRecorder) Find(ctx, slug any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockReindexProviderRepository)(nil).Find), ctx, slug)
+}
+
+// FindAll mocks base method.
+func (m *MockReindexProviderRepository) FindAll(ctx context.Context, order internal.SortOrder) ([]internal.Provider, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx, order)
+	ret0, _ := ret[0].([]internal.Provider)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockReindexProviderRepositoryMockRecorder) FindAll(ctx, order any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockReindexProviderRepository)(nil).FindAll), ctx, order)
+}
+
+// MockReindexSearchRepository is a mock of ReindexSearchRepository interface.
+type MockReindexSearchRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockReindexSearchRepositoryMockRecorder
+}
+
+// MockReindexSearchRepositoryMockRecorder is the mock recorder for MockReindexSearchRepository.
+type MockReindexSearchRepositoryMockRecorder struct {
+	mock *MockReindexSearchRepository
+}
+
+// NewMockReindexSearchRepository creates a new mock instance.
+func NewMockReindexSearchRepository(ctrl *gomock.Controller) *MockReindexSearchRepository {
+	mock := &MockReindexSearchRepository{ctrl: ctrl}
+	mock.recorder = &MockReindexSearchRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReindexSearchRepository) EXPECT() *MockReindexSearchRepositoryMockRecorder {
+	return m.recorder
+}
+
+// BulkDelete mocks base method.
+func (m *MockReindexSearchRepository) BulkDelete(ctx context.Context, index string, slugs []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BulkDelete", ctx, index, slugs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BulkDelete indicates an expected call of BulkDelete.
+func (mr *MockReindexSearchRepositoryMockRecorder) BulkDelete(ctx, index, slugs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkDelete", reflect.TypeOf((*MockReindexSearchRepository)(nil).BulkDelete), ctx, index, slugs)
+}
+
+// BulkIndex mocks base method.
+func (m *MockReindexSearchRepository) BulkIndex(ctx context.Context, index string, series []internal.Series) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BulkIndex", ctx, index, series)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BulkIndex indicates an expected call of BulkIndex.
+func (mr *MockReindexSearchRepositoryMockRecorder) BulkIndex(ctx, index, series any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkIndex", reflect.TypeOf((*MockReindexSearchRepository)(nil).BulkIndex), ctx, index, series)
+}
+
+// Count mocks base method.
+func (m *MockReindexSearchRepository) Count(ctx context.Context, index string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Count", ctx, index)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Count indicates an expected call of Count.
+func (mr *MockReindexSearchRepositoryMockRecorder) Count(ctx, index any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockReindexSearchRepository)(nil).Count), ctx, index)
+}
+
+// CreateIndex mocks base method.
+func (m *MockReindexSearchRepository) CreateIndex(ctx context.Context, provider string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateIndex", ctx, provider)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateIndex indicates an expected call of CreateIndex.
+func (mr *MockReindexSearchRepositoryMockRecorder) CreateIndex(ctx, provider any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIndex", reflect.TypeOf((*MockReindexSearchRepository)(nil).CreateIndex), ctx, provider)
+}
+
+// DeleteIndex mocks base method.
+func (m *MockReindexSearchRepository) DeleteIndex(ctx context.Context, indices ...string) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range indices {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteIndex", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteIndex indicates an expected call of DeleteIndex.
+func (mr *MockReindexSearchRepositoryMockRecorder) DeleteIndex(ctx any, indices ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, indices...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteIndex", reflect.TypeOf((*MockReindexSearchRepository)(nil).DeleteIndex), varargs...)
+}
+
+// SwapAlias mocks base method.
+func (m *MockReindexSearchRepository) SwapAlias(ctx context.Context, provider, index string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SwapAlias", ctx, provider, index)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SwapAlias indicates an expected call of SwapAlias.
+func (mr *MockReindexSearchRepositoryMockRecorder) SwapAlias(ctx, provider, index any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SwapAlias", reflect.TypeOf((*MockReindexSearchRepository)(nil).SwapAlias), ctx, provider, index)
+}
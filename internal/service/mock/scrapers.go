@@ -40,6 +40,36 @@ func (m *MockScrapeRequestRepository) EXPECT() *MockScrapeRequestRepositoryMockR
 	return m.recorder
 }
 
+// Cancel mocks base method.
+func (m *MockScrapeRequestRepository) Cancel(ctx context.Context, id string) (internal.ScrapeRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cancel", ctx, id)
+	ret0, _ := ret[0].(internal.ScrapeRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Cancel indicates an expected call of Cancel.
+func (mr *MockScrapeRequestRepositoryMockRecorder) Cancel(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cancel", reflect.TypeOf((*MockScrapeRequestRepository)(nil).Cancel), ctx, id)
+}
+
+// Count mocks base method.
+func (m *MockScrapeRequestRepository) Count(ctx context.Context, params internal.FindScrapeRequestParams) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Count", ctx, params)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Count indicates an expected call of Count.
+func (mr *MockScrapeRequestRepositoryMockRecorder) Count(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockScrapeRequestRepository)(nil).Count), ctx, params)
+}
+
 // Create mocks base method.
 func (m *MockScrapeRequestRepository) Create(ctx context.Context, params internal.CreateScrapeRequestParams) (internal.ScrapeRequest, error) {
 	m.ctrl.T.Helper()
@@ -69,6 +99,21 @@ func (mr *MockScrapeRequestRepositoryMockRecorder) Delete(ctx, id any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockScrapeRequestRepository)(nil).Delete), ctx, id)
 }
 
+// DeleteMany mocks base method.
+func (m *MockScrapeRequestRepository) DeleteMany(ctx context.Context, params internal.DeleteScrapeRequestParams) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMany", ctx, params)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteMany indicates an expected call of DeleteMany.
+func (mr *MockScrapeRequestRepositoryMockRecorder) DeleteMany(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMany", reflect.TypeOf((*MockScrapeRequestRepository)(nil).DeleteMany), ctx, params)
+}
+
 // Find mocks base method.
 func (m *MockScrapeRequestRepository) Find(ctx context.Context, id string) (internal.ScrapeRequest, error) {
 	m.ctrl.T.Helper()
@@ -84,19 +129,35 @@ func (mr *MockScrapeRequestRepositoryMockRecorder) Find(ctx, id any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockScrapeRequestRepository)(nil).Find), ctx, id)
 }
 
-// FindPendings mocks base method.
-func (m *MockScrapeRequestRepository) FindPendings(ctx context.Context, params internal.FindScrapeRequestParams) ([]internal.ScrapeRequest, error) {
+// FindPaginated mocks base method.
+func (m *MockScrapeRequestRepository) FindPaginated(ctx context.Context, params internal.FindScrapeRequestParams) ([]internal.ScrapeRequest, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindPendings", ctx, params)
+	ret := m.ctrl.Call(m, "FindPaginated", ctx, params)
 	ret0, _ := ret[0].([]internal.ScrapeRequest)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// FindPendings indicates an expected call of FindPendings.
-func (mr *MockScrapeRequestRepositoryMockRecorder) FindPendings(ctx, params any) *gomock.Call {
+// FindPaginated indicates an expected call of FindPaginated.
+func (mr *MockScrapeRequestRepositoryMockRecorder) FindPaginated(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPaginated", reflect.TypeOf((*MockScrapeRequestRepository)(nil).FindPaginated), ctx, params)
+}
+
+// Requeue mocks base method.
+func (m *MockScrapeRequestRepository) Requeue(ctx context.Context, request internal.ScrapeRequest) (internal.ScrapeRequest, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Requeue", ctx, request)
+	ret0, _ := ret[0].(internal.ScrapeRequest)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Requeue indicates an expected call of Requeue.
+func (mr *MockScrapeRequestRepositoryMockRecorder) Requeue(ctx, request any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPendings", reflect.TypeOf((*MockScrapeRequestRepository)(nil).FindPendings), ctx, params)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Requeue", reflect.TypeOf((*MockScrapeRequestRepository)(nil).Requeue), ctx, request)
 }
 
 // Update mocks base method.
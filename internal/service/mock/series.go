@@ -40,6 +40,21 @@ func (m *MockSeriesRepository) EXPECT() *MockSeriesRepositoryMockRecorder {
 	return m.recorder
 }
 
+// Browse mocks base method.
+func (m *MockSeriesRepository) Browse(ctx context.Context, params internal.BrowseSeriesParams) (internal.SeriesPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Browse", ctx, params)
+	ret0, _ := ret[0].(internal.SeriesPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Browse indicates an expected call of Browse.
+func (mr *MockSeriesRepositoryMockRecorder) Browse(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Browse", reflect.TypeOf((*MockSeriesRepository)(nil).Browse), ctx, params)
+}
+
 // CreateInit mocks base method.
 func (m *MockSeriesRepository) CreateInit(ctx context.Context, params internal.CreateInitSeriesParams) (internal.Series, error) {
 	m.ctrl.T.Helper()
@@ -115,10 +130,10 @@ func (mr *MockSeriesRepositoryMockRecorder) FindBC(ctx, params any) *gomock.Call
 }
 
 // FindPaginated mocks base method.
-func (m *MockSeriesRepository) FindPaginated(ctx context.Context, params internal.FindSeriesParams) ([]internal.Series, error) {
+func (m *MockSeriesRepository) FindPaginated(ctx context.Context, params internal.FindSeriesParams) (internal.SeriesPage, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "FindPaginated", ctx, params)
-	ret0, _ := ret[0].([]internal.Series)
+	ret0, _ := ret[0].(internal.SeriesPage)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -129,6 +144,21 @@ func (mr *MockSeriesRepositoryMockRecorder) FindPaginated(ctx, params any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPaginated", reflect.TypeOf((*MockSeriesRepository)(nil).FindPaginated), ctx, params)
 }
 
+// SyncChapters mocks base method.
+func (m *MockSeriesRepository) SyncChapters(ctx context.Context, params internal.FindSeriesParams) (internal.Series, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SyncChapters", ctx, params)
+	ret0, _ := ret[0].(internal.Series)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SyncChapters indicates an expected call of SyncChapters.
+func (mr *MockSeriesRepositoryMockRecorder) SyncChapters(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncChapters", reflect.TypeOf((*MockSeriesRepository)(nil).SyncChapters), ctx, params)
+}
+
 // UpdateInit mocks base method.
 func (m *MockSeriesRepository) UpdateInit(ctx context.Context, params internal.UpdateInitSeriesParams) (internal.Series, error) {
 	m.ctrl.T.Helper()
@@ -182,45 +212,32 @@ func (m *MockSeriesSearchRepository) EXPECT() *MockSeriesSearchRepositoryMockRec
 	return m.recorder
 }
 
-// Delete mocks base method.
-func (m *MockSeriesSearchRepository) Delete(ctx context.Context, provider, slug string) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", ctx, provider, slug)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// Delete indicates an expected call of Delete.
-func (mr *MockSeriesSearchRepositoryMockRecorder) Delete(ctx, provider, slug any) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockSeriesSearchRepository)(nil).Delete), ctx, provider, slug)
-}
-
-// Index mocks base method.
-func (m *MockSeriesSearchRepository) Index(ctx context.Context, series internal.Series) error {
+// Search mocks base method.
+func (m *MockSeriesSearchRepository) Search(ctx context.Context, params internal.SearchSeriesParams) (internal.SeriesSearchResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Index", ctx, series)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "Search", ctx, params)
+	ret0, _ := ret[0].(internal.SeriesSearchResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// Index indicates an expected call of Index.
-func (mr *MockSeriesSearchRepositoryMockRecorder) Index(ctx, series any) *gomock.Call {
+// Search indicates an expected call of Search.
+func (mr *MockSeriesSearchRepositoryMockRecorder) Search(ctx, params any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Index", reflect.TypeOf((*MockSeriesSearchRepository)(nil).Index), ctx, series)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockSeriesSearchRepository)(nil).Search), ctx, params)
 }
 
-// Search mocks base method.
-func (m *MockSeriesSearchRepository) Search(ctx context.Context, q string) ([]internal.Series, error) {
+// Suggest mocks base method.
+func (m *MockSeriesSearchRepository) Suggest(ctx context.Context, params internal.SuggestSeriesParams) ([]internal.SeriesSuggestion, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Search", ctx, q)
-	ret0, _ := ret[0].([]internal.Series)
+	ret := m.ctrl.Call(m, "Suggest", ctx, params)
+	ret0, _ := ret[0].([]internal.SeriesSuggestion)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Search indicates an expected call of Search.
-func (mr *MockSeriesSearchRepositoryMockRecorder) Search(ctx, q any) *gomock.Call {
+// Suggest indicates an expected call of Suggest.
+func (mr *MockSeriesSearchRepositoryMockRecorder) Suggest(ctx, params any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockSeriesSearchRepository)(nil).Search), ctx, q)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Suggest", reflect.TypeOf((*MockSeriesSearchRepository)(nil).Suggest), ctx, params)
 }
@@ -0,0 +1,252 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/service/works.go
+//
+// Generated by this command:
+//
+//	mockgen -package mock -destination internal/service/mock/works.go -source internal/service/works.go WorkSourceRepository,WorkRepository,WorkChapterRepository
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	internal "fourleaves.studio/manga-scraper/internal"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockWorkSourceRepository is a mock of WorkSourceRepository interface.
+type MockWorkSourceRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockWorkSourceRepositoryMockRecorder
+}
+
+// MockWorkSourceRepositoryMockRecorder is the mock recorder for MockWorkSourceRepository.
+type MockWorkSourceRepositoryMockRecorder struct {
+	mock *MockWorkSourceRepository
+}
+
+// NewMockWorkSourceRepository creates a new mock instance.
+func NewMockWorkSourceRepository(ctrl *gomock.Controller) *MockWorkSourceRepository {
+	mock := &MockWorkSourceRepository{ctrl: ctrl}
+	mock.recorder = &MockWorkSourceRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWorkSourceRepository) EXPECT() *MockWorkSourceRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindBySeries mocks base method.
+func (m *MockWorkSourceRepository) FindBySeries(ctx context.Context, params internal.FindSeriesParams) (internal.Work, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBySeries", ctx, params)
+	ret0, _ := ret[0].(internal.Work)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindBySeries indicates an expected call of FindBySeries.
+func (mr *MockWorkSourceRepositoryMockRecorder) FindBySeries(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBySeries", reflect.TypeOf((*MockWorkSourceRepository)(nil).FindBySeries), ctx, params)
+}
+
+// FindChapterSources mocks base method.
+func (m *MockWorkSourceRepository) FindChapterSources(ctx context.Context, chapter internal.Chapter) ([]internal.ChapterSource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindChapterSources", ctx, chapter)
+	ret0, _ := ret[0].([]internal.ChapterSource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindChapterSources indicates an expected call of FindChapterSources.
+func (mr *MockWorkSourceRepositoryMockRecorder) FindChapterSources(ctx, chapter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindChapterSources", reflect.TypeOf((*MockWorkSourceRepository)(nil).FindChapterSources), ctx, chapter)
+}
+
+// MockWorkRepository is a mock of WorkRepository interface.
+type MockWorkRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockWorkRepositoryMockRecorder
+}
+
+// MockWorkRepositoryMockRecorder is the mock recorder for MockWorkRepository.
+type MockWorkRepositoryMockRecorder struct {
+	mock *MockWorkRepository
+}
+
+// NewMockWorkRepository creates a new mock instance.
+func NewMockWorkRepository(ctrl *gomock.Controller) *MockWorkRepository {
+	mock := &MockWorkRepository{ctrl: ctrl}
+	mock.recorder = &MockWorkRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWorkRepository) EXPECT() *MockWorkRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Find mocks base method.
+func (m *MockWorkRepository) Find(ctx context.Context, id string) (internal.Work, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", ctx, id)
+	ret0, _ := ret[0].(internal.Work)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockWorkRepositoryMockRecorder) Find(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockWorkRepository)(nil).Find), ctx, id)
+}
+
+// FindCandidate mocks base method.
+func (m *MockWorkRepository) FindCandidate(ctx context.Context, id string) (internal.WorkCandidate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindCandidate", ctx, id)
+	ret0, _ := ret[0].(internal.WorkCandidate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindCandidate indicates an expected call of FindCandidate.
+func (mr *MockWorkRepositoryMockRecorder) FindCandidate(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindCandidate", reflect.TypeOf((*MockWorkRepository)(nil).FindCandidate), ctx, id)
+}
+
+// FindCandidates mocks base method.
+func (m *MockWorkRepository) FindCandidates(ctx context.Context, params internal.FindWorkCandidatesParams) ([]internal.WorkCandidate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindCandidates", ctx, params)
+	ret0, _ := ret[0].([]internal.WorkCandidate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindCandidates indicates an expected call of FindCandidates.
+func (mr *MockWorkRepositoryMockRecorder) FindCandidates(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindCandidates", reflect.TypeOf((*MockWorkRepository)(nil).FindCandidates), ctx, params)
+}
+
+// Link mocks base method.
+func (m *MockWorkRepository) Link(ctx context.Context, params internal.WorkSeriesParams) (internal.Work, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Link", ctx, params)
+	ret0, _ := ret[0].(internal.Work)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Link indicates an expected call of Link.
+func (mr *MockWorkRepositoryMockRecorder) Link(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Link", reflect.TypeOf((*MockWorkRepository)(nil).Link), ctx, params)
+}
+
+// Merge mocks base method.
+func (m *MockWorkRepository) Merge(ctx context.Context, params internal.MergeWorksParams) (internal.Work, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Merge", ctx, params)
+	ret0, _ := ret[0].(internal.Work)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Merge indicates an expected call of Merge.
+func (mr *MockWorkRepositoryMockRecorder) Merge(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Merge", reflect.TypeOf((*MockWorkRepository)(nil).Merge), ctx, params)
+}
+
+// RejectCandidate mocks base method.
+func (m *MockWorkRepository) RejectCandidate(ctx context.Context, id string) (internal.WorkCandidate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RejectCandidate", ctx, id)
+	ret0, _ := ret[0].(internal.WorkCandidate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RejectCandidate indicates an expected call of RejectCandidate.
+func (mr *MockWorkRepositoryMockRecorder) RejectCandidate(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RejectCandidate", reflect.TypeOf((*MockWorkRepository)(nil).RejectCandidate), ctx, id)
+}
+
+// Split mocks base method.
+func (m *MockWorkRepository) Split(ctx context.Context, params internal.WorkSeriesParams) (internal.Work, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Split", ctx, params)
+	ret0, _ := ret[0].(internal.Work)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Split indicates an expected call of Split.
+func (mr *MockWorkRepositoryMockRecorder) Split(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Split", reflect.TypeOf((*MockWorkRepository)(nil).Split), ctx, params)
+}
+
+// Update mocks base method.
+func (m *MockWorkRepository) Update(ctx context.Context, params internal.UpdateWorkParams) (internal.Work, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, params)
+	ret0, _ := ret[0].(internal.Work)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockWorkRepositoryMockRecorder) Update(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockWorkRepository)(nil).Update), ctx, params)
+}
+
+// MockWorkChapterRepository is a mock of WorkChapterRepository interface.
+type MockWorkChapterRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockWorkChapterRepositoryMockRecorder
+}
+
+// MockWorkChapterRepositoryMockRecorder is the mock recorder for MockWorkChapterRepository.
+type MockWorkChapterRepositoryMockRecorder struct {
+	mock *MockWorkChapterRepository
+}
+
+// NewMockWorkChapterRepository creates a new mock instance.
+func NewMockWorkChapterRepository(ctrl *gomock.Controller) *MockWorkChapterRepository {
+	mock := &MockWorkChapterRepository{ctrl: ctrl}
+	mock.recorder = &MockWorkChapterRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWorkChapterRepository) EXPECT() *MockWorkChapterRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindAll mocks base method.
+func (m *MockWorkChapterRepository) FindAll(ctx context.Context, params internal.FindChapterParams) ([]internal.Chapter, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx, params)
+	ret0, _ := ret[0].([]internal.Chapter)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockWorkChapterRepositoryMockRecorder) FindAll(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockWorkChapterRepository)(nil).FindAll), ctx, params)
+}
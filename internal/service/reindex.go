@@ -0,0 +1,309 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"fourleaves.studio/manga-scraper/internal"
+)
+
+type ReindexJobRepository interface {
+	Create(ctx context.Context, provider string) (internal.ReindexJob, error)
+	Find(ctx context.Context, id string) (internal.ReindexJob, error)
+	ReleaseStale(ctx context.Context, provider string, since time.Time) error
+	Update(ctx context.Context, params internal.UpdateReindexJobParams) (internal.ReindexJob, error)
+}
+
+type ReindexSeriesRepository interface {
+	Find(ctx context.Context, params internal.FindSeriesParams) (internal.Series, error)
+	FindAll(ctx context.Context, params internal.FindSeriesParams) ([]internal.Series, error)
+}
+
+type ReindexChangeRepository interface {
+	FindByProvider(ctx context.Context, provider string) ([]internal.SeriesChange, error)
+}
+
+type ReindexProviderRepository interface {
+	Find(ctx context.Context, slug string) (internal.Provider, error)
+	FindAll(ctx context.Context, order internal.SortOrder) ([]internal.Provider, error)
+}
+
+type ReindexSearchRepository interface {
+	CreateIndex(ctx context.Context, provider string) (string, error)
+	BulkIndex(ctx context.Context, index string, series []internal.Series) error
+	BulkDelete(ctx context.Context, index string, slugs []string) error
+	Count(ctx context.Context, index string) (int, error)
+	SwapAlias(ctx context.Context, provider, index string) error
+	DeleteIndex(ctx context.Context, indices ...string) error
+}
+
+// reindexBatchSize is how many series go into one _bulk request
+const reindexBatchSize = 500
+
+type ReindexService struct {
+	jobs      ReindexJobRepository
+	series    ReindexSeriesRepository
+	changes   ReindexChangeRepository
+	providers ReindexProviderRepository
+	search    ReindexSearchRepository
+}
+
+func NewReindexService(
+	jobs ReindexJobRepository,
+	series ReindexSeriesRepository,
+	changes ReindexChangeRepository,
+	providers ReindexProviderRepository,
+	search ReindexSearchRepository,
+) *ReindexService {
+	return &ReindexService{
+		jobs:      jobs,
+		series:    series,
+		changes:   changes,
+		providers: providers,
+		search:    search,
+	}
+}
+
+func (s *ReindexService) Find(ctx context.Context, id string) (internal.ReindexJob, error) {
+	defer newSentrySpan(ctx, "ReindexService.Find").Finish()
+
+	job, err := s.jobs.Find(ctx, id)
+	if err != nil {
+		return internal.ReindexJob{}, internal.WrapErrorf(err, internal.ErrUnknown, "jobs.Find")
+	}
+
+	return job, nil
+}
+
+// Start creates a reindex job for the provider and runs it in the background, Find reports its progress.
+// Only one job runs per provider at a time
+func (s *ReindexService) Start(ctx context.Context, provider string) (internal.ReindexJob, error) {
+	defer newSentrySpan(ctx, "ReindexService.Start").Finish()
+
+	job, err := s.create(ctx, provider)
+	if err != nil {
+		return internal.ReindexJob{}, err
+	}
+
+	// the job outlives the request that started it
+	go func() {
+		_, _ = s.Run(context.WithoutCancel(ctx), job)
+	}()
+
+	return job, nil
+}
+
+// RunProvider creates a reindex job for the provider and waits for it to finish
+func (s *ReindexService) RunProvider(ctx context.Context, provider string) (internal.ReindexJob, error) {
+	defer newSentrySpan(ctx, "ReindexService.RunProvider").Finish()
+
+	job, err := s.create(ctx, provider)
+	if err != nil {
+		return internal.ReindexJob{}, err
+	}
+
+	return s.Run(ctx, job)
+}
+
+// RunAll rebuilds the index of every provider one after another, a failed provider does not stop the others
+func (s *ReindexService) RunAll(ctx context.Context) ([]internal.ReindexJob, error) {
+	defer newSentrySpan(ctx, "ReindexService.RunAll").Finish()
+
+	providers, err := s.providers.FindAll(ctx, internal.ASC)
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrUnknown, "providers.FindAll")
+	}
+
+	jobs := make([]internal.ReindexJob, 0, len(providers))
+
+	var failed int
+
+	for _, provider := range providers {
+		job, err := s.RunProvider(ctx, provider.Slug)
+		if err != nil {
+			failed++
+		}
+
+		if job.ID != "" {
+			jobs = append(jobs, job)
+		}
+	}
+
+	if failed > 0 {
+		return jobs, internal.NewErrorf(internal.ErrUnknown, "%d of %d providers failed to reindex", failed, len(providers))
+	}
+
+	return jobs, nil
+}
+
+// Run bulk indexes every series of the job's provider into a new versioned index, checks the index holds as many
+// series as MySQL and only then swaps the provider alias to it. A failed job deletes its index, searches never see it.
+// The indexer keeps the changes of the provider pending while the job runs, the job applies them to the new index
+// before the swap and the indexer applies them again once the job is done, so no change made meanwhile is lost
+func (s *ReindexService) Run(ctx context.Context, job internal.ReindexJob) (internal.ReindexJob, error) {
+	defer newSentrySpan(ctx, "ReindexService.Run").Finish()
+
+	series, err := s.series.FindAll(ctx, internal.FindSeriesParams{Provider: job.Provider})
+	if err != nil && !isNotFound(err) {
+		return s.fail(ctx, job, internal.WrapErrorf(err, internal.ErrUnknown, "series.FindAll"))
+	}
+
+	index, err := s.search.CreateIndex(ctx, job.Provider)
+	if err != nil {
+		return s.fail(ctx, job, internal.WrapErrorf(err, internal.ErrUnknown, "search.CreateIndex"))
+	}
+
+	job.Status = internal.RunningReindexStatus
+	job.Index = index
+	job.Total = len(series)
+	s.report(ctx, job)
+
+	for start := 0; start < len(series); start += reindexBatchSize {
+		end := min(start+reindexBatchSize, len(series))
+
+		if err := s.search.BulkIndex(ctx, index, series[start:end]); err != nil {
+			return s.fail(ctx, job, internal.WrapErrorf(err, internal.ErrUnknown, "search.BulkIndex"))
+		}
+
+		job.Indexed = end
+		s.report(ctx, job)
+	}
+
+	count, err := s.search.Count(ctx, index)
+	if err != nil {
+		return s.fail(ctx, job, internal.WrapErrorf(err, internal.ErrUnknown, "search.Count"))
+	}
+
+	if count != len(series) {
+		return s.fail(ctx, job, internal.NewErrorf(internal.ErrUnknown, "index holds %d series, expected %d", count, len(series)))
+	}
+
+	if err := s.catchUp(ctx, job); err != nil {
+		return s.fail(ctx, job, err)
+	}
+
+	if err := s.search.SwapAlias(ctx, job.Provider, index); err != nil {
+		return s.fail(ctx, job, internal.WrapErrorf(err, internal.ErrUnknown, "search.SwapAlias"))
+	}
+
+	job.Status = internal.CompletedReindexStatus
+
+	updated, err := s.jobs.Update(ctx, newUpdateReindexJobParams(job))
+	if err != nil {
+		return job, internal.WrapErrorf(err, internal.ErrUnknown, "jobs.Update")
+	}
+
+	return updated, nil
+}
+
+// catchUp applies the changes recorded for the provider since the snapshot to the new index,
+// it reads every series again rather than trusting the events
+func (s *ReindexService) catchUp(ctx context.Context, job internal.ReindexJob) error {
+	changes, err := s.changes.FindByProvider(ctx, job.Provider)
+	if err != nil {
+		return internal.WrapErrorf(err, internal.ErrUnknown, "changes.FindByProvider")
+	}
+
+	var (
+		changed []internal.Series
+		deleted []string
+	)
+
+	for _, change := range changes {
+		series, err := s.series.Find(ctx, internal.FindSeriesParams{Provider: change.Provider, Slug: change.Slug})
+		if err != nil {
+			if !isNotFound(err) {
+				return internal.WrapErrorf(err, internal.ErrUnknown, "series.Find")
+			}
+
+			deleted = append(deleted, change.Slug)
+
+			continue
+		}
+
+		changed = append(changed, series)
+	}
+
+	if len(changed) > 0 {
+		if err := s.search.BulkIndex(ctx, job.Index, changed); err != nil {
+			return internal.WrapErrorf(err, internal.ErrUnknown, "search.BulkIndex")
+		}
+	}
+
+	if len(deleted) > 0 {
+		if err := s.search.BulkDelete(ctx, job.Index, deleted); err != nil {
+			return internal.WrapErrorf(err, internal.ErrUnknown, "search.BulkDelete")
+		}
+	}
+
+	return nil
+}
+
+// create starts a job for the provider, the job repository lets a single unfinished job hold each provider
+func (s *ReindexService) create(ctx context.Context, provider string) (internal.ReindexJob, error) {
+	if _, err := s.providers.Find(ctx, provider); err != nil {
+		return internal.ReindexJob{}, internal.WrapErrorf(err, internal.ErrUnknown, "providers.Find")
+	}
+
+	if err := s.jobs.ReleaseStale(ctx, provider, time.Now().Add(-internal.ReindexStaleAfter)); err != nil {
+		return internal.ReindexJob{}, internal.WrapErrorf(err, internal.ErrUnknown, "jobs.ReleaseStale")
+	}
+
+	job, err := s.jobs.Create(ctx, provider)
+	if err != nil {
+		if isUniqueConstraint(err) {
+			return internal.ReindexJob{}, internal.NewErrorf(internal.ErrUniqueConstraint, "reindex job is already running for %s", provider)
+		}
+
+		return internal.ReindexJob{}, internal.WrapErrorf(err, internal.ErrUnknown, "jobs.Create")
+	}
+
+	return job, nil
+}
+
+// isUniqueConstraint reports whether the innermost coded error of the chain is a unique constraint violation
+func isUniqueConstraint(err error) bool {
+	var ierr *internal.Error
+
+	found := false
+
+	for errors.As(err, &ierr) {
+		found = ierr.Code() == internal.ErrUniqueConstraint
+		err = ierr.Unwrap()
+	}
+
+	return found
+}
+
+// report saves the progress of a running job, a failed save only delays the progress shown until the next one
+func (s *ReindexService) report(ctx context.Context, job internal.ReindexJob) {
+	_, _ = s.jobs.Update(ctx, newUpdateReindexJobParams(job))
+}
+
+// fail deletes the index the job was building and records the cause on the job
+func (s *ReindexService) fail(ctx context.Context, job internal.ReindexJob, cause error) (internal.ReindexJob, error) {
+	if job.Index != "" {
+		_ = s.search.DeleteIndex(ctx, job.Index)
+	}
+
+	job.Status = internal.FailedReindexStatus
+	job.Message = cause.Error()
+
+	if updated, err := s.jobs.Update(ctx, newUpdateReindexJobParams(job)); err == nil {
+		job = updated
+	}
+
+	return job, cause
+}
+
+func newUpdateReindexJobParams(job internal.ReindexJob) internal.UpdateReindexJobParams {
+	return internal.UpdateReindexJobParams{
+		ID:      job.ID,
+		Status:  job.Status,
+		Index:   job.Index,
+		Total:   job.Total,
+		Indexed: job.Indexed,
+		Message: job.Message,
+	}
+}
@@ -0,0 +1,217 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"fourleaves.studio/manga-scraper/internal"
+	"fourleaves.studio/manga-scraper/internal/service/mock"
+	"go.uber.org/mock/gomock"
+)
+
+func TestReindexService_Run(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockJobs := mock.NewMockReindexJobRepository(ctrl)
+	mockSeries := mock.NewMockReindexSeriesRepository(ctrl)
+	mockChanges := mock.NewMockReindexChangeRepository(ctrl)
+	mockSearch := mock.NewMockReindexSearchRepository(ctrl)
+
+	service := NewReindexService(mockJobs, mockSeries, mockChanges, nil, mockSearch)
+
+	series := make([]internal.Series, reindexBatchSize+1)
+	job := internal.ReindexJob{ID: "1", Provider: "asura", Status: internal.PendingReindexStatus}
+
+	testCases := []struct {
+		name           string
+		mockReturn     func()
+		expectedStatus internal.ReindexJobStatus
+		expectedError  bool
+	}{
+		{
+			name: "swaps the alias once the counts match",
+			mockReturn: func() {
+				mockSeries.EXPECT().FindAll(gomock.Any(), internal.FindSeriesParams{Provider: "asura"}).Return(series, nil)
+				mockSearch.EXPECT().CreateIndex(gomock.Any(), "asura").Return("series_asura_1", nil)
+				mockSearch.EXPECT().BulkIndex(gomock.Any(), "series_asura_1", gomock.Len(reindexBatchSize)).Return(nil)
+				mockSearch.EXPECT().BulkIndex(gomock.Any(), "series_asura_1", gomock.Len(1)).Return(nil)
+				mockSearch.EXPECT().Count(gomock.Any(), "series_asura_1").Return(len(series), nil)
+				mockChanges.EXPECT().FindByProvider(gomock.Any(), "asura").Return(nil, nil)
+				mockSearch.EXPECT().SwapAlias(gomock.Any(), "asura", "series_asura_1").Return(nil)
+				mockJobs.EXPECT().Update(gomock.Any(), gomock.Any()).Times(3).Return(internal.ReindexJob{}, nil)
+				mockJobs.EXPECT().
+					Update(gomock.Any(), internal.UpdateReindexJobParams{
+						ID:      "1",
+						Status:  internal.CompletedReindexStatus,
+						Index:   "series_asura_1",
+						Total:   len(series),
+						Indexed: len(series),
+					}).
+					Return(internal.ReindexJob{ID: "1", Status: internal.CompletedReindexStatus}, nil)
+			},
+			expectedStatus: internal.CompletedReindexStatus,
+		},
+		{
+			name: "applies the changes made since the snapshot before the swap",
+			mockReturn: func() {
+				updated := internal.Series{Provider: "asura", Slug: "updated"}
+
+				mockSeries.EXPECT().FindAll(gomock.Any(), gomock.Any()).Return(series[:1], nil)
+				mockSearch.EXPECT().CreateIndex(gomock.Any(), "asura").Return("series_asura_1", nil)
+				mockSearch.EXPECT().BulkIndex(gomock.Any(), "series_asura_1", gomock.Len(1)).Return(nil)
+				mockSearch.EXPECT().Count(gomock.Any(), "series_asura_1").Return(1, nil)
+				mockChanges.EXPECT().
+					FindByProvider(gomock.Any(), "asura").
+					Return([]internal.SeriesChange{
+						{Provider: "asura", Slug: "updated", Event: internal.SeriesUpsertedEvent},
+						{Provider: "asura", Slug: "deleted", Event: internal.SeriesDeletedEvent},
+					}, nil)
+				mockSeries.EXPECT().
+					Find(gomock.Any(), internal.FindSeriesParams{Provider: "asura", Slug: "updated"}).
+					Return(updated, nil)
+				mockSeries.EXPECT().
+					Find(gomock.Any(), internal.FindSeriesParams{Provider: "asura", Slug: "deleted"}).
+					Return(internal.Series{}, internal.NewErrorf(internal.ErrNotFound, "series not found"))
+				mockSearch.EXPECT().BulkIndex(gomock.Any(), "series_asura_1", []internal.Series{updated}).Return(nil)
+				mockSearch.EXPECT().BulkDelete(gomock.Any(), "series_asura_1", []string{"deleted"}).Return(nil)
+				mockSearch.EXPECT().SwapAlias(gomock.Any(), "asura", "series_asura_1").Return(nil)
+				mockJobs.EXPECT().Update(gomock.Any(), gomock.Any()).Times(2).Return(internal.ReindexJob{}, nil)
+				mockJobs.EXPECT().
+					Update(gomock.Any(), gomock.Any()).
+					Return(internal.ReindexJob{ID: "1", Status: internal.CompletedReindexStatus}, nil)
+			},
+			expectedStatus: internal.CompletedReindexStatus,
+		},
+		{
+			name: "catch up error keeps the previous index",
+			mockReturn: func() {
+				mockSeries.EXPECT().FindAll(gomock.Any(), gomock.Any()).Return(series[:1], nil)
+				mockSearch.EXPECT().CreateIndex(gomock.Any(), "asura").Return("series_asura_1", nil)
+				mockSearch.EXPECT().BulkIndex(gomock.Any(), "series_asura_1", gomock.Any()).Return(nil)
+				mockSearch.EXPECT().Count(gomock.Any(), "series_asura_1").Return(1, nil)
+				mockChanges.EXPECT().FindByProvider(gomock.Any(), "asura").Return(nil, fmt.Errorf("test error"))
+				mockSearch.EXPECT().DeleteIndex(gomock.Any(), "series_asura_1").Return(nil)
+				mockJobs.EXPECT().Update(gomock.Any(), gomock.Any()).Times(2).Return(internal.ReindexJob{}, nil)
+				mockJobs.EXPECT().
+					Update(gomock.Any(), gomock.Any()).
+					Return(internal.ReindexJob{ID: "1", Status: internal.FailedReindexStatus}, nil)
+			},
+			expectedStatus: internal.FailedReindexStatus,
+			expectedError:  true,
+		},
+		{
+			name: "count mismatch keeps the previous index",
+			mockReturn: func() {
+				mockSeries.EXPECT().FindAll(gomock.Any(), gomock.Any()).Return(series[:1], nil)
+				mockSearch.EXPECT().CreateIndex(gomock.Any(), "asura").Return("series_asura_1", nil)
+				mockSearch.EXPECT().BulkIndex(gomock.Any(), "series_asura_1", gomock.Any()).Return(nil)
+				mockSearch.EXPECT().Count(gomock.Any(), "series_asura_1").Return(0, nil)
+				mockSearch.EXPECT().DeleteIndex(gomock.Any(), "series_asura_1").Return(nil)
+				mockJobs.EXPECT().Update(gomock.Any(), gomock.Any()).Times(2).Return(internal.ReindexJob{}, nil)
+				mockJobs.EXPECT().
+					Update(gomock.Any(), gomock.Any()).
+					Return(internal.ReindexJob{ID: "1", Status: internal.FailedReindexStatus}, nil)
+			},
+			expectedStatus: internal.FailedReindexStatus,
+			expectedError:  true,
+		},
+		{
+			name: "bulk error deletes the new index",
+			mockReturn: func() {
+				mockSeries.EXPECT().FindAll(gomock.Any(), gomock.Any()).Return(series[:1], nil)
+				mockSearch.EXPECT().CreateIndex(gomock.Any(), "asura").Return("series_asura_1", nil)
+				mockSearch.EXPECT().BulkIndex(gomock.Any(), gomock.Any(), gomock.Any()).Return(fmt.Errorf("test error"))
+				mockSearch.EXPECT().DeleteIndex(gomock.Any(), "series_asura_1").Return(nil)
+				mockJobs.EXPECT().Update(gomock.Any(), gomock.Any()).Return(internal.ReindexJob{}, nil)
+				mockJobs.EXPECT().
+					Update(gomock.Any(), gomock.Any()).
+					Return(internal.ReindexJob{ID: "1", Status: internal.FailedReindexStatus}, nil)
+			},
+			expectedStatus: internal.FailedReindexStatus,
+			expectedError:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockReturn()
+
+			result, err := service.Run(context.Background(), job)
+			if (err != nil) != tc.expectedError {
+				t.Errorf("expected error: %v, got: %v", tc.expectedError, err)
+			}
+
+			if result.Status != tc.expectedStatus {
+				t.Errorf("expected status: %s, got: %s", tc.expectedStatus, result.Status)
+			}
+		})
+	}
+}
+
+func TestReindexService_Start(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockJobs := mock.NewMockReindexJobRepository(ctrl)
+	mockProviders := mock.NewMockReindexProviderRepository(ctrl)
+
+	service := NewReindexService(mockJobs, nil, nil, mockProviders, nil)
+
+	testCases := []struct {
+		name          string
+		mockReturn    func()
+		expectedCode  internal.ErrorCode
+		expectedError bool
+	}{
+		{
+			name: "provider not found",
+			mockReturn: func() {
+				mockProviders.EXPECT().
+					Find(gomock.Any(), "asura").
+					Return(internal.Provider{}, internal.NewErrorf(internal.ErrNotFound, "provider not found"))
+			},
+			expectedCode:  internal.ErrUnknown,
+			expectedError: true,
+		},
+		{
+			name: "job already running",
+			mockReturn: func() {
+				mockProviders.EXPECT().Find(gomock.Any(), "asura").Return(internal.Provider{Slug: "asura"}, nil)
+				mockJobs.EXPECT().ReleaseStale(gomock.Any(), "asura", gomock.Any()).Return(nil)
+				mockJobs.EXPECT().
+					Create(gomock.Any(), "asura").
+					Return(internal.ReindexJob{}, internal.NewErrorf(internal.ErrUniqueConstraint, "reindex job already running"))
+			},
+			expectedCode:  internal.ErrUniqueConstraint,
+			expectedError: true,
+		},
+		{
+			name: "stale job release error",
+			mockReturn: func() {
+				mockProviders.EXPECT().Find(gomock.Any(), "asura").Return(internal.Provider{Slug: "asura"}, nil)
+				mockJobs.EXPECT().ReleaseStale(gomock.Any(), "asura", gomock.Any()).Return(fmt.Errorf("test error"))
+			},
+			expectedCode:  internal.ErrUnknown,
+			expectedError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockReturn()
+
+			_, err := service.Start(context.Background(), "asura")
+			if (err != nil) != tc.expectedError {
+				t.Errorf("expected error: %v, got: %v", tc.expectedError, err)
+			}
+
+			var ierr *internal.Error
+			if tc.expectedError && (!errors.As(err, &ierr) || ierr.Code() != tc.expectedCode) {
+				t.Errorf("expected code %v, got %v", tc.expectedCode, err)
+			}
+		})
+	}
+}
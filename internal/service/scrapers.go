@@ -13,9 +13,13 @@ import (
 type ScrapeRequestRepository interface {
 	Create(ctx context.Context, params internal.CreateScrapeRequestParams) (internal.ScrapeRequest, error)
 	Find(ctx context.Context, id string) (internal.ScrapeRequest, error)
-	FindPendings(ctx context.Context, params internal.FindScrapeRequestParams) ([]internal.ScrapeRequest, error)
+	FindPaginated(ctx context.Context, params internal.FindScrapeRequestParams) ([]internal.ScrapeRequest, error)
+	Count(ctx context.Context, params internal.FindScrapeRequestParams) (int, error)
 	Update(ctx context.Context, params internal.UpdateScrapeRequestParams) (internal.ScrapeRequest, error)
+	Cancel(ctx context.Context, id string) (internal.ScrapeRequest, error)
+	Requeue(ctx context.Context, request internal.ScrapeRequest) (internal.ScrapeRequest, bool, error)
 	Delete(ctx context.Context, id string) error
+	DeleteMany(ctx context.Context, params internal.DeleteScrapeRequestParams) (int, error)
 }
 
 type ScrapeRequestMessageBroker interface {
@@ -100,15 +104,41 @@ func (s *ScraperService) Find(ctx context.Context, id string) (internal.ScrapeRe
 	return receipt, nil
 }
 
-func (s *ScraperService) FindPendings(ctx context.Context, params internal.FindScrapeRequestParams) ([]internal.ScrapeRequest, error) {
-	defer newSentrySpan(ctx, "Scraper.FindPendings").Finish()
+func (s *ScraperService) FindPaginated(ctx context.Context, params internal.FindScrapeRequestParams) (internal.ScrapeRequestPage, error) {
+	defer newSentrySpan(ctx, "Scraper.FindPaginated").Finish()
 
-	receipts, err := s.repo.FindPendings(ctx, params)
+	if err := params.Validate(); err != nil {
+		return internal.ScrapeRequestPage{}, internal.WrapErrorf(err, internal.ErrInvalidInput, "params.Validate")
+	}
+
+	return s.findPage(ctx, params)
+}
+
+func (s *ScraperService) FindDeadLetters(ctx context.Context, params internal.FindScrapeRequestParams) (internal.ScrapeRequestPage, error) {
+	defer newSentrySpan(ctx, "Scraper.FindDeadLetters").Finish()
+
+	params.Status = internal.DeadLetterRequestStatus
+
+	if err := params.Validate(); err != nil {
+		return internal.ScrapeRequestPage{}, internal.WrapErrorf(err, internal.ErrInvalidInput, "params.Validate")
+	}
+
+	return s.findPage(ctx, params)
+}
+
+// findPage reads a page of requests along with the number of requests matching the filters
+func (s *ScraperService) findPage(ctx context.Context, params internal.FindScrapeRequestParams) (internal.ScrapeRequestPage, error) {
+	receipts, err := s.repo.FindPaginated(ctx, params)
+	if err != nil {
+		return internal.ScrapeRequestPage{}, internal.WrapErrorf(err, internal.ErrUnknown, "repo.FindPaginated")
+	}
+
+	total, err := s.repo.Count(ctx, params)
 	if err != nil {
-		return nil, internal.WrapErrorf(err, internal.ErrUnknown, "repo.FindPendings")
+		return internal.ScrapeRequestPage{}, internal.WrapErrorf(err, internal.ErrUnknown, "repo.Count")
 	}
 
-	return receipts, nil
+	return internal.ScrapeRequestPage{Requests: receipts, Total: total}, nil
 }
 
 func (s *ScraperService) Update(ctx context.Context, params internal.UpdateScrapeRequestParams) (internal.ScrapeRequest, error) {
@@ -122,6 +152,78 @@ func (s *ScraperService) Update(ctx context.Context, params internal.UpdateScrap
 	return receipt, nil
 }
 
+func (s *ScraperService) Cancel(ctx context.Context, id string) (internal.ScrapeRequest, error) {
+	defer newSentrySpan(ctx, "Scraper.Cancel").Finish()
+
+	receipt, err := s.repo.Cancel(ctx, id)
+	if err != nil {
+		return internal.ScrapeRequest{}, internal.WrapErrorf(err, internal.ErrUnknown, "repo.Cancel")
+	}
+
+	return receipt, nil
+}
+
+// Retry publishes matching failed requests again, skipping targets that already have a pending request
+func (s *ScraperService) Retry(ctx context.Context, params internal.RetryScrapeRequestParams) (internal.RetryScrapeRequestResult, error) {
+	defer newSentrySpan(ctx, "Scraper.Retry").Finish()
+
+	if err := params.Validate(); err != nil {
+		return internal.RetryScrapeRequestResult{}, internal.WrapErrorf(err, internal.ErrInvalidInput, "params.Validate")
+	}
+
+	receipts, err := s.repo.FindPaginated(ctx, internal.FindScrapeRequestParams{
+		Type:          params.Type,
+		Provider:      params.Provider,
+		Status:        params.Status,
+		Message:       params.Message,
+		CreatedAfter:  params.CreatedAfter,
+		CreatedBefore: params.CreatedBefore,
+		Order:         internal.ASC,
+		Page:          1,
+		Size:          params.Limit,
+	})
+	if err != nil {
+		return internal.RetryScrapeRequestResult{}, internal.WrapErrorf(err, internal.ErrUnknown, "repo.FindPaginated")
+	}
+
+	result := internal.RetryScrapeRequestResult{
+		Matched:  len(receipts),
+		Requeued: []string{},
+	}
+
+	for _, receipt := range receipts {
+		requeued, ok, err := s.repo.Requeue(ctx, receipt)
+		if err != nil {
+			return result, internal.WrapErrorf(err, internal.ErrUnknown, "repo.Requeue")
+		}
+
+		if !ok {
+			result.Skipped++
+			continue
+		}
+
+		if err := s.msgBroker.Created(ctx, requeued); err != nil {
+			// put the request back as it was, a pending request that was never published would block its target
+			if _, rerr := s.repo.Update(ctx, internal.UpdateScrapeRequestParams{
+				ID:        receipt.ID,
+				Status:    receipt.Status,
+				Retries:   receipt.Retries,
+				TotalTime: receipt.TotalTime,
+				Error:     receipt.Error,
+				Message:   receipt.Message,
+			}); rerr != nil {
+				return result, internal.WrapErrorf(rerr, internal.ErrUnknown, "repo.Update")
+			}
+
+			return result, internal.WrapErrorf(err, internal.ErrUnknown, "msgBroker.Create")
+		}
+
+		result.Requeued = append(result.Requeued, requeued.ID)
+	}
+
+	return result, nil
+}
+
 func (s *ScraperService) Delete(ctx context.Context, id string) error {
 	defer newSentrySpan(ctx, "Scraper.Delete").Finish()
 
@@ -132,3 +234,18 @@ func (s *ScraperService) Delete(ctx context.Context, id string) error {
 
 	return nil
 }
+
+func (s *ScraperService) DeleteMany(ctx context.Context, params internal.DeleteScrapeRequestParams) (int, error) {
+	defer newSentrySpan(ctx, "Scraper.DeleteMany").Finish()
+
+	if err := params.Validate(); err != nil {
+		return 0, internal.WrapErrorf(err, internal.ErrInvalidInput, "params.Validate")
+	}
+
+	count, err := s.repo.DeleteMany(ctx, params)
+	if err != nil {
+		return 0, internal.WrapErrorf(err, internal.ErrUnknown, "repo.DeleteMany")
+	}
+
+	return count, nil
+}
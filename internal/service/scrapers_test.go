@@ -0,0 +1,168 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"fourleaves.studio/manga-scraper/internal"
+	"fourleaves.studio/manga-scraper/internal/service/mock"
+	"go.uber.org/mock/gomock"
+)
+
+func TestScraperService_Retry(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock.NewMockScrapeRequestRepository(ctrl)
+	mockBroker := mock.NewMockScrapeRequestMessageBroker(ctrl)
+	mockLogger := mock.NewMockLogger(ctrl)
+	service := NewScraperService(mockRepo, mockBroker, mockLogger)
+
+	failed := []internal.ScrapeRequest{
+		{ID: "1", Type: internal.ChapterDetailRequestType, Provider: "asura", Series: "s", Chapter: "c-1", Status: internal.FailedRequestStatus},
+		{ID: "2", Type: internal.ChapterDetailRequestType, Provider: "asura", Series: "s", Chapter: "c-2", Status: internal.FailedRequestStatus},
+	}
+
+	testCases := []struct {
+		name          string
+		params        internal.RetryScrapeRequestParams
+		mockReturn    func()
+		expected      internal.RetryScrapeRequestResult
+		expectedError bool
+	}{
+		{
+			name:   "requeues and skips pending duplicates",
+			params: internal.RetryScrapeRequestParams{Provider: "asura", Status: internal.FailedRequestStatus, Limit: 10},
+			mockReturn: func() {
+				mockRepo.EXPECT().
+					FindPaginated(gomock.Any(), gomock.Any()).
+					Return(failed, nil)
+				mockRepo.EXPECT().
+					Requeue(gomock.Any(), failed[0]).
+					Return(internal.ScrapeRequest{ID: "1", Status: internal.PendingRequestStatus}, true, nil)
+				mockRepo.EXPECT().
+					Requeue(gomock.Any(), failed[1]).
+					Return(internal.ScrapeRequest{}, false, nil)
+				mockBroker.EXPECT().
+					Created(gomock.Any(), internal.ScrapeRequest{ID: "1", Status: internal.PendingRequestStatus}).
+					Return(nil)
+			},
+			expected: internal.RetryScrapeRequestResult{Matched: 2, Requeued: []string{"1"}, Skipped: 1},
+		},
+		{
+			name:          "validation failure",
+			params:        internal.RetryScrapeRequestParams{Status: internal.CompletedRequestStatus, Limit: 10},
+			mockReturn:    func() {},
+			expectedError: true,
+		},
+		{
+			name:   "repository error",
+			params: internal.RetryScrapeRequestParams{Status: internal.FailedRequestStatus, Limit: 10},
+			mockReturn: func() {
+				mockRepo.EXPECT().
+					FindPaginated(gomock.Any(), gomock.Any()).
+					Return(nil, fmt.Errorf("test error"))
+			},
+			expectedError: true,
+		},
+		{
+			name:   "publish error",
+			params: internal.RetryScrapeRequestParams{Status: internal.FailedRequestStatus, Limit: 10},
+			mockReturn: func() {
+				mockRepo.EXPECT().
+					FindPaginated(gomock.Any(), gomock.Any()).
+					Return(failed[:1], nil)
+				mockRepo.EXPECT().
+					Requeue(gomock.Any(), failed[0]).
+					Return(failed[0], true, nil)
+				mockBroker.EXPECT().
+					Created(gomock.Any(), failed[0]).
+					Return(fmt.Errorf("test error"))
+				mockRepo.EXPECT().
+					Update(gomock.Any(), internal.UpdateScrapeRequestParams{ID: "1", Status: internal.FailedRequestStatus}).
+					Return(failed[0], nil)
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockReturn()
+
+			result, err := service.Retry(context.Background(), tc.params)
+			if (err != nil) != tc.expectedError {
+				t.Errorf("expected error: %v, got: %v", tc.expectedError, err)
+			}
+
+			if !tc.expectedError && !reflect.DeepEqual(result, tc.expected) {
+				t.Errorf("expected result: %+v, got: %+v", tc.expected, result)
+			}
+		})
+	}
+}
+
+func TestScraperService_FindDeadLetters(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock.NewMockScrapeRequestRepository(ctrl)
+	mockBroker := mock.NewMockScrapeRequestMessageBroker(ctrl)
+	mockLogger := mock.NewMockLogger(ctrl)
+	service := NewScraperService(mockRepo, mockBroker, mockLogger)
+
+	deadLetters := []internal.ScrapeRequest{
+		{ID: "1", Status: internal.DeadLetterRequestStatus},
+	}
+	params := internal.FindScrapeRequestParams{Page: 1, Size: 1}
+	filtered := internal.FindScrapeRequestParams{Page: 1, Size: 1, Status: internal.DeadLetterRequestStatus}
+
+	testCases := []struct {
+		name          string
+		mockReturn    func()
+		expected      internal.ScrapeRequestPage
+		expectedError bool
+	}{
+		{
+			name: "counts every dead letter",
+			mockReturn: func() {
+				mockRepo.EXPECT().
+					FindPaginated(gomock.Any(), filtered).
+					Return(deadLetters, nil)
+				mockRepo.EXPECT().
+					Count(gomock.Any(), filtered).
+					Return(3, nil)
+			},
+			expected: internal.ScrapeRequestPage{Requests: deadLetters, Total: 3},
+		},
+		{
+			name: "count error",
+			mockReturn: func() {
+				mockRepo.EXPECT().
+					FindPaginated(gomock.Any(), filtered).
+					Return(deadLetters, nil)
+				mockRepo.EXPECT().
+					Count(gomock.Any(), filtered).
+					Return(0, fmt.Errorf("test error"))
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockReturn()
+
+			result, err := service.FindDeadLetters(context.Background(), params)
+			if (err != nil) != tc.expectedError {
+				t.Errorf("expected error: %v, got: %v", tc.expectedError, err)
+			}
+
+			if !tc.expectedError && !reflect.DeepEqual(result, tc.expected) {
+				t.Errorf("expected result: %+v, got: %+v", tc.expected, result)
+			}
+		})
+	}
+}
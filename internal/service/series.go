@@ -14,28 +14,31 @@ type SeriesRepository interface {
 	Find(ctx context.Context, params internal.FindSeriesParams) (internal.Series, error)
 	FindBC(ctx context.Context, params internal.FindSeriesParams) (internal.SeriesBC, error)
 	FindAll(ctx context.Context, params internal.FindSeriesParams) ([]internal.Series, error)
-	FindPaginated(ctx context.Context, params internal.FindSeriesParams) ([]internal.Series, error)
+	FindPaginated(ctx context.Context, params internal.FindSeriesParams) (internal.SeriesPage, error)
+	Browse(ctx context.Context, params internal.BrowseSeriesParams) (internal.SeriesPage, error)
 	UpdateInit(ctx context.Context, params internal.UpdateInitSeriesParams) (internal.Series, error)
 	UpdateLatest(ctx context.Context, params internal.UpdateLatestSeriesParams) (internal.Series, error)
+	SyncChapters(ctx context.Context, params internal.FindSeriesParams) (internal.Series, error)
 	Delete(ctx context.Context, params internal.FindSeriesParams) error
 }
 
 type SeriesSearchRepository interface {
-	Search(ctx context.Context, q string) ([]internal.Series, error)
-	Index(ctx context.Context, series internal.Series) error
-	Delete(ctx context.Context, provider, slug string) error
+	Search(ctx context.Context, params internal.SearchSeriesParams) (internal.SeriesSearchResult, error)
+	Suggest(ctx context.Context, params internal.SuggestSeriesParams) ([]internal.SeriesSuggestion, error)
 }
 
 type SeriesService struct {
 	repo   SeriesRepository
 	search SeriesSearchRepository
+	works  WorkSourceRepository
 	cb     *circuitbreaker.CircuitBreaker
 }
 
-func NewSeriesService(repo SeriesRepository, search SeriesSearchRepository, logger echo.Logger) *SeriesService {
+func NewSeriesService(repo SeriesRepository, search SeriesSearchRepository, works WorkSourceRepository, logger echo.Logger) *SeriesService {
 	return &SeriesService{
 		repo:   repo,
 		search: search,
+		works:  works,
 		cb: circuitbreaker.New(
 			circuitbreaker.WithOpenTimeout(time.Minute*2),
 			circuitbreaker.WithTripFunc(circuitbreaker.NewTripFuncConsecutiveFailures(3)),
@@ -63,55 +66,54 @@ func (s *SeriesService) CreateInit(ctx context.Context, params internal.CreateIn
 		return internal.Series{}, internal.WrapErrorf(err, internal.ErrUnknown, "repo.CreateInit")
 	}
 
-	err = s.search.Index(ctx, series)
-	if err != nil {
-		return internal.Series{}, internal.WrapErrorf(err, internal.ErrUnknown, "search.Index")
-	}
-
 	return series, nil
 }
 
-func (s *SeriesService) Search(ctx context.Context, q string) (results []internal.Series, err error) {
+func (s *SeriesService) Search(ctx context.Context, params internal.SearchSeriesParams) (result internal.SeriesSearchResult, err error) {
 	defer newSentrySpan(ctx, "SeriesService.Search").Finish()
 
+	// invalid params are checked before the circuit breaker, they say nothing about the search cluster
+	if err := params.Validate(); err != nil {
+		return internal.SeriesSearchResult{}, internal.WrapErrorf(err, internal.ErrInvalidInput, "params.Validate")
+	}
+
 	if !s.cb.Ready() {
-		return nil, internal.WrapErrorf(nil, internal.ErrUnknown, "circuit breaker is open")
+		return internal.SeriesSearchResult{}, internal.WrapErrorf(nil, internal.ErrUnknown, "circuit breaker is open")
 	}
 
 	defer func() {
 		err = s.cb.Done(ctx, err)
 	}()
 
-	results, err = s.search.Search(ctx, q)
+	result, err = s.search.Search(ctx, params)
 	if err != nil {
-		return nil, internal.WrapErrorf(err, internal.ErrUnknown, "search.Search")
+		return internal.SeriesSearchResult{}, internal.WrapErrorf(err, internal.ErrUnknown, "search.Search")
 	}
 
-	return results, nil
+	return result, nil
 }
 
-func (s *SeriesService) Index(ctx context.Context, series []internal.Series) (err error) {
-	defer newSentrySpan(ctx, "SeriesService.Index").Finish()
+func (s *SeriesService) Suggest(ctx context.Context, params internal.SuggestSeriesParams) (result []internal.SeriesSuggestion, err error) {
+	defer newSentrySpan(ctx, "SeriesService.Suggest").Finish()
+
+	if err := params.Validate(); err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrInvalidInput, "params.Validate")
+	}
 
 	if !s.cb.Ready() {
-		return internal.WrapErrorf(nil, internal.ErrUnknown, "circuit breaker is open")
+		return nil, internal.WrapErrorf(nil, internal.ErrUnknown, "circuit breaker is open")
 	}
 
 	defer func() {
 		err = s.cb.Done(ctx, err)
 	}()
 
-	var loopErr error
-
-	for i := range series {
-		err := s.search.Index(ctx, series[i])
-		if err != nil {
-			loopErr = internal.WrapErrorf(err, internal.ErrUnknown, "search.Index")
-			break
-		}
+	result, err = s.search.Suggest(ctx, params)
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrUnknown, "search.Suggest")
 	}
 
-	return loopErr
+	return result, nil
 }
 
 func (s *SeriesService) Find(ctx context.Context, params internal.FindSeriesParams) (internal.Series, error) {
@@ -122,6 +124,12 @@ func (s *SeriesService) Find(ctx context.Context, params internal.FindSeriesPara
 		return internal.Series{}, internal.WrapErrorf(err, internal.ErrUnknown, "repo.Find")
 	}
 
+	// sources are optional, an unlinked series or a failed lookup still returns the series
+	if work, err := s.works.FindBySeries(ctx, params); err == nil {
+		series.WorkID = work.ID
+		series.Sources = seriesSources(work, series.Provider, series.Slug)
+	}
+
 	return series, nil
 }
 
@@ -147,15 +155,31 @@ func (s *SeriesService) FindAll(ctx context.Context, params internal.FindSeriesP
 	return series, nil
 }
 
-func (s *SeriesService) FindPaginated(ctx context.Context, params internal.FindSeriesParams) ([]internal.Series, error) {
+func (s *SeriesService) FindPaginated(ctx context.Context, params internal.FindSeriesParams) (internal.SeriesPage, error) {
 	defer newSentrySpan(ctx, "SeriesService.FindPaginated").Finish()
 
-	series, err := s.repo.FindPaginated(ctx, params)
+	page, err := s.repo.FindPaginated(ctx, params)
 	if err != nil {
-		return nil, internal.WrapErrorf(err, internal.ErrUnknown, "repo.FindPaginated")
+		return internal.SeriesPage{}, internal.WrapErrorf(err, internal.ErrUnknown, "repo.FindPaginated")
 	}
 
-	return series, nil
+	return page, nil
+}
+
+// Browse returns a page of the series matching the filters, across every provider when no provider is given
+func (s *SeriesService) Browse(ctx context.Context, params internal.BrowseSeriesParams) (internal.SeriesPage, error) {
+	defer newSentrySpan(ctx, "SeriesService.Browse").Finish()
+
+	if err := params.Validate(); err != nil {
+		return internal.SeriesPage{}, internal.WrapErrorf(err, internal.ErrInvalidInput, "params.Validate")
+	}
+
+	page, err := s.repo.Browse(ctx, params)
+	if err != nil {
+		return internal.SeriesPage{}, internal.WrapErrorf(err, internal.ErrUnknown, "repo.Browse")
+	}
+
+	return page, nil
 }
 
 func (s *SeriesService) UpdateInit(ctx context.Context, params internal.UpdateInitSeriesParams) (internal.Series, error) {
@@ -170,11 +194,6 @@ func (s *SeriesService) UpdateInit(ctx context.Context, params internal.UpdateIn
 		return internal.Series{}, internal.WrapErrorf(err, internal.ErrUnknown, "repo.UpdateInit")
 	}
 
-	err = s.search.Index(ctx, series)
-	if err != nil {
-		return internal.Series{}, internal.WrapErrorf(err, internal.ErrUnknown, "search.Index")
-	}
-
 	return series, nil
 }
 
@@ -190,9 +209,19 @@ func (s *SeriesService) UpdateLatest(ctx context.Context, params internal.Update
 		return internal.Series{}, internal.WrapErrorf(err, internal.ErrUnknown, "repo.UpdateLatest")
 	}
 
-	err = s.search.Index(ctx, series)
+	return series, nil
+}
+
+func (s *SeriesService) SyncChapters(ctx context.Context, params internal.FindSeriesParams) (internal.Series, error) {
+	defer newSentrySpan(ctx, "SeriesService.SyncChapters").Finish()
+
+	if params.Provider == "" || params.Slug == "" {
+		return internal.Series{}, internal.NewErrorf(internal.ErrInvalidInput, "provider and slug are required")
+	}
+
+	series, err := s.repo.SyncChapters(ctx, params)
 	if err != nil {
-		return internal.Series{}, internal.WrapErrorf(err, internal.ErrUnknown, "search.Index")
+		return internal.Series{}, internal.WrapErrorf(err, internal.ErrUnknown, "repo.SyncChapters")
 	}
 
 	return series, nil
@@ -206,10 +235,5 @@ func (s *SeriesService) Delete(ctx context.Context, params internal.FindSeriesPa
 		return internal.WrapErrorf(err, internal.ErrUnknown, "repo.Delete")
 	}
 
-	err = s.search.Delete(ctx, params.Provider, params.Slug)
-	if err != nil {
-		return internal.WrapErrorf(err, internal.ErrUnknown, "search.Delete")
-	}
-
 	return nil
 }
@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"testing"
 
 	"fourleaves.studio/manga-scraper/internal"
@@ -18,7 +19,7 @@ func TestSeriesService_CreateInit(t *testing.T) {
 	mockSearch := mock.NewMockSeriesSearchRepository(ctrl)
 	mockLogger := mock.NewMockLogger(ctrl)
 
-	service := NewSeriesService(mockRepo, mockSearch, mockLogger)
+	service := NewSeriesService(mockRepo, mockSearch, nil, mockLogger)
 
 	testCases := []struct {
 		name          string
@@ -35,9 +36,6 @@ func TestSeriesService_CreateInit(t *testing.T) {
 				mockRepo.EXPECT().
 					CreateInit(gomock.Any(), gomock.Any()).
 					Return(internal.Series{Slug: "test-series", Title: "Test Series"}, nil)
-				mockSearch.EXPECT().
-					Index(gomock.Any(), gomock.Any()).
-					Return(nil)
 			},
 			expectedError: false,
 		},
@@ -61,20 +59,6 @@ func TestSeriesService_CreateInit(t *testing.T) {
 			},
 			expectedError: true,
 		},
-		{
-			name:         "search index error",
-			params:       *internal.CreateValidInitSeriesParams(),
-			modifyParams: func(params *internal.CreateInitSeriesParams) {},
-			mockReturn: func() {
-				mockRepo.EXPECT().
-					CreateInit(gomock.Any(), gomock.Any()).
-					Return(internal.Series{Slug: "test-series", Title: "Test Series"}, nil)
-				mockSearch.EXPECT().
-					Index(gomock.Any(), gomock.Any()).
-					Return(fmt.Errorf("test error"))
-			},
-			expectedError: true,
-		},
 	}
 
 	for _, tc := range testCases {
@@ -97,31 +81,37 @@ func TestSeriesService_Search(t *testing.T) {
 	mockSearch := mock.NewMockSeriesSearchRepository(ctrl)
 	mockLogger := mock.NewMockLogger(ctrl)
 
-	service := NewSeriesService(nil, mockSearch, mockLogger)
+	service := NewSeriesService(nil, mockSearch, nil, mockLogger)
 
 	testCases := []struct {
 		name          string
-		q             string
+		params        internal.SearchSeriesParams
 		mockReturn    func()
 		expectedError bool
 	}{
 		{
-			name: "successful search",
-			q:    "test query",
+			name:   "successful search",
+			params: internal.SearchSeriesParams{Query: "test query", Size: 10},
 			mockReturn: func() {
 				mockSearch.EXPECT().
 					Search(gomock.Any(), gomock.Any()).
-					Return([]internal.Series{}, nil)
+					Return(internal.SeriesSearchResult{Hits: []internal.SeriesSearchHit{}}, nil)
 			},
 			expectedError: false,
 		},
 		{
-			name: "search error",
-			q:    "test query",
+			name:          "invalid params",
+			params:        internal.SearchSeriesParams{Query: "test query"},
+			mockReturn:    func() {},
+			expectedError: true,
+		},
+		{
+			name:   "search error",
+			params: internal.SearchSeriesParams{Query: "test query", Size: 10},
 			mockReturn: func() {
 				mockSearch.EXPECT().
 					Search(gomock.Any(), gomock.Any()).
-					Return(nil, fmt.Errorf("test error"))
+					Return(internal.SeriesSearchResult{}, fmt.Errorf("test error"))
 			},
 			expectedError: true,
 		},
@@ -131,7 +121,7 @@ func TestSeriesService_Search(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			tc.mockReturn()
 
-			_, err := service.Search(context.Background(), tc.q)
+			_, err := service.Search(context.Background(), tc.params)
 			if (err != nil) != tc.expectedError {
 				t.Errorf("expected error: %v, got: %v", tc.expectedError, err)
 			}
@@ -139,45 +129,44 @@ func TestSeriesService_Search(t *testing.T) {
 	}
 }
 
-func TestSeriesService_Index(t *testing.T) {
+func TestSeriesService_Suggest(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	mockSearch := mock.NewMockSeriesSearchRepository(ctrl)
 	mockLogger := mock.NewMockLogger(ctrl)
 
-	service := NewSeriesService(nil, mockSearch, mockLogger)
+	service := NewSeriesService(nil, mockSearch, nil, mockLogger)
 
 	testCases := []struct {
 		name          string
-		series        []internal.Series
+		params        internal.SuggestSeriesParams
 		mockReturn    func()
 		expectedError bool
 	}{
 		{
-			name: "successful index",
-			series: []internal.Series{
-				{Slug: "test-series-1", Title: "Test Series 1"},
-				{Slug: "test-series-2", Title: "Test Series 2"},
-			},
+			name:   "successful suggest",
+			params: internal.SuggestSeriesParams{Query: "sol", Size: 5},
 			mockReturn: func() {
 				mockSearch.EXPECT().
-					Index(gomock.Any(), gomock.Any()).
-					Times(2).
-					Return(nil)
+					Suggest(gomock.Any(), internal.SuggestSeriesParams{Query: "sol", Size: 5}).
+					Return([]internal.SeriesSuggestion{{Provider: "asura", Slug: "solo-leveling", Title: "Solo Leveling"}}, nil)
 			},
 			expectedError: false,
 		},
 		{
-			name: "search error",
-			series: []internal.Series{
-				{Slug: "test-series-1", Title: "Test Series 1"},
-				{Slug: "test-series-2", Title: "Test Series 2"},
-			},
+			name:          "invalid params",
+			params:        internal.SuggestSeriesParams{Query: "sol"},
+			mockReturn:    func() {},
+			expectedError: true,
+		},
+		{
+			name:   "suggest error",
+			params: internal.SuggestSeriesParams{Query: "sol", Size: 5},
 			mockReturn: func() {
 				mockSearch.EXPECT().
-					Index(gomock.Any(), gomock.Any()).
-					Return(fmt.Errorf("test error"))
+					Suggest(gomock.Any(), gomock.Any()).
+					Return(nil, fmt.Errorf("test error"))
 			},
 			expectedError: true,
 		},
@@ -187,7 +176,7 @@ func TestSeriesService_Index(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			tc.mockReturn()
 
-			err := service.Index(context.Background(), tc.series)
+			_, err := service.Suggest(context.Background(), tc.params)
 			if (err != nil) != tc.expectedError {
 				t.Errorf("expected error: %v, got: %v", tc.expectedError, err)
 			}
@@ -200,25 +189,56 @@ func TestSeriesService_Find(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mock.NewMockSeriesRepository(ctrl)
+	mockWorks := mock.NewMockWorkSourceRepository(ctrl)
 	mockLogger := mock.NewMockLogger(ctrl)
 
-	service := NewSeriesService(mockRepo, nil, mockLogger)
+	service := NewSeriesService(mockRepo, nil, mockWorks, mockLogger)
 
 	testCases := []struct {
-		name          string
-		params        internal.FindSeriesParams
-		mockReturn    func()
-		expectedError bool
+		name            string
+		params          internal.FindSeriesParams
+		mockReturn      func()
+		expectedSources []internal.SeriesSource
+		expectedError   bool
 	}{
 		{
 			name: "successful find",
 			params: internal.FindSeriesParams{
-				Slug: "test-series",
+				Provider: "test-provider",
+				Slug:     "test-series",
 			},
 			mockReturn: func() {
 				mockRepo.EXPECT().
 					Find(gomock.Any(), gomock.Any()).
-					Return(internal.Series{Slug: "test-series", Title: "Test Series"}, nil)
+					Return(internal.Series{Provider: "test-provider", Slug: "test-series", Title: "Test Series"}, nil)
+				mockWorks.EXPECT().
+					FindBySeries(gomock.Any(), gomock.Any()).
+					Return(internal.Work{}, internal.NewErrorf(internal.ErrNotFound, "series is not linked to a work"))
+			},
+			expectedError: false,
+		},
+		{
+			name: "successful find with sources",
+			params: internal.FindSeriesParams{
+				Provider: "test-provider",
+				Slug:     "test-series",
+			},
+			mockReturn: func() {
+				mockRepo.EXPECT().
+					Find(gomock.Any(), gomock.Any()).
+					Return(internal.Series{Provider: "test-provider", Slug: "test-series", Title: "Test Series"}, nil)
+				mockWorks.EXPECT().
+					FindBySeries(gomock.Any(), gomock.Any()).
+					Return(internal.Work{
+						ID: "test-work",
+						Sources: []internal.SeriesSource{
+							{Provider: "other-provider", Slug: "test-series"},
+							{Provider: "test-provider", Slug: "test-series"},
+						},
+					}, nil)
+			},
+			expectedSources: []internal.SeriesSource{
+				{Provider: "other-provider", Slug: "test-series"},
 			},
 			expectedError: false,
 		},
@@ -240,10 +260,14 @@ func TestSeriesService_Find(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			tc.mockReturn()
 
-			_, err := service.Find(context.Background(), tc.params)
+			result, err := service.Find(context.Background(), tc.params)
 			if (err != nil) != tc.expectedError {
 				t.Errorf("expected error: %v, got: %v", tc.expectedError, err)
 			}
+
+			if !reflect.DeepEqual(result.Sources, tc.expectedSources) {
+				t.Errorf("expected sources: %v, got: %v", tc.expectedSources, result.Sources)
+			}
 		})
 	}
 }
@@ -255,7 +279,7 @@ func TestSeriesService_FindBC(t *testing.T) {
 	mockRepo := mock.NewMockSeriesRepository(ctrl)
 	mockLogger := mock.NewMockLogger(ctrl)
 
-	service := NewSeriesService(mockRepo, nil, mockLogger)
+	service := NewSeriesService(mockRepo, nil, nil, mockLogger)
 
 	testCases := []struct {
 		name          string
@@ -319,7 +343,7 @@ func TestSeriesService_FindAll(t *testing.T) {
 	mockRepo := mock.NewMockSeriesRepository(ctrl)
 	mockLogger := mock.NewMockLogger(ctrl)
 
-	service := NewSeriesService(mockRepo, nil, mockLogger)
+	service := NewSeriesService(mockRepo, nil, nil, mockLogger)
 
 	testCases := []struct {
 		name          string
@@ -375,7 +399,7 @@ func TestSeriesService_FindPaginated(t *testing.T) {
 	mockRepo := mock.NewMockSeriesRepository(ctrl)
 	mockLogger := mock.NewMockLogger(ctrl)
 
-	service := NewSeriesService(mockRepo, nil, mockLogger)
+	service := NewSeriesService(mockRepo, nil, nil, mockLogger)
 
 	testCases := []struct {
 		name          string
@@ -391,9 +415,13 @@ func TestSeriesService_FindPaginated(t *testing.T) {
 			mockReturn: func() {
 				mockRepo.EXPECT().
 					FindPaginated(gomock.Any(), gomock.Any()).
-					Return([]internal.Series{
-						{Slug: "test-series-1", Title: "Test Series 1"},
-						{Slug: "test-series-2", Title: "Test Series 2"},
+					Return(internal.SeriesPage{
+						Series: []internal.Series{
+							{Slug: "test-series-1", Title: "Test Series 1"},
+							{Slug: "test-series-2", Title: "Test Series 2"},
+						},
+						NextCursor: internal.Cursor{Slug: "test-series-2"}.Encode(),
+						Total:      3,
 					}, nil)
 			},
 			expectedError: false,
@@ -406,7 +434,7 @@ func TestSeriesService_FindPaginated(t *testing.T) {
 			mockReturn: func() {
 				mockRepo.EXPECT().
 					FindPaginated(gomock.Any(), gomock.Any()).
-					Return(nil, fmt.Errorf("test error"))
+					Return(internal.SeriesPage{}, fmt.Errorf("test error"))
 			},
 			expectedError: true,
 		},
@@ -424,6 +452,75 @@ func TestSeriesService_FindPaginated(t *testing.T) {
 	}
 }
 
+func TestSeriesService_Browse(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock.NewMockSeriesRepository(ctrl)
+	mockLogger := mock.NewMockLogger(ctrl)
+
+	service := NewSeriesService(mockRepo, nil, nil, mockLogger)
+
+	testCases := []struct {
+		name          string
+		params        internal.BrowseSeriesParams
+		mockReturn    func()
+		expectedError bool
+	}{
+		{
+			name: "successful browse",
+			params: internal.BrowseSeriesParams{
+				Genres: []string{"action"},
+				Sort:   internal.LatestChapterSeriesSort,
+				Order:  internal.DESC,
+				Size:   10,
+			},
+			mockReturn: func() {
+				mockRepo.EXPECT().
+					Browse(gomock.Any(), gomock.Any()).
+					Return(internal.SeriesPage{
+						Series: []internal.Series{{Provider: "test-provider", Slug: "test-series-1"}},
+						Total:  1,
+					}, nil)
+			},
+			expectedError: false,
+		},
+		{
+			name: "invalid sort",
+			params: internal.BrowseSeriesParams{
+				Sort: "views",
+				Size: 10,
+			},
+			mockReturn:    func() {},
+			expectedError: true,
+		},
+		{
+			name: "repository browse error",
+			params: internal.BrowseSeriesParams{
+				Status: internal.OngoingSeriesStatus,
+				Size:   10,
+			},
+			mockReturn: func() {
+				mockRepo.EXPECT().
+					Browse(gomock.Any(), gomock.Any()).
+					Return(internal.SeriesPage{}, fmt.Errorf("test error"))
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockReturn()
+
+			_, err := service.Browse(context.Background(), tc.params)
+			if (err != nil) != tc.expectedError {
+				t.Errorf("expected error: %v, got: %v", tc.expectedError, err)
+			}
+		})
+	}
+}
+
 func TestSeriesService_UpdateInit(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -432,7 +529,7 @@ func TestSeriesService_UpdateInit(t *testing.T) {
 	mockSearch := mock.NewMockSeriesSearchRepository(ctrl)
 	mockLogger := mock.NewMockLogger(ctrl)
 
-	service := NewSeriesService(mockRepo, mockSearch, mockLogger)
+	service := NewSeriesService(mockRepo, mockSearch, nil, mockLogger)
 
 	testCases := []struct {
 		name          string
@@ -449,9 +546,6 @@ func TestSeriesService_UpdateInit(t *testing.T) {
 				mockRepo.EXPECT().
 					UpdateInit(gomock.Any(), gomock.Any()).
 					Return(internal.Series{Slug: "test-series", Title: "Test Series"}, nil)
-				mockSearch.EXPECT().
-					Index(gomock.Any(), gomock.Any()).
-					Return(nil)
 			},
 			expectedError: false,
 		},
@@ -475,20 +569,6 @@ func TestSeriesService_UpdateInit(t *testing.T) {
 			},
 			expectedError: true,
 		},
-		{
-			name:         "search index error",
-			params:       *internal.UpdateValidInitSeriesParams(),
-			modifyParams: func(params *internal.UpdateInitSeriesParams) {},
-			mockReturn: func() {
-				mockRepo.EXPECT().
-					UpdateInit(gomock.Any(), gomock.Any()).
-					Return(internal.Series{Slug: "test-series", Title: "Test Series"}, nil)
-				mockSearch.EXPECT().
-					Index(gomock.Any(), gomock.Any()).
-					Return(fmt.Errorf("test error"))
-			},
-			expectedError: true,
-		},
 	}
 
 	for _, tc := range testCases {
@@ -512,7 +592,7 @@ func TestSeriesService_UpdateLatest(t *testing.T) {
 	mockSearch := mock.NewMockSeriesSearchRepository(ctrl)
 	mockLogger := mock.NewMockLogger(ctrl)
 
-	service := NewSeriesService(mockRepo, mockSearch, mockLogger)
+	service := NewSeriesService(mockRepo, mockSearch, nil, mockLogger)
 
 	testCases := []struct {
 		name          string
@@ -529,9 +609,6 @@ func TestSeriesService_UpdateLatest(t *testing.T) {
 				mockRepo.EXPECT().
 					UpdateLatest(gomock.Any(), gomock.Any()).
 					Return(internal.Series{Slug: "test-series", Title: "Test Series"}, nil)
-				mockSearch.EXPECT().
-					Index(gomock.Any(), gomock.Any()).
-					Return(nil)
 			},
 			expectedError: false,
 		},
@@ -555,17 +632,62 @@ func TestSeriesService_UpdateLatest(t *testing.T) {
 			},
 			expectedError: true,
 		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.modifyParams(&tc.params)
+			tc.mockReturn()
+
+			_, err := service.UpdateLatest(context.Background(), tc.params)
+			if (err != nil) != tc.expectedError {
+				t.Errorf("expected error: %v, got: %v", tc.expectedError, err)
+			}
+		})
+	}
+}
+
+func TestSeriesService_SyncChapters(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock.NewMockSeriesRepository(ctrl)
+	mockSearch := mock.NewMockSeriesSearchRepository(ctrl)
+	mockLogger := mock.NewMockLogger(ctrl)
+
+	service := NewSeriesService(mockRepo, mockSearch, nil, mockLogger)
+
+	synced := internal.Series{Provider: "provider", Slug: "slug", ChaptersCount: 120, LatestChapter: "slug-chapter-120"}
+
+	testCases := []struct {
+		name          string
+		params        internal.FindSeriesParams
+		mockReturn    func()
+		expectedError bool
+	}{
+		{
+			name:   "successful sync",
+			params: internal.FindSeriesParams{Provider: "provider", Slug: "slug"},
+			mockReturn: func() {
+				mockRepo.EXPECT().
+					SyncChapters(gomock.Any(), internal.FindSeriesParams{Provider: "provider", Slug: "slug"}).
+					Return(synced, nil)
+			},
+			expectedError: false,
+		},
+		{
+			name:          "validation failure",
+			params:        internal.FindSeriesParams{Provider: "provider"},
+			mockReturn:    func() {},
+			expectedError: true,
+		},
 		{
-			name:         "search index error",
-			params:       *internal.UpdateValidLatestSeriesParams(),
-			modifyParams: func(params *internal.UpdateLatestSeriesParams) {},
+			name:   "repository error",
+			params: internal.FindSeriesParams{Provider: "provider", Slug: "slug"},
 			mockReturn: func() {
 				mockRepo.EXPECT().
-					UpdateLatest(gomock.Any(), gomock.Any()).
-					Return(internal.Series{Slug: "test-series", Title: "Test Series"}, nil)
-				mockSearch.EXPECT().
-					Index(gomock.Any(), gomock.Any()).
-					Return(fmt.Errorf("test error"))
+					SyncChapters(gomock.Any(), gomock.Any()).
+					Return(internal.Series{}, fmt.Errorf("test error"))
 			},
 			expectedError: true,
 		},
@@ -573,13 +695,16 @@ func TestSeriesService_UpdateLatest(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			tc.modifyParams(&tc.params)
 			tc.mockReturn()
 
-			_, err := service.UpdateLatest(context.Background(), tc.params)
+			result, err := service.SyncChapters(context.Background(), tc.params)
 			if (err != nil) != tc.expectedError {
 				t.Errorf("expected error: %v, got: %v", tc.expectedError, err)
 			}
+
+			if !tc.expectedError && !reflect.DeepEqual(result, synced) {
+				t.Errorf("expected result: %+v, got: %+v", synced, result)
+			}
 		})
 	}
 }
@@ -592,7 +717,7 @@ func TestSeriesService_Delete(t *testing.T) {
 	mockSearch := mock.NewMockSeriesSearchRepository(ctrl)
 	mockLogger := mock.NewMockLogger(ctrl)
 
-	service := NewSeriesService(mockRepo, mockSearch, mockLogger)
+	service := NewSeriesService(mockRepo, mockSearch, nil, mockLogger)
 
 	testCases := []struct {
 		name          string
@@ -610,9 +735,6 @@ func TestSeriesService_Delete(t *testing.T) {
 				mockRepo.EXPECT().
 					Delete(gomock.Any(), gomock.Any()).
 					Return(nil)
-				mockSearch.EXPECT().
-					Delete(gomock.Any(), gomock.Any(), gomock.Any()).
-					Return(nil)
 			},
 			expectedError: false,
 		},
@@ -629,22 +751,6 @@ func TestSeriesService_Delete(t *testing.T) {
 			},
 			expectedError: true,
 		},
-		{
-			name: "search delete error",
-			params: internal.FindSeriesParams{
-				Provider: "test-provider",
-				Slug:     "test-series",
-			},
-			mockReturn: func() {
-				mockRepo.EXPECT().
-					Delete(gomock.Any(), gomock.Any()).
-					Return(nil)
-				mockSearch.EXPECT().
-					Delete(gomock.Any(), gomock.Any(), gomock.Any()).
-					Return(fmt.Errorf("test error"))
-			},
-			expectedError: true,
-		},
 	}
 
 	for _, tc := range testCases {
@@ -0,0 +1,135 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+
+	"fourleaves.studio/manga-scraper/internal"
+)
+
+type WebhookRepository interface {
+	CreateSubscription(ctx context.Context, params internal.WebhookSubscriptionParams) (internal.WebhookSubscription, error)
+	FindSubscription(ctx context.Context, id string) (internal.WebhookSubscription, error)
+	FindSubscriptions(ctx context.Context) ([]internal.WebhookSubscription, error)
+	UpdateSubscription(ctx context.Context, params internal.WebhookSubscriptionParams) (internal.WebhookSubscription, error)
+	DeleteSubscription(ctx context.Context, id string) error
+	FindDeliveries(ctx context.Context, params internal.FindWebhookDeliveriesParams) ([]internal.WebhookDelivery, error)
+}
+
+type WebhookService struct {
+	repo WebhookRepository
+}
+
+func NewWebhookService(repo WebhookRepository) *WebhookService {
+	return &WebhookService{
+		repo: repo,
+	}
+}
+
+// Create stores the subscription and returns it with its signing secret, the only time the secret is shown
+// A secret is generated when none is given
+func (s *WebhookService) Create(ctx context.Context, params internal.WebhookSubscriptionParams) (internal.WebhookSubscription, error) {
+	defer newSentrySpan(ctx, "WebhookService.Create").Finish()
+
+	if err := params.Validate(); err != nil {
+		return internal.WebhookSubscription{}, internal.WrapErrorf(err, internal.ErrInvalidInput, "params.Validate")
+	}
+
+	if params.Secret == "" {
+		secret, err := newWebhookSecret()
+		if err != nil {
+			return internal.WebhookSubscription{}, internal.WrapErrorf(err, internal.ErrUnknown, "newWebhookSecret")
+		}
+
+		params.Secret = secret
+	}
+
+	subscription, err := s.repo.CreateSubscription(ctx, params)
+	if err != nil {
+		return internal.WebhookSubscription{}, internal.WrapErrorf(err, internal.ErrUnknown, "repo.CreateSubscription")
+	}
+
+	return subscription, nil
+}
+
+func (s *WebhookService) Find(ctx context.Context, id string) (internal.WebhookSubscription, error) {
+	defer newSentrySpan(ctx, "WebhookService.Find").Finish()
+
+	subscription, err := s.repo.FindSubscription(ctx, id)
+	if err != nil {
+		return internal.WebhookSubscription{}, internal.WrapErrorf(err, internal.ErrUnknown, "repo.FindSubscription")
+	}
+
+	subscription.Secret = ""
+
+	return subscription, nil
+}
+
+func (s *WebhookService) FindAll(ctx context.Context) ([]internal.WebhookSubscription, error) {
+	defer newSentrySpan(ctx, "WebhookService.FindAll").Finish()
+
+	subscriptions, err := s.repo.FindSubscriptions(ctx)
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrUnknown, "repo.FindSubscriptions")
+	}
+
+	for i := range subscriptions {
+		subscriptions[i].Secret = ""
+	}
+
+	return subscriptions, nil
+}
+
+// Update replaces the subscription, the secret is only changed when a new one is given
+func (s *WebhookService) Update(ctx context.Context, params internal.WebhookSubscriptionParams) (internal.WebhookSubscription, error) {
+	defer newSentrySpan(ctx, "WebhookService.Update").Finish()
+
+	if err := params.Validate(); err != nil {
+		return internal.WebhookSubscription{}, internal.WrapErrorf(err, internal.ErrInvalidInput, "params.Validate")
+	}
+
+	subscription, err := s.repo.UpdateSubscription(ctx, params)
+	if err != nil {
+		return internal.WebhookSubscription{}, internal.WrapErrorf(err, internal.ErrUnknown, "repo.UpdateSubscription")
+	}
+
+	subscription.Secret = ""
+
+	return subscription, nil
+}
+
+func (s *WebhookService) Delete(ctx context.Context, id string) error {
+	defer newSentrySpan(ctx, "WebhookService.Delete").Finish()
+
+	if err := s.repo.DeleteSubscription(ctx, id); err != nil {
+		return internal.WrapErrorf(err, internal.ErrUnknown, "repo.DeleteSubscription")
+	}
+
+	return nil
+}
+
+func (s *WebhookService) FindDeliveries(ctx context.Context, params internal.FindWebhookDeliveriesParams) ([]internal.WebhookDelivery, error) {
+	defer newSentrySpan(ctx, "WebhookService.FindDeliveries").Finish()
+
+	if err := params.Validate(); err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrInvalidInput, "params.Validate")
+	}
+
+	deliveries, err := s.repo.FindDeliveries(ctx, params)
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrUnknown, "repo.FindDeliveries")
+	}
+
+	return deliveries, nil
+}
+
+func newWebhookSecret() (string, error) {
+	b := make([]byte, 32)
+
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(b), nil
+}
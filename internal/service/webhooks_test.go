@@ -0,0 +1,105 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"fourleaves.studio/manga-scraper/internal"
+	"fourleaves.studio/manga-scraper/internal/service/mock"
+	"go.uber.org/mock/gomock"
+)
+
+func TestWebhookService_Create(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock.NewMockWebhookRepository(ctrl)
+	service := NewWebhookService(mockRepo)
+
+	testCases := []struct {
+		name          string
+		params        internal.WebhookSubscriptionParams
+		mockReturn    func()
+		expectedError bool
+	}{
+		{
+			name:   "generates a secret",
+			params: internal.WebhookSubscriptionParams{URL: "https://example.com/hooks"},
+			mockReturn: func() {
+				mockRepo.EXPECT().
+					CreateSubscription(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, params internal.WebhookSubscriptionParams) (internal.WebhookSubscription, error) {
+						if len(params.Secret) != 64 {
+							t.Errorf("expected a 64 character secret, got %q", params.Secret)
+						}
+
+						return internal.WebhookSubscription{ID: "1", URL: params.URL, Secret: params.Secret}, nil
+					})
+			},
+		},
+		{
+			name:   "keeps the given secret",
+			params: internal.WebhookSubscriptionParams{URL: "https://example.com/hooks", Secret: "secret"},
+			mockReturn: func() {
+				mockRepo.EXPECT().
+					CreateSubscription(gomock.Any(), internal.WebhookSubscriptionParams{URL: "https://example.com/hooks", Secret: "secret"}).
+					Return(internal.WebhookSubscription{ID: "1", Secret: "secret"}, nil)
+			},
+		},
+		{
+			name:          "validation failure",
+			params:        internal.WebhookSubscriptionParams{URL: "example.com"},
+			mockReturn:    func() {},
+			expectedError: true,
+		},
+		{
+			name:   "repository error",
+			params: internal.WebhookSubscriptionParams{URL: "https://example.com/hooks"},
+			mockReturn: func() {
+				mockRepo.EXPECT().
+					CreateSubscription(gomock.Any(), gomock.Any()).
+					Return(internal.WebhookSubscription{}, fmt.Errorf("test error"))
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockReturn()
+
+			result, err := service.Create(context.Background(), tc.params)
+			if (err != nil) != tc.expectedError {
+				t.Errorf("expected error: %v, got: %v", tc.expectedError, err)
+			}
+
+			if !tc.expectedError && result.Secret == "" {
+				t.Errorf("expected the secret to be returned on create")
+			}
+		})
+	}
+}
+
+func TestWebhookService_FindAll(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock.NewMockWebhookRepository(ctrl)
+	service := NewWebhookService(mockRepo)
+
+	mockRepo.EXPECT().
+		FindSubscriptions(gomock.Any()).
+		Return([]internal.WebhookSubscription{{ID: "1", Secret: "secret"}, {ID: "2", Secret: "secret"}}, nil)
+
+	result, err := service.FindAll(context.Background())
+	if err != nil {
+		t.Fatalf("did not expect an error but got one: %v", err)
+	}
+
+	for _, subscription := range result {
+		if subscription.Secret != "" {
+			t.Errorf("expected the secret of %s to be hidden", subscription.ID)
+		}
+	}
+}
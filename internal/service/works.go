@@ -0,0 +1,220 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"fourleaves.studio/manga-scraper/internal"
+)
+
+// WorkSourceRepository finds the sibling sources of a series or chapter from other providers
+type WorkSourceRepository interface {
+	FindBySeries(ctx context.Context, params internal.FindSeriesParams) (internal.Work, error)
+	FindChapterSources(ctx context.Context, chapter internal.Chapter) ([]internal.ChapterSource, error)
+}
+
+type WorkRepository interface {
+	Find(ctx context.Context, id string) (internal.Work, error)
+	Update(ctx context.Context, params internal.UpdateWorkParams) (internal.Work, error)
+	Link(ctx context.Context, params internal.WorkSeriesParams) (internal.Work, error)
+	Split(ctx context.Context, params internal.WorkSeriesParams) (internal.Work, error)
+	Merge(ctx context.Context, params internal.MergeWorksParams) (internal.Work, error)
+	FindCandidate(ctx context.Context, id string) (internal.WorkCandidate, error)
+	FindCandidates(ctx context.Context, params internal.FindWorkCandidatesParams) ([]internal.WorkCandidate, error)
+	RejectCandidate(ctx context.Context, id string) (internal.WorkCandidate, error)
+}
+
+type WorkChapterRepository interface {
+	FindAll(ctx context.Context, params internal.FindChapterParams) ([]internal.Chapter, error)
+}
+
+type WorkService struct {
+	repo     WorkRepository
+	chapters WorkChapterRepository
+	priority []string
+}
+
+// NewWorkService creates the service, priority lists the preferred providers of the merged chapter list first
+func NewWorkService(repo WorkRepository, chapters WorkChapterRepository, priority []string) *WorkService {
+	return &WorkService{
+		repo:     repo,
+		chapters: chapters,
+		priority: priority,
+	}
+}
+
+func (s *WorkService) Find(ctx context.Context, id string) (internal.Work, error) {
+	defer newSentrySpan(ctx, "WorkService.Find").Finish()
+
+	work, err := s.repo.Find(ctx, id)
+	if err != nil {
+		return internal.Work{}, internal.WrapErrorf(err, internal.ErrUnknown, "repo.Find")
+	}
+
+	return work, nil
+}
+
+// FindChapters returns the chapters of every source of the work, the chapters of different providers merged by number,
+// a source without chapters is skipped
+func (s *WorkService) FindChapters(ctx context.Context, params internal.FindWorkChaptersParams) ([]internal.Chapter, error) {
+	defer newSentrySpan(ctx, "WorkService.FindChapters").Finish()
+
+	if err := params.Validate(); err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrInvalidInput, "params.Validate")
+	}
+
+	work, err := s.repo.Find(ctx, params.ID)
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrUnknown, "repo.Find")
+	}
+
+	lists := make([][]internal.Chapter, 0, len(work.Sources))
+
+	for _, source := range work.Sources {
+		chapters, err := s.chapters.FindAll(ctx, internal.FindChapterParams{
+			Provider: source.Provider,
+			Series:   source.Slug,
+			Order:    params.Order,
+		})
+		if err != nil {
+			if isNotFound(err) {
+				continue
+			}
+
+			return nil, internal.WrapErrorf(err, internal.ErrUnknown, "chapters.FindAll")
+		}
+
+		lists = append(lists, chapters)
+	}
+
+	chapters := internal.MergeWorkChapters(lists, s.priority, params.Order)
+	if len(chapters) == 0 {
+		return nil, internal.NewErrorf(internal.ErrNotFound, "no chapters found")
+	}
+
+	return chapters, nil
+}
+
+func (s *WorkService) Update(ctx context.Context, params internal.UpdateWorkParams) (internal.Work, error) {
+	defer newSentrySpan(ctx, "WorkService.Update").Finish()
+
+	if err := params.Validate(); err != nil {
+		return internal.Work{}, internal.WrapErrorf(err, internal.ErrInvalidInput, "params.Validate")
+	}
+
+	work, err := s.repo.Update(ctx, params)
+	if err != nil {
+		return internal.Work{}, internal.WrapErrorf(err, internal.ErrUnknown, "repo.Update")
+	}
+
+	return work, nil
+}
+
+// Split moves the series out of the work into a work of its own, the linker will not suggest it for the old work again
+func (s *WorkService) Split(ctx context.Context, params internal.WorkSeriesParams) (internal.Work, error) {
+	defer newSentrySpan(ctx, "WorkService.Split").Finish()
+
+	if err := params.Validate(); err != nil {
+		return internal.Work{}, internal.WrapErrorf(err, internal.ErrInvalidInput, "params.Validate")
+	}
+
+	work, err := s.repo.Split(ctx, params)
+	if err != nil {
+		return internal.Work{}, internal.WrapErrorf(err, internal.ErrUnknown, "repo.Split")
+	}
+
+	return work, nil
+}
+
+// Merge moves every series of the source work into the target work and deletes the source work
+func (s *WorkService) Merge(ctx context.Context, params internal.MergeWorksParams) (internal.Work, error) {
+	defer newSentrySpan(ctx, "WorkService.Merge").Finish()
+
+	if err := params.Validate(); err != nil {
+		return internal.Work{}, internal.WrapErrorf(err, internal.ErrInvalidInput, "params.Validate")
+	}
+
+	work, err := s.repo.Merge(ctx, params)
+	if err != nil {
+		return internal.Work{}, internal.WrapErrorf(err, internal.ErrUnknown, "repo.Merge")
+	}
+
+	return work, nil
+}
+
+func (s *WorkService) FindCandidates(ctx context.Context, params internal.FindWorkCandidatesParams) ([]internal.WorkCandidate, error) {
+	defer newSentrySpan(ctx, "WorkService.FindCandidates").Finish()
+
+	if err := params.Validate(); err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrInvalidInput, "params.Validate")
+	}
+
+	candidates, err := s.repo.FindCandidates(ctx, params)
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrUnknown, "repo.FindCandidates")
+	}
+
+	return candidates, nil
+}
+
+// ConfirmCandidate links the candidate series into the candidate work
+func (s *WorkService) ConfirmCandidate(ctx context.Context, id string) (internal.Work, error) {
+	defer newSentrySpan(ctx, "WorkService.ConfirmCandidate").Finish()
+
+	candidate, err := s.repo.FindCandidate(ctx, id)
+	if err != nil {
+		return internal.Work{}, internal.WrapErrorf(err, internal.ErrUnknown, "repo.FindCandidate")
+	}
+
+	work, err := s.repo.Link(ctx, internal.WorkSeriesParams{
+		WorkID:   candidate.WorkID,
+		Provider: candidate.Provider,
+		Series:   candidate.Series,
+	})
+	if err != nil {
+		return internal.Work{}, internal.WrapErrorf(err, internal.ErrUnknown, "repo.Link")
+	}
+
+	return work, nil
+}
+
+func (s *WorkService) RejectCandidate(ctx context.Context, id string) (internal.WorkCandidate, error) {
+	defer newSentrySpan(ctx, "WorkService.RejectCandidate").Finish()
+
+	candidate, err := s.repo.RejectCandidate(ctx, id)
+	if err != nil {
+		return internal.WorkCandidate{}, internal.WrapErrorf(err, internal.ErrUnknown, "repo.RejectCandidate")
+	}
+
+	return candidate, nil
+}
+
+// seriesSources returns the sources of the work the series belongs to, without the series itself
+func seriesSources(work internal.Work, provider, slug string) []internal.SeriesSource {
+	sources := make([]internal.SeriesSource, 0, len(work.Sources))
+
+	for _, source := range work.Sources {
+		if source.Provider == provider && source.Slug == slug {
+			continue
+		}
+
+		sources = append(sources, source)
+	}
+
+	return sources
+}
+
+// isNotFound reports whether the innermost internal error of the chain is ErrNotFound,
+// caches wrap the not found errors of their stores with ErrUnknown
+func isNotFound(err error) bool {
+	var ierr *internal.Error
+
+	found := false
+
+	for errors.As(err, &ierr) {
+		found = ierr.Code() == internal.ErrNotFound
+		err = ierr.Unwrap()
+	}
+
+	return found
+}
@@ -0,0 +1,259 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"fourleaves.studio/manga-scraper/internal"
+	"fourleaves.studio/manga-scraper/internal/service/mock"
+	"go.uber.org/mock/gomock"
+)
+
+func TestWorkService_Merge(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock.NewMockWorkRepository(ctrl)
+	service := NewWorkService(mockRepo, nil, nil)
+
+	testCases := []struct {
+		name          string
+		params        internal.MergeWorksParams
+		mockReturn    func()
+		expectedError bool
+	}{
+		{
+			name:   "successful merge",
+			params: internal.MergeWorksParams{TargetID: "1", SourceID: "2"},
+			mockReturn: func() {
+				mockRepo.EXPECT().
+					Merge(gomock.Any(), internal.MergeWorksParams{TargetID: "1", SourceID: "2"}).
+					Return(internal.Work{ID: "1"}, nil)
+			},
+		},
+		{
+			name:          "merge into itself",
+			params:        internal.MergeWorksParams{TargetID: "1", SourceID: "1"},
+			mockReturn:    func() {},
+			expectedError: true,
+		},
+		{
+			name:   "repository error",
+			params: internal.MergeWorksParams{TargetID: "1", SourceID: "2"},
+			mockReturn: func() {
+				mockRepo.EXPECT().
+					Merge(gomock.Any(), gomock.Any()).
+					Return(internal.Work{}, fmt.Errorf("test error"))
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockReturn()
+
+			_, err := service.Merge(context.Background(), tc.params)
+			if (err != nil) != tc.expectedError {
+				t.Errorf("expected error: %v, got: %v", tc.expectedError, err)
+			}
+		})
+	}
+}
+
+func TestWorkService_ConfirmCandidate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock.NewMockWorkRepository(ctrl)
+	service := NewWorkService(mockRepo, nil, nil)
+
+	testCases := []struct {
+		name          string
+		mockReturn    func()
+		expectedError bool
+	}{
+		{
+			name: "links the candidate series",
+			mockReturn: func() {
+				mockRepo.EXPECT().
+					FindCandidate(gomock.Any(), "1").
+					Return(internal.WorkCandidate{ID: "1", WorkID: "2", Provider: "test-provider", Series: "test-series"}, nil)
+				mockRepo.EXPECT().
+					Link(gomock.Any(), internal.WorkSeriesParams{WorkID: "2", Provider: "test-provider", Series: "test-series"}).
+					Return(internal.Work{ID: "2"}, nil)
+			},
+		},
+		{
+			name: "candidate not found",
+			mockReturn: func() {
+				mockRepo.EXPECT().
+					FindCandidate(gomock.Any(), "1").
+					Return(internal.WorkCandidate{}, internal.NewErrorf(internal.ErrNotFound, "candidate not found"))
+			},
+			expectedError: true,
+		},
+		{
+			name: "link error",
+			mockReturn: func() {
+				mockRepo.EXPECT().
+					FindCandidate(gomock.Any(), "1").
+					Return(internal.WorkCandidate{ID: "1", WorkID: "2", Provider: "test-provider", Series: "test-series"}, nil)
+				mockRepo.EXPECT().
+					Link(gomock.Any(), gomock.Any()).
+					Return(internal.Work{}, fmt.Errorf("test error"))
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockReturn()
+
+			_, err := service.ConfirmCandidate(context.Background(), "1")
+			if (err != nil) != tc.expectedError {
+				t.Errorf("expected error: %v, got: %v", tc.expectedError, err)
+			}
+		})
+	}
+}
+
+func TestWorkService_FindCandidates(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock.NewMockWorkRepository(ctrl)
+	service := NewWorkService(mockRepo, nil, nil)
+
+	testCases := []struct {
+		name          string
+		params        internal.FindWorkCandidatesParams
+		mockReturn    func()
+		expectedError bool
+	}{
+		{
+			name:   "successful find",
+			params: internal.FindWorkCandidatesParams{Status: internal.PendingCandidateStatus, Page: 1, Size: 10},
+			mockReturn: func() {
+				mockRepo.EXPECT().
+					FindCandidates(gomock.Any(), gomock.Any()).
+					Return([]internal.WorkCandidate{{ID: "1"}}, nil)
+			},
+		},
+		{
+			name:          "invalid status",
+			params:        internal.FindWorkCandidatesParams{Status: "CONFIRMED", Page: 1, Size: 10},
+			mockReturn:    func() {},
+			expectedError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockReturn()
+
+			_, err := service.FindCandidates(context.Background(), tc.params)
+			if (err != nil) != tc.expectedError {
+				t.Errorf("expected error: %v, got: %v", tc.expectedError, err)
+			}
+		})
+	}
+}
+
+func TestWorkService_FindChapters(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock.NewMockWorkRepository(ctrl)
+	mockChapters := mock.NewMockWorkChapterRepository(ctrl)
+	service := NewWorkService(mockRepo, mockChapters, []string{"flame"})
+
+	work := internal.Work{
+		ID: "1",
+		Sources: []internal.SeriesSource{
+			{Provider: "asura", Slug: "solo"},
+			{Provider: "flame", Slug: "solo-leveling"},
+		},
+	}
+
+	testCases := []struct {
+		name          string
+		mockReturn    func()
+		expected      []string
+		expectedError bool
+	}{
+		{
+			name: "merges sources by priority",
+			mockReturn: func() {
+				mockRepo.EXPECT().Find(gomock.Any(), "1").Return(work, nil)
+				mockChapters.EXPECT().
+					FindAll(gomock.Any(), internal.FindChapterParams{Provider: "asura", Series: "solo", Order: internal.ASC}).
+					Return([]internal.Chapter{
+						{Provider: "asura", Slug: "a-1", Number: 1, ContentURLs: []string{"1.jpg"}},
+						{Provider: "asura", Slug: "a-2", Number: 2, ContentURLs: []string{"2.jpg"}},
+					}, nil)
+				mockChapters.EXPECT().
+					FindAll(gomock.Any(), internal.FindChapterParams{Provider: "flame", Series: "solo-leveling", Order: internal.ASC}).
+					Return([]internal.Chapter{
+						{Provider: "flame", Slug: "f-1", Number: 1, ContentURLs: []string{"1.jpg"}},
+					}, nil)
+			},
+			expected: []string{"f-1", "a-2"},
+		},
+		{
+			name: "skips sources without chapters",
+			mockReturn: func() {
+				mockRepo.EXPECT().Find(gomock.Any(), "1").Return(work, nil)
+				mockChapters.EXPECT().
+					FindAll(gomock.Any(), gomock.Any()).
+					Return([]internal.Chapter{{Provider: "asura", Slug: "a-1", Number: 1}}, nil)
+				mockChapters.EXPECT().
+					FindAll(gomock.Any(), gomock.Any()).
+					Return(nil, internal.WrapErrorf(internal.NewErrorf(internal.ErrNotFound, "no chapters found"), internal.ErrUnknown, "store.FindAll"))
+			},
+			expected: []string{"a-1"},
+		},
+		{
+			name: "chapters error",
+			mockReturn: func() {
+				mockRepo.EXPECT().Find(gomock.Any(), "1").Return(work, nil)
+				mockChapters.EXPECT().
+					FindAll(gomock.Any(), gomock.Any()).
+					Return(nil, fmt.Errorf("test error"))
+			},
+			expectedError: true,
+		},
+		{
+			name: "work not found",
+			mockReturn: func() {
+				mockRepo.EXPECT().
+					Find(gomock.Any(), "1").
+					Return(internal.Work{}, internal.NewErrorf(internal.ErrNotFound, "work not found"))
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockReturn()
+
+			result, err := service.FindChapters(context.Background(), internal.FindWorkChaptersParams{ID: "1", Order: internal.ASC})
+			if (err != nil) != tc.expectedError {
+				t.Fatalf("expected error: %v, got: %v", tc.expectedError, err)
+			}
+
+			slugs := make([]string, 0, len(result))
+			for _, chapter := range result {
+				slugs = append(slugs, chapter.Slug)
+			}
+
+			if !tc.expectedError && !reflect.DeepEqual(slugs, tc.expected) {
+				t.Errorf("expected chapters: %v, got: %v", tc.expected, slugs)
+			}
+		})
+	}
+}
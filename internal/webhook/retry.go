@@ -0,0 +1,30 @@
+package webhook
+
+import (
+	"time"
+
+	"fourleaves.studio/manga-scraper/internal"
+	"fourleaves.studio/manga-scraper/internal/backoff"
+)
+
+// RetryPolicy controls how often a failed delivery is attempted again
+// MaxAttempts includes the first attempt, so 1 disables retries
+type RetryPolicy struct {
+	MaxAttempts int
+	BaseDelay   time.Duration
+	MaxDelay    time.Duration
+}
+
+// Backoff returns the delay before the given retry attempt, doubling from BaseDelay up to MaxDelay
+func (p RetryPolicy) Backoff(attempt int) time.Duration {
+	return backoff.Exponential(p.BaseDelay, p.MaxDelay, attempt)
+}
+
+// next returns the state of a delivery after its attempts-th attempt failed
+func (p RetryPolicy) next(attempts int, now time.Time) (internal.WebhookDeliveryStatus, time.Time) {
+	if attempts >= p.MaxAttempts {
+		return internal.FailedDeliveryStatus, now
+	}
+
+	return internal.PendingDeliveryStatus, now.Add(p.Backoff(attempts))
+}
@@ -0,0 +1,70 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	SignatureHeader = "X-Webhook-Signature"
+	EventHeader     = "X-Webhook-Event"
+	DeliveryHeader  = "X-Webhook-Delivery"
+)
+
+// Sign returns the hex HMAC-SHA256 of "<timestamp>.<body>" keyed with the subscription secret
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10))) // nolint:errcheck
+	mac.Write([]byte("."))                              // nolint:errcheck
+	mac.Write(body)                                     // nolint:errcheck
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// SignatureValue formats the signature header as "t=<unix seconds>,v1=<signature>",
+// receivers should reject timestamps too far in the past to prevent replays
+func SignatureValue(secret string, at time.Time, body []byte) string {
+	return fmt.Sprintf("t=%d,v1=%s", at.Unix(), Sign(secret, at.Unix(), body))
+}
+
+// Verify checks a signature header against the body, it is the receiver side of SignatureValue
+func Verify(secret, header string, body []byte, tolerance time.Duration, now time.Time) bool {
+	var (
+		timestamp int64
+		signature string
+	)
+
+	for _, part := range strings.Split(header, ",") {
+		key, value, ok := strings.Cut(part, "=")
+		if !ok {
+			continue
+		}
+
+		switch key {
+		case "t":
+			t, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return false
+			}
+
+			timestamp = t
+		case "v1":
+			signature = value
+		}
+	}
+
+	if timestamp == 0 || signature == "" {
+		return false
+	}
+
+	if tolerance > 0 && now.Sub(time.Unix(timestamp, 0)).Abs() > tolerance {
+		return false
+	}
+
+	return hmac.Equal([]byte(signature), []byte(Sign(secret, timestamp, body)))
+}
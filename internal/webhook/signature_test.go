@@ -0,0 +1,49 @@
+package webhook
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSign(t *testing.T) {
+	// echo -n '1720000000.{"id":"1"}' | openssl dgst -sha256 -hmac secret
+	want := "b8a6a714904f85f015ced17aad514b594d0e65cd5712d12e521fc77f65354dd7"
+
+	if got := Sign("secret", 1720000000, []byte(`{"id":"1"}`)); got != want {
+		t.Errorf("expected %s, got %s", want, got)
+	}
+}
+
+func TestVerify(t *testing.T) {
+	now := time.Unix(1720000000, 0)
+	body := []byte(`{"id":"1"}`)
+	header := SignatureValue("secret", now, body)
+
+	tests := []struct {
+		name   string
+		secret string
+		header string
+		body   []byte
+		now    time.Time
+		want   bool
+	}{
+		{"Valid", "secret", header, body, now, true},
+		{"WithinTolerance", "secret", header, body, now.Add(4 * time.Minute), true},
+		{"Expired", "secret", header, body, now.Add(10 * time.Minute), false},
+		{"WrongSecret", "other", header, body, now, false},
+		{"TamperedBody", "secret", header, []byte(`{"id":"2"}`), now, false},
+		{"MissingSignature", "secret", "t=1720000000", body, now, false},
+		{"MalformedTimestamp", "secret", "t=abc,v1=00", body, now, false},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := Verify(tc.secret, tc.header, tc.body, 5*time.Minute, tc.now); got != tc.want {
+				t.Errorf("expected %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
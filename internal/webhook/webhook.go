@@ -0,0 +1,407 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"go.uber.org/zap"
+
+	"fourleaves.studio/manga-scraper/internal"
+)
+
+type WebhookRepository interface {
+	FindSubscription(ctx context.Context, id string) (internal.WebhookSubscription, error)
+	FindMatchingSubscriptions(ctx context.Context, provider, series string) ([]internal.WebhookSubscription, error)
+	CreateDelivery(ctx context.Context, params internal.CreateWebhookDeliveryParams) (internal.WebhookDelivery, error)
+	FindDueDeliveries(ctx context.Context, limit int) ([]internal.WebhookDelivery, error)
+	ClaimDelivery(ctx context.Context, delivery internal.WebhookDelivery, until time.Time) (bool, error)
+	UpdateDelivery(ctx context.Context, params internal.UpdateWebhookDeliveryParams) (internal.WebhookDelivery, error)
+}
+
+// Worker turns chapter.released events into one delivery per matching subscription,
+// and sends the due deliveries to the subscribers
+type Worker struct {
+	repo         WebhookRepository
+	kafkaClient  *kafka.Consumer
+	httpClient   *http.Client
+	logger       *zap.Logger
+	retry        RetryPolicy
+	pollInterval time.Duration
+	doneC        chan struct{}
+	closeC       chan struct{}
+}
+
+func NewWorker(
+	repo WebhookRepository,
+	kafkaClient *kafka.Consumer,
+	httpClient *http.Client,
+	logger *zap.Logger,
+	retry RetryPolicy,
+	pollInterval time.Duration,
+) *Worker {
+	return &Worker{
+		repo:         repo,
+		kafkaClient:  kafkaClient,
+		httpClient:   httpClient,
+		logger:       logger,
+		retry:        retry,
+		pollInterval: pollInterval,
+		doneC:        make(chan struct{}),
+		closeC:       make(chan struct{}),
+	}
+}
+
+// deliveryBatch is how many due deliveries are loaded per poll
+const deliveryBatch = 50
+
+// responseLimit bounds how much of a failed response body is kept in the delivery log
+const responseLimit = 512
+
+type chapterEvent struct {
+	Type  string
+	Value internal.ChapterRelease
+}
+
+// payload is the body posted to the subscribers
+type payload struct {
+	ID    string          `json:"id"`
+	Event string          `json:"event"`
+	Data  json.RawMessage `json:"data"`
+}
+
+func (w *Worker) StartServer() (<-chan error, error) {
+	errC := make(chan error, 1)
+
+	ctx, stop := signal.NotifyContext(context.Background(),
+		os.Interrupt,
+		syscall.SIGTERM,
+		syscall.SIGQUIT,
+	)
+
+	go func() {
+		<-ctx.Done()
+
+		w.logger.Info("Shutdown signal received")
+
+		ctxTimeout, cancel := context.WithTimeout(context.Background(), w.httpClient.Timeout+10*time.Second)
+
+		defer func() {
+			_ = w.logger.Sync()
+			_ = w.kafkaClient.Unsubscribe()
+
+			stop()
+			cancel()
+			close(errC)
+		}()
+
+		if err := w.Shutdown(ctxTimeout); err != nil {
+			errC <- err
+		}
+
+		w.logger.Info("Shutdown completed")
+	}()
+
+	go func() {
+		w.logger.Info("Listening and serving")
+
+		if err := w.ListenAndServe(); err != nil {
+			errC <- err
+		}
+	}()
+
+	return errC, nil
+}
+
+func (w *Worker) ListenAndServe() error {
+	go func() {
+		var wg sync.WaitGroup
+
+		wg.Add(2)
+
+		go func() {
+			defer wg.Done()
+			w.consume()
+		}()
+
+		go func() {
+			defer wg.Done()
+			w.poll()
+		}()
+
+		wg.Wait()
+
+		w.logger.Info("No more messages to consume. Exiting.")
+
+		w.doneC <- struct{}{}
+	}()
+
+	return nil
+}
+
+func (w *Worker) Shutdown(ctx context.Context) error {
+	w.logger.Info("Shutting down server")
+
+	close(w.closeC)
+
+	select {
+	case <-ctx.Done():
+		return internal.WrapErrorf(ctx.Err(), internal.ErrUnknown, "Context done")
+	case <-w.doneC:
+		return nil
+	}
+}
+
+// consume records a pending delivery for every subscription matching a released chapter
+func (w *Worker) consume() {
+	for {
+		select {
+		case <-w.closeC:
+			return
+		default:
+		}
+
+		msg, ok := w.kafkaClient.Poll(150).(*kafka.Message)
+		if !ok {
+			continue
+		}
+
+		var evt chapterEvent
+
+		if err := json.NewDecoder(bytes.NewReader(msg.Value)).Decode(&evt); err != nil || evt.Type != internal.ChapterReleasedEvent {
+			w.logger.Info("Ignoring message, invalid", zap.String("type", evt.Type), zap.Error(err))
+			w.commit(msg)
+
+			continue
+		}
+
+		// the offset stays uncommitted when shutting down mid retry, so the release is consumed again on restart
+		if !w.enqueueWithRetry(evt.Value) {
+			return
+		}
+
+		w.commit(msg)
+	}
+}
+
+// enqueueWithRetry retries enqueue until it succeeds, the deliveries are keyed by chapter so a partial
+// attempt is completed without duplicates. It returns false if the worker shuts down first.
+func (w *Worker) enqueueWithRetry(release internal.ChapterRelease) bool {
+	for attempt := 1; ; attempt++ {
+		err := w.enqueue(release)
+		if err == nil {
+			return true
+		}
+
+		w.logger.Error("failed to enqueue webhook deliveries",
+			zap.String("provider", release.Provider),
+			zap.String("series", release.Series),
+			zap.String("chapter", release.Chapter),
+			zap.Int("attempt", attempt),
+			zap.Error(err),
+		)
+
+		select {
+		case <-w.closeC:
+			return false
+		case <-time.After(w.retry.Backoff(attempt)):
+		}
+	}
+}
+
+func (w *Worker) enqueue(release internal.ChapterRelease) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	subscriptions, err := w.repo.FindMatchingSubscriptions(ctx, release.Provider, release.Series)
+	if err != nil {
+		return err
+	}
+
+	if len(subscriptions) == 0 {
+		return nil
+	}
+
+	data, err := json.Marshal(release)
+	if err != nil {
+		return internal.WrapErrorf(err, internal.ErrUnknown, "json.Marshal")
+	}
+
+	for _, subscription := range subscriptions {
+		if _, err := w.repo.CreateDelivery(ctx, internal.CreateWebhookDeliveryParams{
+			SubscriptionID: subscription.ID,
+			Event:          internal.ChapterReleasedEvent,
+			DedupeKey:      release.Provider + "/" + release.Series + "/" + release.Chapter,
+			Payload:        data,
+		}); err != nil {
+			return err
+		}
+	}
+
+	w.logger.Info("Enqueued webhook deliveries", zap.String("chapter", release.Chapter), zap.Int("count", len(subscriptions)))
+
+	return nil
+}
+
+func (w *Worker) commit(msg *kafka.Message) {
+	if _, err := w.kafkaClient.CommitMessage(msg); err != nil {
+		w.logger.Error("commit failed", zap.String("partition", msg.TopicPartition.String()), zap.Error(err))
+	}
+}
+
+// poll sends the due deliveries every pollInterval, until a batch comes back short
+func (w *Worker) poll() {
+	ticker := time.NewTicker(w.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-w.closeC:
+			return
+		case <-ticker.C:
+			for w.deliverDue() == deliveryBatch {
+				select {
+				case <-w.closeC:
+					return
+				default:
+				}
+			}
+		}
+	}
+}
+
+func (w *Worker) deliverDue() int {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	deliveries, err := w.repo.FindDueDeliveries(ctx, deliveryBatch)
+	if err != nil {
+		w.logger.Error("failed to find due webhook deliveries", zap.Error(err))
+		return 0
+	}
+
+	subscriptions := make(map[string]internal.WebhookSubscription)
+
+	for _, delivery := range deliveries {
+		w.attempt(delivery, subscriptions)
+	}
+
+	return len(deliveries)
+}
+
+// attempt claims the delivery and sends it, subscriptions caches the subscriptions loaded for the current batch
+func (w *Worker) attempt(delivery internal.WebhookDelivery, subscriptions map[string]internal.WebhookSubscription) {
+	ctx, cancel := context.WithTimeout(context.Background(), w.httpClient.Timeout+30*time.Second)
+	defer cancel()
+
+	// keep other workers off the delivery until this attempt has timed out
+	claimed, err := w.repo.ClaimDelivery(ctx, delivery, time.Now().Add(w.httpClient.Timeout+time.Minute))
+	if err != nil {
+		w.logger.Error("failed to claim webhook delivery", zap.String("id", delivery.ID), zap.Error(err))
+		return
+	}
+
+	if !claimed {
+		return
+	}
+
+	subscription, ok := subscriptions[delivery.SubscriptionID]
+	if !ok {
+		subscription, err = w.repo.FindSubscription(ctx, delivery.SubscriptionID)
+		if err != nil {
+			w.logger.Error("failed to find webhook subscription", zap.String("id", delivery.SubscriptionID), zap.Error(err))
+			return
+		}
+
+		subscriptions[delivery.SubscriptionID] = subscription
+	}
+
+	w.deliver(ctx, subscription, delivery)
+}
+
+// deliver makes a single attempt and records its outcome in the delivery log
+func (w *Worker) deliver(ctx context.Context, subscription internal.WebhookSubscription, delivery internal.WebhookDelivery) {
+	params := internal.UpdateWebhookDeliveryParams{
+		ID:       delivery.ID,
+		Attempts: delivery.Attempts + 1,
+	}
+
+	now := time.Now()
+
+	if !subscription.IsActive {
+		params.Status = internal.FailedDeliveryStatus
+		params.Error = "subscription is inactive"
+		params.NextAttemptAt = now
+	} else {
+		status, err := w.send(ctx, subscription, delivery)
+
+		params.ResponseStatus = status
+
+		if err == nil {
+			params.Status = internal.SucceededDeliveryStatus
+			params.NextAttemptAt = now
+		} else {
+			params.Error = err.Error()
+			params.Status, params.NextAttemptAt = w.retry.next(params.Attempts, now)
+		}
+	}
+
+	if _, err := w.repo.UpdateDelivery(ctx, params); err != nil {
+		w.logger.Error("failed to update webhook delivery", zap.String("id", delivery.ID), zap.Error(err))
+		return
+	}
+
+	w.logger.Info("Webhook delivered",
+		zap.String("id", delivery.ID),
+		zap.String("url", subscription.URL),
+		zap.String("status", string(params.Status)),
+		zap.Int("attempts", params.Attempts),
+		zap.Int("responseStatus", params.ResponseStatus),
+	)
+}
+
+// send posts the signed delivery, any response outside 2xx counts as a failure
+func (w *Worker) send(ctx context.Context, subscription internal.WebhookSubscription, delivery internal.WebhookDelivery) (int, error) {
+	body, err := json.Marshal(payload{
+		ID:    delivery.ID,
+		Event: delivery.Event,
+		Data:  delivery.Payload,
+	})
+	if err != nil {
+		return 0, internal.WrapErrorf(err, internal.ErrUnknown, "json.Marshal")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, subscription.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, internal.WrapErrorf(err, internal.ErrUnknown, "http.NewRequest")
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "manga-scraper-webhook")
+	req.Header.Set(EventHeader, delivery.Event)
+	req.Header.Set(DeliveryHeader, delivery.ID)
+	req.Header.Set(SignatureHeader, SignatureValue(subscription.Secret, time.Now(), body))
+
+	resp, err := w.httpClient.Do(req)
+	if err != nil {
+		return 0, internal.WrapErrorf(err, internal.ErrUnknown, "http.Do")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		return resp.StatusCode, nil
+	}
+
+	text, _ := io.ReadAll(io.LimitReader(resp.Body, responseLimit))
+
+	return resp.StatusCode, internal.NewErrorf(internal.ErrUnknown, "unexpected status %d: %s", resp.StatusCode, text)
+}
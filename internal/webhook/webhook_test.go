@@ -0,0 +1,101 @@
+package webhook
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+
+	"fourleaves.studio/manga-scraper/internal"
+)
+
+type fakeRepo struct {
+	WebhookRepository
+	updated internal.UpdateWebhookDeliveryParams
+}
+
+func (f *fakeRepo) UpdateDelivery(_ context.Context, params internal.UpdateWebhookDeliveryParams) (internal.WebhookDelivery, error) {
+	f.updated = params
+	return internal.WebhookDelivery{ID: params.ID}, nil
+}
+
+func TestWorker_Deliver(t *testing.T) {
+	policy := RetryPolicy{MaxAttempts: 3, BaseDelay: time.Minute, MaxDelay: time.Hour}
+
+	tests := []struct {
+		name       string
+		status     int
+		active     bool
+		attempts   int
+		wantStatus internal.WebhookDeliveryStatus
+		wantRetry  bool
+	}{
+		{"Succeeded", http.StatusNoContent, true, 0, internal.SucceededDeliveryStatus, false},
+		{"Retried", http.StatusInternalServerError, true, 0, internal.PendingDeliveryStatus, true},
+		{"Exhausted", http.StatusInternalServerError, true, 2, internal.FailedDeliveryStatus, false},
+		{"Inactive", http.StatusNoContent, false, 0, internal.FailedDeliveryStatus, false},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var signature, event string
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				if !Verify("secret", r.Header.Get(SignatureHeader), body, time.Minute, time.Now()) {
+					t.Errorf("invalid signature %q", r.Header.Get(SignatureHeader))
+				}
+
+				signature = r.Header.Get(SignatureHeader)
+				event = r.Header.Get(EventHeader)
+
+				w.WriteHeader(tc.status)
+			}))
+			defer server.Close()
+
+			repo := &fakeRepo{}
+			worker := NewWorker(repo, nil, server.Client(), zap.NewNop(), policy, time.Second)
+
+			before := time.Now()
+
+			worker.deliver(context.Background(), internal.WebhookSubscription{
+				ID:       "sub",
+				URL:      server.URL,
+				Secret:   "secret",
+				IsActive: tc.active,
+			}, internal.WebhookDelivery{
+				ID:       "delivery",
+				Event:    internal.ChapterReleasedEvent,
+				Payload:  []byte(`{"chapter":"c-1"}`),
+				Attempts: tc.attempts,
+			})
+
+			if repo.updated.Status != tc.wantStatus {
+				t.Errorf("expected status %s, got %s", tc.wantStatus, repo.updated.Status)
+			}
+
+			if repo.updated.Attempts != tc.attempts+1 {
+				t.Errorf("expected %d attempts, got %d", tc.attempts+1, repo.updated.Attempts)
+			}
+
+			if retry := repo.updated.NextAttemptAt.Sub(before) >= time.Minute; retry != tc.wantRetry {
+				t.Errorf("expected retry %v, next attempt at %v", tc.wantRetry, repo.updated.NextAttemptAt)
+			}
+
+			if tc.active && (signature == "" || event != internal.ChapterReleasedEvent) {
+				t.Errorf("expected signed %s delivery, got signature %q event %q", internal.ChapterReleasedEvent, signature, event)
+			}
+
+			if !tc.active && signature != "" {
+				t.Errorf("expected no request for an inactive subscription")
+			}
+		})
+	}
+}
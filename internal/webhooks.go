@@ -0,0 +1,140 @@
+package internal
+
+import (
+	"encoding/json"
+	"net/url"
+	"time"
+)
+
+// ChapterReleasedEvent is sent once for every chapter that did not exist before a chapter list scrape
+const ChapterReleasedEvent = "chapter.released"
+
+type ChapterRelease struct {
+	Provider    string    `json:"provider"`
+	Series      string    `json:"series"`
+	SeriesTitle string    `json:"seriesTitle"`
+	Chapter     string    `json:"chapter"`
+	Number      float64   `json:"number"`
+	ShortTitle  string    `json:"shortTitle"`
+	SourceURL   string    `json:"sourceURL"`
+	ReleasedAt  time.Time `json:"releasedAt"`
+}
+
+type WebhookSubscription struct {
+	ID       string `json:"id"`
+	URL      string `json:"url"`
+	Provider string `json:"provider,omitempty"`
+	Series   string `json:"series,omitempty"`
+	IsActive bool   `json:"isActive"`
+	// Secret is only returned when the subscription is created
+	Secret    string    `json:"secret,omitempty"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+type WebhookSubscriptionParams struct {
+	ID       string
+	URL      string
+	Secret   string
+	Provider string
+	Series   string
+	IsActive *bool
+}
+
+type WebhookDeliveryStatus string
+
+const (
+	PendingDeliveryStatus   WebhookDeliveryStatus = "PENDING"
+	SucceededDeliveryStatus WebhookDeliveryStatus = "SUCCEEDED"
+	FailedDeliveryStatus    WebhookDeliveryStatus = "FAILED"
+)
+
+type WebhookDelivery struct {
+	ID             string                `json:"id"`
+	SubscriptionID string                `json:"subscriptionId"`
+	Event          string                `json:"event"`
+	Payload        json.RawMessage       `json:"payload"`
+	Status         WebhookDeliveryStatus `json:"status"`
+	Attempts       int                   `json:"attempts"`
+	ResponseStatus int                   `json:"responseStatus,omitempty"`
+	Error          string                `json:"error,omitempty"`
+	NextAttemptAt  time.Time             `json:"nextAttemptAt"`
+	CreatedAt      time.Time             `json:"createdAt"`
+	UpdatedAt      time.Time             `json:"updatedAt"`
+}
+
+// CreateWebhookDeliveryParams creates at most one delivery per subscription, event and DedupeKey
+type CreateWebhookDeliveryParams struct {
+	SubscriptionID string
+	Event          string
+	DedupeKey      string
+	Payload        []byte
+}
+
+type UpdateWebhookDeliveryParams struct {
+	ID             string
+	Status         WebhookDeliveryStatus
+	Attempts       int
+	ResponseStatus int
+	Error          string
+	NextAttemptAt  time.Time
+}
+
+type FindWebhookDeliveriesParams struct {
+	SubscriptionID string
+	Status         WebhookDeliveryStatus
+	Page           int
+	Size           int
+}
+
+func (p *WebhookSubscriptionParams) Validate() error {
+	if p.URL == "" {
+		return NewErrorf(ErrInvalidInput, "url is required")
+	}
+
+	u, err := url.Parse(p.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return NewErrorf(ErrInvalidInput, "url must be an absolute http or https url")
+	}
+
+	if p.Series != "" && p.Provider == "" {
+		return NewErrorf(ErrInvalidInput, "provider is required when filtering by series")
+	}
+
+	return nil
+}
+
+// Matches reports whether the subscription wants events of the given series
+func (s *WebhookSubscription) Matches(provider, series string) bool {
+	if !s.IsActive {
+		return false
+	}
+
+	if s.Provider != "" && s.Provider != provider {
+		return false
+	}
+
+	return s.Series == "" || s.Series == series
+}
+
+func (p *FindWebhookDeliveriesParams) Validate() error {
+	if p.SubscriptionID == "" {
+		return NewErrorf(ErrInvalidInput, "subscription id is required")
+	}
+
+	if p.Page < 1 {
+		return NewErrorf(ErrInvalidInput, "page must be greater than 0")
+	}
+
+	if p.Size < 1 {
+		return NewErrorf(ErrInvalidInput, "size must be greater than 0")
+	}
+
+	switch p.Status {
+	case "", PendingDeliveryStatus, SucceededDeliveryStatus, FailedDeliveryStatus:
+	default:
+		return NewErrorf(ErrInvalidInput, "invalid status %s", p.Status)
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package internal
+
+import "testing"
+
+func TestWebhookSubscriptionParams_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  WebhookSubscriptionParams
+		wantErr bool
+	}{
+		{"Valid", WebhookSubscriptionParams{URL: "https://example.com/hooks"}, false},
+		{"ValidSeries", WebhookSubscriptionParams{URL: "http://example.com/hooks", Provider: "asura", Series: "reincarnator"}, false},
+		{"EmptyURL", WebhookSubscriptionParams{}, true},
+		{"RelativeURL", WebhookSubscriptionParams{URL: "/hooks"}, true},
+		{"InvalidScheme", WebhookSubscriptionParams{URL: "ftp://example.com/hooks"}, true},
+		{"SeriesWithoutProvider", WebhookSubscriptionParams{URL: "https://example.com/hooks", Series: "reincarnator"}, true},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tc.params.Validate()
+			if tc.wantErr && err == nil {
+				t.Errorf("expected an error but got none")
+			}
+			if !tc.wantErr && err != nil {
+				t.Errorf("did not expect an error but got one: %v", err)
+			}
+		})
+	}
+}
+
+func TestWebhookSubscription_Matches(t *testing.T) {
+	tests := []struct {
+		name string
+		sub  WebhookSubscription
+		want bool
+	}{
+		{"All", WebhookSubscription{IsActive: true}, true},
+		{"Provider", WebhookSubscription{IsActive: true, Provider: "asura"}, true},
+		{"OtherProvider", WebhookSubscription{IsActive: true, Provider: "flame"}, false},
+		{"Series", WebhookSubscription{IsActive: true, Provider: "asura", Series: "reincarnator"}, true},
+		{"OtherSeries", WebhookSubscription{IsActive: true, Provider: "asura", Series: "nano-machine"}, false},
+		{"Inactive", WebhookSubscription{IsActive: false}, false},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tc.sub.Matches("asura", "reincarnator"); got != tc.want {
+				t.Errorf("expected %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
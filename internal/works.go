@@ -0,0 +1,337 @@
+package internal
+
+import (
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+// Work is the canonical title that groups the series of the same manga across providers
+type Work struct {
+	ID        string         `json:"id"`
+	Title     string         `json:"title"`
+	Aliases   []string       `json:"aliases"`
+	Sources   []SeriesSource `json:"sources"`
+	CreatedAt time.Time      `json:"createdAt"`
+	UpdatedAt time.Time      `json:"updatedAt"`
+}
+
+// SeriesSource is a provider series linked to a work
+type SeriesSource struct {
+	Provider      string `json:"provider"`
+	Slug          string `json:"slug"`
+	Title         string `json:"title"`
+	ChaptersCount int    `json:"chaptersCount"`
+	LatestChapter string `json:"latestChapter"`
+}
+
+// ChapterSource is the chapter with the same number in another series of the same work
+type ChapterSource struct {
+	Provider string `json:"provider"`
+	Series   string `json:"series"`
+	Slug     string `json:"slug"`
+}
+
+type WorkCandidateStatus string
+
+const (
+	PendingCandidateStatus  WorkCandidateStatus = "PENDING"
+	RejectedCandidateStatus WorkCandidateStatus = "REJECTED"
+)
+
+// WorkCandidate is a series that looks like it belongs to a work and waits for an admin to confirm or reject it
+type WorkCandidate struct {
+	ID          string              `json:"id"`
+	WorkID      string              `json:"workId"`
+	WorkTitle   string              `json:"workTitle,omitempty"`
+	Provider    string              `json:"provider"`
+	Series      string              `json:"series"`
+	SeriesTitle string              `json:"seriesTitle,omitempty"`
+	Reason      string              `json:"reason"`
+	Status      WorkCandidateStatus `json:"status"`
+	CreatedAt   time.Time           `json:"createdAt"`
+	UpdatedAt   time.Time           `json:"updatedAt"`
+}
+
+// CreateWorkParams creates a work with the given series as its only source
+type CreateWorkParams struct {
+	Title    string
+	Provider string
+	Series   string
+}
+
+type UpdateWorkParams struct {
+	ID      string
+	Title   string
+	Aliases []string
+}
+
+type WorkSeriesParams struct {
+	WorkID   string
+	Provider string
+	Series   string
+}
+
+type MergeWorksParams struct {
+	TargetID string
+	SourceID string
+}
+
+type CreateWorkCandidateParams struct {
+	WorkID   string
+	Provider string
+	Series   string
+	Reason   string
+	Status   WorkCandidateStatus
+}
+
+type FindWorkChaptersParams struct {
+	ID    string
+	Order SortOrder
+}
+
+type FindWorkCandidatesParams struct {
+	Status WorkCandidateStatus
+	Page   int
+	Size   int
+}
+
+var titleFolder = transform.Chain(norm.NFKD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
+
+// NormalizeTitle folds a title for matching across providers: case, accents, punctuation and spacing are ignored,
+// so "Solo Leveling: Ragnarök" and "solo leveling ragnarok" match
+func NormalizeTitle(title string) string {
+	folded, _, err := transform.String(titleFolder, title)
+	if err != nil {
+		folded = title
+	}
+
+	folded = strings.ToLower(strings.ReplaceAll(folded, "&", " and "))
+
+	var b strings.Builder
+
+	space := false
+
+	for _, r := range folded {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			if space && b.Len() > 0 {
+				b.WriteByte(' ')
+			}
+
+			b.WriteRune(r)
+			space = false
+
+			continue
+		}
+
+		// apostrophes join words, "hero's" and "heros" should match
+		if r == '\'' || r == '’' {
+			continue
+		}
+
+		space = true
+	}
+
+	return b.String()
+}
+
+func (p *CreateWorkParams) Validate() error {
+	if p.Title == "" {
+		return NewErrorf(ErrInvalidInput, "title is required")
+	}
+
+	if p.Provider == "" || p.Series == "" {
+		return NewErrorf(ErrInvalidInput, "provider and series are required")
+	}
+
+	return nil
+}
+
+func (p *UpdateWorkParams) Validate() error {
+	if p.ID == "" {
+		return NewErrorf(ErrInvalidInput, "id is required")
+	}
+
+	if p.Title == "" {
+		return NewErrorf(ErrInvalidInput, "title is required")
+	}
+
+	for _, alias := range p.Aliases {
+		if NormalizeTitle(alias) == "" {
+			return NewErrorf(ErrInvalidInput, "alias %q has no letters or digits", alias)
+		}
+	}
+
+	return nil
+}
+
+func (p *WorkSeriesParams) Validate() error {
+	if p.WorkID == "" {
+		return NewErrorf(ErrInvalidInput, "work id is required")
+	}
+
+	if p.Provider == "" || p.Series == "" {
+		return NewErrorf(ErrInvalidInput, "provider and series are required")
+	}
+
+	return nil
+}
+
+func (p *MergeWorksParams) Validate() error {
+	if p.TargetID == "" || p.SourceID == "" {
+		return NewErrorf(ErrInvalidInput, "target and source work ids are required")
+	}
+
+	if p.TargetID == p.SourceID {
+		return NewErrorf(ErrInvalidInput, "can not merge a work into itself")
+	}
+
+	return nil
+}
+
+func (p *FindWorkChaptersParams) Validate() error {
+	if p.ID == "" {
+		return NewErrorf(ErrInvalidInput, "id is required")
+	}
+
+	return nil
+}
+
+func (p *FindWorkCandidatesParams) Validate() error {
+	if p.Page < 1 {
+		return NewErrorf(ErrInvalidInput, "page must be greater than 0")
+	}
+
+	if p.Size < 1 {
+		return NewErrorf(ErrInvalidInput, "size must be greater than 0")
+	}
+
+	switch p.Status {
+	case "", PendingCandidateStatus, RejectedCandidateStatus:
+	default:
+		return NewErrorf(ErrInvalidInput, "invalid status %s", p.Status)
+	}
+
+	return nil
+}
+
+// MergeWorkChapters merges the chapter lists of the sources of a work, grouping the chapters of different providers
+// by number. Each group is served by its preferred chapter: chapters with content first, then by provider priority,
+// providers missing from priority come after the listed ones in slug order. The other chapters of the
+// group are kept in the Sources of the preferred one, so a reader can fall back to them
+func MergeWorkChapters(lists [][]Chapter, priority []string, order SortOrder) []Chapter {
+	rank := make(map[string]int, len(priority))
+
+	for i, provider := range priority {
+		if _, ok := rank[provider]; !ok {
+			rank[provider] = i
+		}
+	}
+
+	better := func(a, b Chapter) bool {
+		if hasContent(a) != hasContent(b) {
+			return hasContent(a)
+		}
+
+		ra, oka := rank[a.Provider]
+		rb, okb := rank[b.Provider]
+
+		switch {
+		case oka && okb && ra != rb:
+			return ra < rb
+		case oka != okb:
+			return oka
+		case a.Provider != b.Provider:
+			return a.Provider < b.Provider
+		}
+
+		return a.Series < b.Series
+	}
+
+	// a group holds at most one chapter per provider, the chapters a provider lists twice under one number
+	// (extras and unnumbered chapters parse as 0) stay separate entries
+	byNumber := make(map[float64][][]Chapter)
+
+	for _, list := range lists {
+		for _, chapter := range list {
+			groups := byNumber[chapter.Number]
+
+			i := len(groups)
+
+			for j, group := range groups {
+				if !hasProvider(group, chapter.Provider) {
+					i = j
+					break
+				}
+			}
+
+			if i == len(groups) {
+				groups = append(groups, nil)
+			}
+
+			groups[i] = append(groups[i], chapter)
+			byNumber[chapter.Number] = groups
+		}
+	}
+
+	result := make([]Chapter, 0, len(byNumber))
+
+	for _, groups := range byNumber {
+		for _, chapters := range groups {
+			sort.SliceStable(chapters, func(i, j int) bool {
+				return better(chapters[i], chapters[j])
+			})
+
+			preferred := chapters[0]
+			preferred.Sources = nil
+
+			for _, chapter := range chapters[1:] {
+				preferred.Sources = append(preferred.Sources, ChapterSource{
+					Provider: chapter.Provider,
+					Series:   chapter.Series,
+					Slug:     chapter.Slug,
+				})
+			}
+
+			result = append(result, preferred)
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Number != result[j].Number {
+			if order == DESC {
+				return result[i].Number > result[j].Number
+			}
+
+			return result[i].Number < result[j].Number
+		}
+
+		// entries sharing a number keep a stable order between requests
+		if result[i].Provider != result[j].Provider {
+			return result[i].Provider < result[j].Provider
+		}
+
+		return result[i].Slug < result[j].Slug
+	})
+
+	return result
+}
+
+func hasProvider(chapters []Chapter, provider string) bool {
+	for _, chapter := range chapters {
+		if chapter.Provider == provider {
+			return true
+		}
+	}
+
+	return false
+}
+
+func hasContent(chapter Chapter) bool {
+	return len(chapter.ContentURLs) > 0
+}
@@ -0,0 +1,180 @@
+package internal
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNormalizeTitle(t *testing.T) {
+	tests := []struct {
+		name  string
+		title string
+		want  string
+	}{
+		{"Plain", "Solo Leveling", "solo leveling"},
+		{"Punctuation", "Solo Leveling: Ragnarok!", "solo leveling ragnarok"},
+		{"Accents", "Solo Leveling: Ragnarök", "solo leveling ragnarok"},
+		{"Spacing", "  Solo   Leveling  ", "solo leveling"},
+		{"Apostrophe", "The Hero’s Return", "the heros return"},
+		{"Ampersand", "Swords & Magic", "swords and magic"},
+		{"Digits", "Nano Machine 2", "nano machine 2"},
+		{"FullWidth", "ＳＯＬＯ Leveling", "solo leveling"},
+		{"Hyphen", "Return of the Mount Hua Sect - Remake", "return of the mount hua sect remake"},
+		{"Empty", "!!", ""},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := NormalizeTitle(tc.title); got != tc.want {
+				t.Errorf("expected %q, got %q", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestMergeWorksParams_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  MergeWorksParams
+		wantErr bool
+	}{
+		{"Valid", MergeWorksParams{TargetID: "a", SourceID: "b"}, false},
+		{"MissingSource", MergeWorksParams{TargetID: "a"}, true},
+		{"Self", MergeWorksParams{TargetID: "a", SourceID: "a"}, true},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tc.params.Validate()
+			if tc.wantErr && err == nil {
+				t.Errorf("expected an error but got none")
+			}
+			if !tc.wantErr && err != nil {
+				t.Errorf("did not expect an error but got one: %v", err)
+			}
+		})
+	}
+}
+
+func TestMergeWorkChapters(t *testing.T) {
+	content := []string{"https://example.com/1.jpg"}
+
+	asura := []Chapter{
+		{Provider: "asura", Series: "solo", Slug: "a-1", Number: 1, ContentURLs: content},
+		{Provider: "asura", Series: "solo", Slug: "a-2", Number: 2},
+	}
+	flame := []Chapter{
+		{Provider: "flame", Series: "solo-leveling", Slug: "f-1", Number: 1, ContentURLs: content},
+		{Provider: "flame", Series: "solo-leveling", Slug: "f-2", Number: 2, ContentURLs: content},
+		{Provider: "flame", Series: "solo-leveling", Slug: "f-3", Number: 3, ContentURLs: content},
+	}
+	surya := []Chapter{
+		{Provider: "surya", Series: "solo", Slug: "s-1", Number: 1, ContentURLs: content},
+	}
+	// unnumbered extras parse as chapter 0
+	asuraExtras := []Chapter{
+		{Provider: "asura", Series: "solo", Slug: "a-notice", Number: 0},
+		{Provider: "asura", Series: "solo", Slug: "a-side-story", Number: 0},
+		{Provider: "asura", Series: "solo", Slug: "a-1", Number: 1, ContentURLs: content},
+	}
+	flameExtras := []Chapter{
+		{Provider: "flame", Series: "solo-leveling", Slug: "f-prologue", Number: 0, ContentURLs: content},
+		{Provider: "flame", Series: "solo-leveling", Slug: "f-1", Number: 1, ContentURLs: content},
+	}
+
+	tests := []struct {
+		name     string
+		lists    [][]Chapter
+		priority []string
+		order    SortOrder
+		want     []string
+		sources  map[string][]string
+	}{
+		{
+			name:     "Priority",
+			lists:    [][]Chapter{flame, asura},
+			priority: []string{"asura", "flame"},
+			order:    ASC,
+			want:     []string{"a-1", "f-2", "f-3"},
+			sources: map[string][]string{
+				"a-1": {"f-1"},
+				"f-2": {"a-2"},
+				"f-3": nil,
+			},
+		},
+		{
+			name:     "Unlisted providers come last",
+			lists:    [][]Chapter{surya, flame, asura},
+			priority: []string{"surya"},
+			order:    ASC,
+			want:     []string{"s-1", "f-2", "f-3"},
+			sources: map[string][]string{
+				"s-1": {"a-1", "f-1"},
+			},
+		},
+		{
+			name:  "No priority",
+			lists: [][]Chapter{flame, asura},
+			order: DESC,
+			want:  []string{"f-3", "f-2", "a-1"},
+		},
+		{
+			name:     "Same provider duplicates stay separate",
+			lists:    [][]Chapter{asuraExtras, flameExtras},
+			priority: []string{"asura", "flame"},
+			order:    ASC,
+			want:     []string{"a-side-story", "f-prologue", "a-1"},
+			sources: map[string][]string{
+				"a-side-story": nil,
+				"f-prologue":   {"a-notice"},
+				"a-1":          {"f-1"},
+			},
+		},
+		{
+			name:  "Empty",
+			lists: nil,
+			order: ASC,
+			want:  []string{},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := MergeWorkChapters(tc.lists, tc.priority, tc.order)
+
+			slugs := make([]string, 0, len(got))
+			for _, chapter := range got {
+				slugs = append(slugs, chapter.Slug)
+			}
+
+			if !reflect.DeepEqual(slugs, tc.want) {
+				t.Fatalf("expected %v, got %v", tc.want, slugs)
+			}
+
+			for _, chapter := range got {
+				want, ok := tc.sources[chapter.Slug]
+				if !ok {
+					continue
+				}
+
+				var sources []string
+				for _, source := range chapter.Sources {
+					sources = append(sources, source.Slug)
+				}
+
+				if !reflect.DeepEqual(sources, want) {
+					t.Errorf("expected sources %v for %s, got %v", want, chapter.Slug, sources)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,2 @@
+-- AddProviderSelectors
+ALTER TABLE `Provider` ADD COLUMN `selectors` JSON NULL;
@@ -0,0 +1,2 @@
+-- AddProviderFetchModes
+ALTER TABLE `Provider` ADD COLUMN `fetchModes` JSON NULL;
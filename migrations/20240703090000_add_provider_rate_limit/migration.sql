@@ -0,0 +1,2 @@
+-- AddProviderRateLimit
+ALTER TABLE `Provider` ADD COLUMN `rateLimit` JSON NULL;
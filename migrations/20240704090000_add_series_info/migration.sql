@@ -0,0 +1,6 @@
+-- AddSeriesInfo
+ALTER TABLE `Series`
+ADD COLUMN `type` VARCHAR(191) NOT NULL DEFAULT '',
+ADD COLUMN `author` VARCHAR(191) NOT NULL DEFAULT '',
+ADD COLUMN `artist` VARCHAR(191) NOT NULL DEFAULT '',
+ADD COLUMN `releaseYear` INTEGER NOT NULL DEFAULT 0;
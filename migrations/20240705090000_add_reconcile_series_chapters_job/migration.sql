@@ -0,0 +1,3 @@
+-- AddReconcileSeriesChaptersJob
+INSERT INTO `CronJob` (`id`, `name`, `crontab`, `tags`, `updatedAt`)
+VALUES (UUID(), 'reconcile-series-chapters', '0 3,15 * * *', '', CURRENT_TIMESTAMP(3));
@@ -0,0 +1,35 @@
+-- AddWebhooks
+CREATE TABLE `WebhookSubscription` (
+    `id` VARCHAR(191) NOT NULL,
+    `url` TEXT NOT NULL,
+    `secret` VARCHAR(191) NOT NULL,
+    `provider` VARCHAR(191) NOT NULL DEFAULT '',
+    `series` VARCHAR(191) NOT NULL DEFAULT '',
+    `isActive` BOOLEAN NOT NULL DEFAULT true,
+    `createdAt` DATETIME(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+    `updatedAt` DATETIME(3) NOT NULL,
+
+    INDEX `subscriptionFilterIndex`(`provider`, `series`),
+    PRIMARY KEY (`id`)
+) DEFAULT CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci;
+
+CREATE TABLE `WebhookDelivery` (
+    `id` VARCHAR(191) NOT NULL,
+    `subscriptionId` VARCHAR(191) NOT NULL,
+    `event` VARCHAR(191) NOT NULL,
+    `payload` JSON NOT NULL,
+    `status` VARCHAR(191) NOT NULL,
+    `attempts` INTEGER NOT NULL DEFAULT 0,
+    `responseStatus` INTEGER NOT NULL DEFAULT 0,
+    `error` TEXT NOT NULL,
+    `nextAttemptAt` DATETIME(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+    `createdAt` DATETIME(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+    `updatedAt` DATETIME(3) NOT NULL,
+
+    INDEX `subscriptionIndex`(`subscriptionId`),
+    INDEX `dueIndex`(`status`, `nextAttemptAt`),
+    PRIMARY KEY (`id`)
+) DEFAULT CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci;
+
+-- AddForeignKey
+ALTER TABLE `WebhookDelivery` ADD CONSTRAINT `WebhookDelivery_subscriptionId_fkey` FOREIGN KEY (`subscriptionId`) REFERENCES `WebhookSubscription`(`id`) ON DELETE CASCADE ON UPDATE CASCADE;
@@ -0,0 +1,42 @@
+-- AlterTable
+ALTER TABLE `Series` ADD COLUMN `workId` VARCHAR(191) NULL;
+
+-- CreateTable
+CREATE TABLE `Work` (
+    `id` VARCHAR(191) NOT NULL,
+    `title` TEXT NOT NULL,
+    `aliases` JSON NOT NULL,
+    `createdAt` DATETIME(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+    `updatedAt` DATETIME(3) NOT NULL,
+
+    PRIMARY KEY (`id`)
+) DEFAULT CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci;
+
+-- CreateTable
+CREATE TABLE `WorkCandidate` (
+    `id` VARCHAR(191) NOT NULL,
+    `workId` VARCHAR(191) NOT NULL,
+    `providerSlug` VARCHAR(191) NOT NULL,
+    `seriesSlug` VARCHAR(191) NOT NULL,
+    `reason` VARCHAR(191) NOT NULL,
+    `status` VARCHAR(191) NOT NULL,
+    `createdAt` DATETIME(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+    `updatedAt` DATETIME(3) NOT NULL,
+
+    INDEX `candidateSeriesIndex`(`providerSlug`, `seriesSlug`),
+    INDEX `candidateStatusIndex`(`status`),
+    UNIQUE INDEX `WorkCandidate_workId_providerSlug_seriesSlug_key`(`workId`, `providerSlug`, `seriesSlug`),
+    PRIMARY KEY (`id`)
+) DEFAULT CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci;
+
+-- CreateIndex
+CREATE INDEX `workIndex` ON `Series`(`workId`);
+
+-- AddForeignKey
+ALTER TABLE `Series` ADD CONSTRAINT `Series_workId_fkey` FOREIGN KEY (`workId`) REFERENCES `Work`(`id`) ON DELETE SET NULL ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE `WorkCandidate` ADD CONSTRAINT `WorkCandidate_workId_fkey` FOREIGN KEY (`workId`) REFERENCES `Work`(`id`) ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE `WorkCandidate` ADD CONSTRAINT `WorkCandidate_providerSlug_seriesSlug_fkey` FOREIGN KEY (`providerSlug`, `seriesSlug`) REFERENCES `Series`(`providerSlug`, `slug`) ON DELETE CASCADE ON UPDATE CASCADE;
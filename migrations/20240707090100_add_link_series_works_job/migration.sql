@@ -0,0 +1,3 @@
+-- AddLinkSeriesWorksJob
+INSERT INTO `CronJob` (`id`, `name`, `crontab`, `tags`, `updatedAt`)
+VALUES (UUID(), 'link-series-works', '30 3 * * *', '', CURRENT_TIMESTAMP(3));
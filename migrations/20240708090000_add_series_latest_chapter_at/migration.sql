@@ -0,0 +1,17 @@
+-- AlterTable
+ALTER TABLE `Series` ADD COLUMN `latestChapterAt` DATETIME(3) NULL;
+
+-- Backfill from the newest chapter of each series
+UPDATE `Series` AS s
+JOIN (
+    SELECT `providerSlug`, `seriesSlug`, MAX(`createdAt`) AS `latestChapterAt`
+    FROM `Chapter`
+    GROUP BY `providerSlug`, `seriesSlug`
+) AS c ON c.`providerSlug` = s.`providerSlug` AND c.`seriesSlug` = s.`slug`
+SET s.`latestChapterAt` = c.`latestChapterAt`;
+
+-- CreateIndex
+CREATE INDEX `updatedIndex` ON `Series`(`updatedAt`);
+
+-- CreateIndex
+CREATE INDEX `latestChapterIndex` ON `Series`(`latestChapterAt`);
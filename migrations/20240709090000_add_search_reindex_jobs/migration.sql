@@ -0,0 +1,15 @@
+-- CreateTable
+CREATE TABLE `SearchReindexJob` (
+    `id` VARCHAR(191) NOT NULL,
+    `provider` VARCHAR(191) NOT NULL,
+    `status` VARCHAR(191) NOT NULL,
+    `index` VARCHAR(191) NOT NULL DEFAULT '',
+    `total` INTEGER NOT NULL DEFAULT 0,
+    `indexed` INTEGER NOT NULL DEFAULT 0,
+    `message` TEXT NOT NULL,
+    `createdAt` DATETIME(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+    `updatedAt` DATETIME(3) NOT NULL,
+
+    INDEX `providerIndex`(`provider`),
+    PRIMARY KEY (`id`)
+) DEFAULT CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci;
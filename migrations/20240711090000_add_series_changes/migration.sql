@@ -0,0 +1,17 @@
+-- CreateTable
+CREATE TABLE `SeriesChange` (
+    `id` VARCHAR(191) NOT NULL,
+    `provider` VARCHAR(191) NOT NULL,
+    `slug` VARCHAR(191) NOT NULL,
+    `event` VARCHAR(191) NOT NULL,
+    `revision` INTEGER NOT NULL DEFAULT 0,
+    `attempts` INTEGER NOT NULL DEFAULT 0,
+    `error` TEXT NOT NULL,
+    `nextAttemptAt` DATETIME(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+    `createdAt` DATETIME(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+    `updatedAt` DATETIME(3) NOT NULL,
+
+    INDEX `dueIndex`(`nextAttemptAt`),
+    UNIQUE INDEX `SeriesChange_provider_slug_key`(`provider`, `slug`),
+    PRIMARY KEY (`id`)
+) DEFAULT CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci;
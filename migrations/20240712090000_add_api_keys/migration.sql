@@ -0,0 +1,16 @@
+-- CreateTable
+CREATE TABLE `APIKey` (
+    `id` VARCHAR(191) NOT NULL,
+    `name` VARCHAR(191) NOT NULL,
+    `prefix` VARCHAR(191) NOT NULL,
+    `hash` VARCHAR(191) NOT NULL,
+    `scopes` JSON NOT NULL,
+    `rateLimit` INTEGER NOT NULL DEFAULT 0,
+    `dailyQuota` INTEGER NOT NULL DEFAULT 0,
+    `revokedAt` DATETIME(3) NULL,
+    `createdAt` DATETIME(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+    `updatedAt` DATETIME(3) NOT NULL,
+
+    UNIQUE INDEX `APIKey_hash_key`(`hash`),
+    PRIMARY KEY (`id`)
+) DEFAULT CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci;
@@ -0,0 +1,10 @@
+-- AlterTable
+ALTER TABLE `WebhookDelivery` ADD COLUMN `dedupeKey` VARCHAR(191) NOT NULL DEFAULT '';
+
+-- the deliveries created before the key are kept apart
+UPDATE `WebhookDelivery` SET `dedupeKey` = `id`;
+
+ALTER TABLE `WebhookDelivery` ALTER COLUMN `dedupeKey` DROP DEFAULT;
+
+-- CreateIndex
+CREATE UNIQUE INDEX `WebhookDelivery_subscriptionId_event_dedupeKey_key` ON `WebhookDelivery`(`subscriptionId`, `event`, `dedupeKey`);
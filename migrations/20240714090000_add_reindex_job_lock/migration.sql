@@ -0,0 +1,5 @@
+-- AlterTable
+ALTER TABLE `SearchReindexJob` ADD COLUMN `activeProvider` VARCHAR(191) NULL;
+
+-- CreateIndex
+CREATE UNIQUE INDEX `SearchReindexJob_activeProvider_key` ON `SearchReindexJob`(`activeProvider`);
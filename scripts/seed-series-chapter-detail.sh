@@ -2,6 +2,8 @@
 
 # apiUrl="https://manga-scraper.hostinger.fourleaves.studio"
 apiUrl="http://localhost:1323"
+# _sync is an admin route, use a key with the admin scope
+apiKey="${API_KEY:?API_KEY must be set to an API key with the admin scope}"
 
 check_error() {
   local response="$1"
@@ -22,8 +24,8 @@ while true; do
   series=$(echo "$seriesApi" | jq -r '.data.series[].slug')
   for s in $series; do
     echo "Updating $s from $provider"
-    curl -s -X PUT "$apiUrl/api/v1/series/$provider/$s/_lch"
-    curl -s -X PUT "$apiUrl/api/v1/series/$provider/$s/_chc"
+    syncApi=$(curl -s -X PUT -H "X-API-Key: $apiKey" "$apiUrl/api/v1/series/$provider/$s/_sync")
+    check_error "$syncApi"
   done
 
   page=$((page + 1))